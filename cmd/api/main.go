@@ -14,13 +14,16 @@ import (
 	community_acl "Gommunity/platform/community/application/outboundservices/acl"
 	community_queryservices "Gommunity/platform/community/application/queryservices"
 	community_repositories "Gommunity/platform/community/infrastructure/persistence/repositories"
+	community_scheduling "Gommunity/platform/community/infrastructure/scheduling"
 	community_controllers "Gommunity/platform/community/interfaces/rest/controllers"
 	posts_acl_impl "Gommunity/platform/posts/application/acl"
 	posts_commandservices "Gommunity/platform/posts/application/commandservices"
 	posts_acl "Gommunity/platform/posts/application/outboundservices/acl"
 	posts_queryservices "Gommunity/platform/posts/application/queryservices"
 	posts_repositories "Gommunity/platform/posts/infrastructure/persistence/repositories"
+	posts_scheduling "Gommunity/platform/posts/infrastructure/scheduling"
 	posts_controllers "Gommunity/platform/posts/interfaces/rest/controllers"
+	reactions_acl_impl "Gommunity/platform/reactions/application/acl"
 	reactions_commandservices "Gommunity/platform/reactions/application/commandservices"
 	reactions_acl "Gommunity/platform/reactions/application/outboundservices/acl"
 	reactions_queryservices "Gommunity/platform/reactions/application/queryservices"
@@ -34,9 +37,12 @@ import (
 	"Gommunity/platform/users/interfaces/rest/controllers"
 	"Gommunity/shared/config"
 	"Gommunity/shared/infrastructure/discovery"
+	"Gommunity/shared/infrastructure/messaging/deadletter"
 	"Gommunity/shared/infrastructure/messaging/kafka"
+	"Gommunity/shared/infrastructure/messaging/outbox"
 	"Gommunity/shared/infrastructure/middleware"
 	"Gommunity/shared/infrastructure/persistence/mongodb"
+	shared_controllers "Gommunity/shared/interfaces/rest/controllers"
 
 	// Subscriptions BC imports
 	communities_acl "Gommunity/platform/community/application/acl"
@@ -45,14 +51,31 @@ import (
 	subscriptions_outbound_acl "Gommunity/platform/subscriptions/application/outboundservices/acl"
 	subscription_queryservices "Gommunity/platform/subscriptions/application/queryservices"
 	subscription_repositories "Gommunity/platform/subscriptions/infrastructure/persistence/repositories"
+	subscription_scheduling "Gommunity/platform/subscriptions/infrastructure/scheduling"
+	subscription_security "Gommunity/platform/subscriptions/infrastructure/security"
 	subscription_controllers "Gommunity/platform/subscriptions/interfaces/rest/controllers"
 	users_acl "Gommunity/platform/users/application/acl"
+	users_outbound_acl "Gommunity/platform/users/application/outboundservices/acl"
+	users_valueobjects "Gommunity/platform/users/domain/model/valueobjects"
+
+	// Comments BC imports
+	comments_acl "Gommunity/platform/comments/application/acl"
+	comments_commandservices "Gommunity/platform/comments/application/commandservices"
+	comments_outbound_acl "Gommunity/platform/comments/application/outboundservices/acl"
+	comments_queryservices "Gommunity/platform/comments/application/queryservices"
+	comments_repositories "Gommunity/platform/comments/infrastructure/persistence/repositories"
+	comments_controllers "Gommunity/platform/comments/interfaces/rest/controllers"
 
 	// Feed BC imports
 	feed_acl "Gommunity/platform/feed/application/outboundservices/acl"
 	feed_queryservices "Gommunity/platform/feed/application/queryservices"
 	feed_controllers "Gommunity/platform/feed/interfaces/rest/controllers"
 
+	// Search BC imports
+	search_acl "Gommunity/platform/search/application/outboundservices/acl"
+	search_queryservices "Gommunity/platform/search/application/queryservices"
+	search_controllers "Gommunity/platform/search/interfaces/rest/controllers"
+
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -99,8 +122,20 @@ func main() {
 	userCollection := mongoConn.GetCollection("users")
 	communityCollection := mongoConn.GetCollection("communities")
 	subscriptionCollection := mongoConn.GetCollection("subscriptions")
+	joinRequestCollection := mongoConn.GetCollection("join_requests")
+	invitationCollection := mongoConn.GetCollection("invitations")
+	sanctionCollection := mongoConn.GetCollection("community_sanctions")
+	customRoleCollection := mongoConn.GetCollection("community_roles")
 	postCollection := mongoConn.GetCollection("posts")
+	postRevisionCollection := mongoConn.GetCollection("post_revisions")
+	pollVoteCollection := mongoConn.GetCollection("poll_votes")
 	reactionCollection := mongoConn.GetCollection("reactions")
+	commentCollection := mongoConn.GetCollection("comments")
+	ownershipTransferCollection := mongoConn.GetCollection("ownership_transfers")
+	communityAuditLogCollection := mongoConn.GetCollection("community_audit_log")
+	outboxCollection := mongoConn.GetCollection("outbox")
+	deadLetterCollection := mongoConn.GetCollection("dead_letters")
+	processedEventCollection := mongoConn.GetCollection("processed_events")
 
 	// Create indexes
 	indexCtx, indexCancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -108,12 +143,75 @@ func main() {
 	if err := mongodb.CreateUserIndexes(indexCtx, userCollection); err != nil {
 		log.Printf("Warning: Failed to create indexes: %v", err)
 	}
+	if err := mongodb.CreatePollVoteIndexes(indexCtx, pollVoteCollection); err != nil {
+		log.Printf("Warning: Failed to create poll vote indexes: %v", err)
+	}
+	if err := mongodb.CreateCommentIndexes(indexCtx, commentCollection); err != nil {
+		log.Printf("Warning: Failed to create comment indexes: %v", err)
+	}
+	if err := mongodb.CreateCommunityIndexes(indexCtx, communityCollection); err != nil {
+		log.Printf("Warning: Failed to create community indexes: %v", err)
+	}
+	if err := mongodb.CreateCommunityTextIndex(indexCtx, communityCollection); err != nil {
+		log.Printf("Warning: Failed to create community text index: %v", err)
+	}
+	if err := mongodb.CreatePostTextIndex(indexCtx, postCollection); err != nil {
+		log.Printf("Warning: Failed to create post text index: %v", err)
+	}
+	if err := mongodb.CreatePostPinIndexes(indexCtx, postCollection); err != nil {
+		log.Printf("Warning: Failed to create post pin index: %v", err)
+	}
+	if err := mongodb.CreatePostFeedIndexes(indexCtx, postCollection); err != nil {
+		log.Printf("Warning: Failed to create post feed index: %v", err)
+	}
+	if err := mongodb.CreateSubscriptionExpiryIndexes(indexCtx, subscriptionCollection); err != nil {
+		log.Printf("Warning: Failed to create subscription expiry indexes: %v", err)
+	}
+	if err := mongodb.CreateJoinRequestIndexes(indexCtx, joinRequestCollection); err != nil {
+		log.Printf("Warning: Failed to create join request indexes: %v", err)
+	}
+	if err := mongodb.CreateInvitationIndexes(indexCtx, invitationCollection); err != nil {
+		log.Printf("Warning: Failed to create invitation indexes: %v", err)
+	}
+	if err := mongodb.CreateSanctionIndexes(indexCtx, sanctionCollection); err != nil {
+		log.Printf("Warning: Failed to create sanction indexes: %v", err)
+	}
+	if err := mongodb.CreateCustomRoleIndexes(indexCtx, customRoleCollection); err != nil {
+		log.Printf("Warning: Failed to create custom role indexes: %v", err)
+	}
+	if err := mongodb.CreateOwnershipTransferIndexes(indexCtx, ownershipTransferCollection); err != nil {
+		log.Printf("Warning: Failed to create ownership transfer indexes: %v", err)
+	}
+	if err := mongodb.CreateOutboxIndexes(indexCtx, outboxCollection); err != nil {
+		log.Printf("Warning: Failed to create outbox indexes: %v", err)
+	}
+	if err := mongodb.CreateDeadLetterIndexes(indexCtx, deadLetterCollection); err != nil {
+		log.Printf("Warning: Failed to create dead letter indexes: %v", err)
+	}
+	if err := mongodb.CreateProcessedEventIndexes(indexCtx, processedEventCollection); err != nil {
+		log.Printf("Warning: Failed to create processed event indexes: %v", err)
+	}
 
 	userRepository := repositories.NewUserRepository(userCollection)
+	processedEventRepository := repositories.NewProcessedEventRepository(processedEventCollection)
 	communityRepository := community_repositories.NewCommunityRepository(communityCollection)
+	ownershipTransferRepository := community_repositories.NewOwnershipTransferRepository(ownershipTransferCollection)
+	communityAuditLogRepository := community_repositories.NewAuditLogRepository(communityAuditLogCollection)
+	outboxStore := outbox.NewStore(outboxCollection)
+	communityOutboxRepository := community_repositories.NewOutboxRepository(outboxStore)
+	deadLetterStore := deadletter.NewStore(deadLetterCollection)
 	subscriptionRepository := subscription_repositories.NewSubscriptionRepository(subscriptionCollection)
+	joinRequestRepository := subscription_repositories.NewJoinRequestRepository(joinRequestCollection)
+	invitationRepository := subscription_repositories.NewInvitationRepository(invitationCollection)
+	sanctionRepository := subscription_repositories.NewSanctionRepository(sanctionCollection)
+	customRoleRepository := subscription_repositories.NewCustomRoleRepository(customRoleCollection)
 	postRepository := posts_repositories.NewPostRepository(postCollection)
+	postRevisionRepository := posts_repositories.NewPostRevisionRepository(postRevisionCollection)
+	pollVoteRepository := posts_repositories.NewPollVoteRepository(pollVoteCollection)
+	postOutboxRepository := posts_repositories.NewOutboxRepository(outboxStore)
 	reactionRepository := reactions_repositories.NewReactionRepository(reactionCollection)
+	commentRepository := comments_repositories.NewCommentRepository(commentCollection)
+	unitOfWork := mongodb.NewUnitOfWork(mongoConn.Client)
 
 	// Initialize Eureka client
 	var eurekaClient *discovery.EurekaClient
@@ -146,62 +244,183 @@ func main() {
 	// Initialize ACL facades
 	usersFacade := users_acl.NewUsersFacade(userRepository)
 	communitiesFacade := communities_acl.NewCommunitiesFacade(communityRepository)
-	subscriptionsFacade := subscriptions_acl.NewSubscriptionsFacade(subscriptionRepository)
+	commentsFacade := comments_acl.NewCommentsFacade(commentRepository)
+	reactionsFacade := reactions_acl_impl.NewReactionsFacade(reactionRepository)
 
 	// Initialize services
 	userQueryService := queryservices.NewUserQueryService(userRepository)
 	userCommandService := commandservices.NewUserCommandService(userRepository)
-	communityQueryService := community_queryservices.NewCommunityQueryService(communityRepository)
 
 	// Initialize Subscriptions BC services
 	externalUsersService := subscriptions_outbound_acl.NewExternalUsersService(usersFacade)
 	externalCommunitiesService := subscriptions_outbound_acl.NewExternalCommunitiesService(communitiesFacade)
+	permissionService := subscription_queryservices.NewPermissionService(
+		subscriptionRepository,
+		customRoleRepository,
+		externalUsersService,
+		externalCommunitiesService,
+	)
+	subscriptionsFacade := subscriptions_acl.NewSubscriptionsFacade(subscriptionRepository, sanctionRepository, permissionService)
 	subscriptionCommandService := subscription_commandservices.NewSubscriptionCommandService(
 		subscriptionRepository,
+		joinRequestRepository,
+		invitationRepository,
+		sanctionRepository,
+		customRoleRepository,
+		permissionService,
 		externalUsersService,
 		externalCommunitiesService,
 	)
 	subscriptionQueryService := subscription_queryservices.NewSubscriptionQueryService(
 		subscriptionRepository,
+		permissionService,
+		externalUsersService,
+		externalCommunitiesService,
+	)
+	joinRequestCommandService := subscription_commandservices.NewJoinRequestCommandService(
+		joinRequestRepository,
+		subscriptionRepository,
+		sanctionRepository,
+		permissionService,
+		externalUsersService,
+		externalCommunitiesService,
+	)
+	joinRequestQueryService := subscription_queryservices.NewJoinRequestQueryService(
+		joinRequestRepository,
+		permissionService,
+		externalCommunitiesService,
+	)
+	invitationTokenService := subscription_security.NewInvitationTokenService(cfg.InvitationSecret)
+	invitationCommandService := subscription_commandservices.NewInvitationCommandService(
+		invitationRepository,
+		subscriptionRepository,
+		sanctionRepository,
+		customRoleRepository,
+		permissionService,
+		invitationTokenService,
+		externalUsersService,
+		externalCommunitiesService,
+		cfg.InvitationLifetime,
+	)
+	invitationQueryService := subscription_queryservices.NewInvitationQueryService(
+		invitationRepository,
+		invitationTokenService,
+		permissionService,
+		externalCommunitiesService,
+	)
+	sanctionCommandService := subscription_commandservices.NewSanctionCommandService(
+		sanctionRepository,
+		subscriptionRepository,
+		joinRequestRepository,
+		permissionService,
+		externalUsersService,
+		externalCommunitiesService,
+	)
+	sanctionQueryService := subscription_queryservices.NewSanctionQueryService(
+		sanctionRepository,
+		permissionService,
+		externalCommunitiesService,
+	)
+	customRoleCommandService := subscription_commandservices.NewCustomRoleCommandService(
+		customRoleRepository,
+		subscriptionRepository,
+		permissionService,
+		externalCommunitiesService,
+	)
+	customRoleQueryService := subscription_queryservices.NewCustomRoleQueryService(
+		customRoleRepository,
+		externalCommunitiesService,
 	)
 
 	// Initialize Community BC ACL service for subscriptions
-	communityExternalSubscriptionsService := community_acl.NewExternalSubscriptionsService(subscriptionCommandService)
-	communityExternalPostsService := community_acl.NewExternalPostsService(postRepository)
+	communityExternalSubscriptionsService := community_acl.NewExternalSubscriptionsService(subscriptionCommandService, subscriptionsFacade)
+	communityExternalPostsService := community_acl.NewExternalPostsService(postRepository, postRevisionRepository, pollVoteRepository)
 	communityExternalReactionsService := community_acl.NewExternalReactionsService(reactionRepository)
+	communityExternalCommentsService := community_acl.NewExternalCommentsService(commentsFacade)
+
+	communityQueryService := community_queryservices.NewCommunityQueryService(
+		communityRepository,
+		communityExternalSubscriptionsService,
+	)
 
 	// Initialize Community BC command service with subscription dependency
 	communityCommandService := community_commandservices.NewCommunityCommandService(
 		communityRepository,
+		ownershipTransferRepository,
+		communityOutboxRepository,
+		unitOfWork,
 		communityExternalSubscriptionsService,
 		communityExternalPostsService,
 		communityExternalReactionsService,
+		communityExternalCommentsService,
 	)
 
+	ownershipTransferCommandService := community_commandservices.NewOwnershipTransferCommandService(
+		communityRepository,
+		ownershipTransferRepository,
+		communityAuditLogRepository,
+		communityOutboxRepository,
+		unitOfWork,
+		communityExternalSubscriptionsService,
+	)
+	ownershipTransferQueryService := community_queryservices.NewOwnershipTransferQueryService(ownershipTransferRepository)
+
 	postExternalUsersService := posts_acl.NewExternalUsersService(usersFacade)
 	postExternalCommunitiesService := posts_acl.NewExternalCommunitiesService(communitiesFacade)
 	postExternalSubscriptionsService := posts_acl.NewExternalSubscriptionsService(subscriptionsFacade)
+	postExternalCommentsService := posts_acl.NewExternalCommentsService(commentsFacade)
+	postExternalReactionsService := posts_acl.NewExternalReactionsService(reactionsFacade)
 	postCommandService := posts_commandservices.NewPostCommandService(
 		postRepository,
+		postRevisionRepository,
+		pollVoteRepository,
+		postOutboxRepository,
+		unitOfWork,
+		postExternalUsersService,
+		postExternalCommunitiesService,
+		postExternalSubscriptionsService,
+		postExternalCommentsService,
+		postExternalReactionsService,
+		cfg.MaxPinnedPosts,
+	)
+	postQueryService := posts_queryservices.NewPostQueryService(
+		postRepository,
+		postRevisionRepository,
+		pollVoteRepository,
 		postExternalUsersService,
 		postExternalCommunitiesService,
 		postExternalSubscriptionsService,
 	)
-	postQueryService := posts_queryservices.NewPostQueryService(postRepository)
 
 	// Initialize Posts ACL facade
-	postsFacade := posts_acl_impl.NewPostsFacade(postQueryService, postRepository)
+	postsFacade := posts_acl_impl.NewPostsFacade(postQueryService, postCommandService, postRepository)
 
 	// Initialize Reactions BC services
 	reactionsExternalPostsService := reactions_acl.NewExternalPostsService(postsFacade)
 	reactionsExternalUsersService := reactions_acl.NewExternalUsersService(usersFacade)
+	reactionsExternalSubscriptionsService := reactions_acl.NewExternalSubscriptionsService(subscriptionsFacade)
+	reactionsExternalCommunitiesService := reactions_acl.NewExternalCommunitiesService(communitiesFacade)
 	reactionCommandService := reactions_commandservices.NewReactionCommandService(
 		reactionRepository,
 		reactionsExternalPostsService,
 		reactionsExternalUsersService,
+		reactionsExternalSubscriptionsService,
+		reactionsExternalCommunitiesService,
 	)
 	reactionQueryService := reactions_queryservices.NewReactionQueryService(reactionRepository)
 
+	// Initialize Comments BC services
+	commentsExternalPostsService := comments_outbound_acl.NewExternalPostsService(postsFacade)
+	commentsExternalSubscriptionsService := comments_outbound_acl.NewExternalSubscriptionsService(subscriptionsFacade)
+	commentsExternalCommunitiesService := comments_outbound_acl.NewExternalCommunitiesService(communitiesFacade)
+	commentCommandService := comments_commandservices.NewCommentCommandService(
+		commentRepository,
+		commentsExternalPostsService,
+		commentsExternalSubscriptionsService,
+		commentsExternalCommunitiesService,
+	)
+	commentQueryService := comments_queryservices.NewCommentQueryService(commentRepository)
+
 	// Initialize Feed BC services
 	feedExternalSubscriptionsService := feed_acl.NewExternalSubscriptionsService(subscriptionsFacade)
 	feedExternalPostsService := feed_acl.NewExternalPostsService(postsFacade)
@@ -210,61 +429,158 @@ func main() {
 		feedExternalPostsService,
 	)
 
+	// Initialize Search BC services
+	searchExternalCommunitiesService := search_acl.NewExternalCommunitiesService(communitiesFacade)
+	searchExternalPostsService := search_acl.NewExternalPostsService(postsFacade)
+	searchExternalSubscriptionsService := search_acl.NewExternalSubscriptionsService(subscriptionsFacade)
+	searchQueryService := search_queryservices.NewSearchQueryService(
+		searchExternalCommunitiesService,
+		searchExternalPostsService,
+		searchExternalSubscriptionsService,
+	)
+
 	// Initialize event handlers
 	registrationHandler := eventhandlers.NewUserRegistrationHandler(userRepository)
 	profileUpdateHandler := eventhandlers.NewProfileUpdatedHandler(userRepository)
+	userRemovalPolicy, err := users_valueobjects.NewRemovalPolicy(cfg.UserRemovalPolicy)
+	if err != nil {
+		log.Fatalf("Invalid user removal policy: %v", err)
+	}
+	userDeactivationPolicy, err := users_valueobjects.NewDeactivationPolicy(cfg.UserDeactivationPolicy)
+	if err != nil {
+		log.Fatalf("Invalid user deactivation policy: %v", err)
+	}
+	userRemovalHandler := eventhandlers.NewUserRemovalHandler(
+		userRepository,
+		users_outbound_acl.NewExternalCommunitiesService(ownershipTransferCommandService),
+		users_outbound_acl.NewExternalSubscriptionsService(subscriptionCommandService),
+		users_outbound_acl.NewExternalPostsService(postsFacade),
+		users_outbound_acl.NewExternalReactionsService(reactionsFacade),
+		users_outbound_acl.NewExternalCommentsService(commentsFacade),
+		userRemovalPolicy,
+	)
 
 	// Initialize controllers
 	userController := controllers.NewUserController(userCommandService, userQueryService)
 	communityController := community_controllers.NewCommunityController(communityCommandService, communityQueryService)
+	ownershipTransferController := community_controllers.NewOwnershipTransferController(
+		ownershipTransferCommandService,
+		ownershipTransferQueryService,
+		communityQueryService,
+	)
 	subscriptionController := subscription_controllers.NewSubscriptionController(
 		subscriptionCommandService,
 		subscriptionQueryService,
 		externalUsersService,
 	)
+	joinRequestController := subscription_controllers.NewJoinRequestController(joinRequestCommandService, joinRequestQueryService)
+	invitationController := subscription_controllers.NewInvitationController(invitationCommandService, invitationQueryService)
+	sanctionController := subscription_controllers.NewSanctionController(sanctionCommandService, sanctionQueryService)
+	customRoleController := subscription_controllers.NewCustomRoleController(customRoleCommandService, customRoleQueryService)
+	memberCSVController := subscription_controllers.NewMemberCSVController(subscriptionCommandService, subscriptionQueryService)
 	postController := posts_controllers.NewPostController(postCommandService, postQueryService)
 	reactionController := reactions_controllers.NewReactionController(reactionCommandService, reactionQueryService)
+	commentController := comments_controllers.NewCommentController(commentCommandService, commentQueryService)
 	feedController := feed_controllers.NewFeedController(feedQueryService)
+	searchController := search_controllers.NewSearchController(searchQueryService)
 
 	// Initialize JWT middleware
 	// Note: Roles (STUDENT, TEACHER, ADMIN) come directly from IAM service via JWT
 	jwtMiddleware := middleware.NewJWTMiddleware(cfg.JWTSecret)
 
-	// Initialize Kafka event consumer
-	kafkaEventConsumer := messaging.NewKafkaEventConsumer(registrationHandler, profileUpdateHandler)
+	// Register the Kafka event handlers of each bounded context
+	kafkaHandlerRegistry := kafka.NewHandlerRegistry()
+	kafkaEventConsumer := messaging.NewKafkaEventConsumer(
+		registrationHandler,
+		profileUpdateHandler,
+		userRemovalHandler,
+		processedEventRepository,
+		userDeactivationPolicy,
+	)
+	if err := kafkaEventConsumer.RegisterHandlers(kafkaHandlerRegistry); err != nil {
+		log.Fatalf("Failed to register Kafka event handlers: %v", err)
+	}
+
+	// Bind the configured topics to the registered handlers; a topic nobody handles stops startup.
+	// Without Kafka the topics are not used, so their configuration is not checked either.
+	var kafkaTopics []string
+	if cfg.Kafka.Enabled() {
+		kafkaTopics, err = kafkaHandlerRegistry.Bind(cfg.Kafka.Topics)
+		if err != nil {
+			log.Fatalf("Invalid Kafka topic configuration: %v", err)
+		}
+	}
+
+	// Dead-lettered messages are replayed through the same handlers the consumer uses
+	deadLetterService := deadletter.NewService(deadLetterStore, kafkaHandlerRegistry.HandleMessage)
+	deadLetterController := shared_controllers.NewDeadLetterController(deadLetterService)
 
 	// Create context for Kafka consumer (if needed)
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	// Initialize Kafka consumer only if properly configured
-	if cfg.Kafka.BootstrapServers != "" && cfg.Kafka.BootstrapServers != "localhost:9092" {
+	// Initialize Kafka consumer only if configured
+	if cfg.Kafka.Enabled() {
 		log.Println("Initializing Kafka consumer...")
 
 		// Initialize Kafka consumer
 		kafkaConsumer := kafka.NewKafkaConsumer(kafka.KafkaConfig{
 			BootstrapServers: cfg.Kafka.BootstrapServers,
-			GroupID:          "gommunity-consumer-group",
-			Topics: []string{
-				messaging.TopicCommunityRegistration,
-				messaging.TopicProfileUpdated,
-			},
+			GroupID:          cfg.Kafka.GroupID,
+			Topics:           kafkaTopics,
 			SecurityProtocol: cfg.Kafka.SecurityProtocol,
 			SASLMechanism:    cfg.Kafka.SASLMechanism,
 			SASLUsername:     cfg.Kafka.SASLUsername,
 			SASLPassword:     cfg.Kafka.SASLPassword,
-		})
+			Retry: kafka.RetryPolicy{
+				MaxAttempts: cfg.Kafka.ConsumerMaxAttempts,
+				Backoff:     cfg.Kafka.ConsumerRetryBackoff,
+				MaxBackoff:  cfg.Kafka.ConsumerMaxRetryBackoff,
+			},
+		}).WithDeadLetterSink(deadLetterStore)
 
 		// Start Kafka consumer in a goroutine
 		go func() {
-			if err := kafkaConsumer.ConsumeMessages(ctx, kafkaEventConsumer.HandleMessage); err != nil {
+			if err := kafkaConsumer.ConsumeMessages(ctx, kafkaHandlerRegistry.HandleMessage); err != nil {
 				log.Printf("Kafka consumer error: %v", err)
 			}
 		}()
 	} else {
-		log.Println("Kafka is not configured or using default localhost - skipping Kafka consumer initialization")
+		log.Println("Kafka is not configured - skipping Kafka consumer initialization")
+	}
+
+	// Publish the events recorded in the outbox. Without Kafka they stay in the outbox until it is configured.
+	// Only one instance may run the relay; the others disable it with KAFKA_OUTBOX_RELAY_ENABLED=false.
+	var outboxRelay *outbox.Relay
+	var kafkaProducer *kafka.KafkaProducer
+	if cfg.Kafka.Enabled() && cfg.OutboxRelayEnabled {
+		kafkaProducer = kafka.NewKafkaProducer(kafka.KafkaConfig{
+			BootstrapServers: cfg.Kafka.BootstrapServers,
+			SecurityProtocol: cfg.Kafka.SecurityProtocol,
+			SASLMechanism:    cfg.Kafka.SASLMechanism,
+			SASLUsername:     cfg.Kafka.SASLUsername,
+			SASLPassword:     cfg.Kafka.SASLPassword,
+		})
+		outboxRelay = outbox.NewRelay(outboxStore, kafkaProducer, cfg.OutboxRelayInterval, cfg.OutboxMaxAttempts)
+		outboxRelay.Start(ctx)
+	} else if cfg.Kafka.Enabled() {
+		log.Println("Outbox relay disabled on this instance - skipping outbox relay initialization")
+	} else {
+		log.Println("Kafka is not configured - skipping outbox relay initialization")
 	}
 
+	// Start publishing scheduled posts in the background
+	postPublicationScheduler := posts_scheduling.NewPostPublicationScheduler(postCommandService, cfg.PostSchedulerInterval)
+	postPublicationScheduler.Start(ctx)
+
+	// Start ending expired memberships in the background
+	membershipExpirySweeper := subscription_scheduling.NewMembershipExpirySweeper(subscriptionCommandService, cfg.MembershipSweepInterval)
+	membershipExpirySweeper.Start(ctx)
+
+	// Start refreshing the member counts the community directory sorts on
+	memberCountRefresher := community_scheduling.NewMemberCountRefresher(communityCommandService, cfg.MemberCountRefreshInterval)
+	memberCountRefresher.Start(ctx)
+
 	// Initialize Gin router
 	r := gin.Default()
 
@@ -272,6 +588,7 @@ func main() {
 	corsConfig := cors.Config{
 		AllowMethods:     cfg.CORSAllowedMethods,
 		AllowHeaders:     cfg.CORSAllowedHeaders,
+		ExposeHeaders:    []string{"X-Total-Count"},
 		AllowCredentials: cfg.CORSAllowCredentials,
 		MaxAge:           cfg.CORSMaxAge,
 	}
@@ -314,12 +631,29 @@ func main() {
 		communityRoutes.GET("/my-communities", communityController.GetMyCommunitiesAsOwner)
 		communityRoutes.GET("/:community_id/posts", postController.GetPostsByCommunity)
 		communityRoutes.POST("/:community_id/posts", postController.CreatePost)
+		communityRoutes.GET("/:community_id/posts/pinned", postController.GetPinnedPosts)
+		communityRoutes.GET("/:community_id/posts/drafts", postController.GetUnpublishedPosts)
+		communityRoutes.GET("/:community_id/posts/hashtags/:hashtag", postController.GetPostsByHashtag)
 		communityRoutes.GET("/:community_id/posts/:post_id", postController.GetPostByID)
+		communityRoutes.PUT("/:community_id/posts/:post_id", postController.UpdatePost)
+		communityRoutes.GET("/:community_id/posts/:post_id/revisions", postController.GetPostRevisions)
+		communityRoutes.POST("/:community_id/posts/:post_id/revisions/:revision_id/restore", postController.RestorePostRevision)
 		communityRoutes.DELETE("/:community_id/posts/:post_id", postController.DeletePost)
+		communityRoutes.POST("/:community_id/posts/:post_id/pin", postController.PinPost)
+		communityRoutes.DELETE("/:community_id/posts/:post_id/pin", postController.UnpinPost)
+		communityRoutes.PATCH("/:community_id/posts/:post_id/status", postController.ChangePostStatus)
+		communityRoutes.GET("/:community_id/posts/:post_id/poll", postController.GetPollResults)
+		communityRoutes.POST("/:community_id/posts/:post_id/poll/votes", postController.VoteOnPoll)
 		communityRoutes.GET("/:community_id", communityController.GetCommunityByID)
 		communityRoutes.PUT("/:community_id", communityController.UpdateCommunityInfo)
 		communityRoutes.DELETE("/:community_id", communityController.DeleteCommunity)
 		communityRoutes.PATCH("/:community_id/privacy", communityController.UpdateCommunityPrivacy)
+		communityRoutes.PATCH("/:community_id/admin-role-management", communityController.UpdateAdminRoleManagement)
+		communityRoutes.POST("/:community_id/ownership-transfer", ownershipTransferController.NominateOwner)
+		communityRoutes.GET("/:community_id/ownership-transfer", ownershipTransferController.GetPendingTransfer)
+		communityRoutes.DELETE("/:community_id/ownership-transfer", ownershipTransferController.CancelTransfer)
+		communityRoutes.POST("/:community_id/ownership-transfer/accept", ownershipTransferController.AcceptTransfer)
+		communityRoutes.POST("/:community_id/ownership-transfer/decline", ownershipTransferController.DeclineTransfer)
 	}
 
 	// Subscription routes (protected with JWT)
@@ -328,19 +662,49 @@ func main() {
 	{
 		subscriptionRoutes.POST("", subscriptionController.SubscribeUser)
 		subscriptionRoutes.DELETE("", subscriptionController.UnsubscribeUser)
+		subscriptionRoutes.GET("/me", subscriptionController.GetMySubscriptions)
 		subscriptionRoutes.GET("/communities/:community_id/count", subscriptionController.GetSubscriptionCount)
 		subscriptionRoutes.GET("/communities/:community_id", subscriptionController.GetAllSubscriptionsByCommunity)
+		subscriptionRoutes.PATCH("/communities/:community_id/users/:user_id/role", subscriptionController.ChangeMemberRole)
+		subscriptionRoutes.POST("/communities/:community_id/members/extend", subscriptionController.ExtendMemberships)
+		subscriptionRoutes.POST("/communities/:community_id/members/import", memberCSVController.ImportMembers)
+		subscriptionRoutes.GET("/communities/:community_id/members/export", memberCSVController.ExportMembers)
+		subscriptionRoutes.POST("/communities/:community_id/join-requests", joinRequestController.RequestToJoin)
+		subscriptionRoutes.GET("/communities/:community_id/join-requests", joinRequestController.GetPendingJoinRequests)
+		subscriptionRoutes.POST("/join-requests/:join_request_id/approve", joinRequestController.ApproveJoinRequest)
+		subscriptionRoutes.POST("/join-requests/:join_request_id/reject", joinRequestController.RejectJoinRequest)
+		subscriptionRoutes.DELETE("/join-requests/:join_request_id", joinRequestController.WithdrawJoinRequest)
+		subscriptionRoutes.POST("/communities/:community_id/invitations", invitationController.CreateInvitation)
+		subscriptionRoutes.GET("/communities/:community_id/invitations", invitationController.GetInvitations)
+		subscriptionRoutes.POST("/invitations/accept", invitationController.AcceptInvitation)
+		subscriptionRoutes.DELETE("/invitations/:invitation_id", invitationController.RevokeInvitation)
+		subscriptionRoutes.POST("/communities/:community_id/bans", sanctionController.BanMember)
+		subscriptionRoutes.GET("/communities/:community_id/bans", sanctionController.GetBans)
+		subscriptionRoutes.DELETE("/communities/:community_id/bans/:user_id", sanctionController.LiftBan)
+		subscriptionRoutes.POST("/communities/:community_id/mutes", sanctionController.MuteMember)
+		subscriptionRoutes.GET("/communities/:community_id/mutes", sanctionController.GetMutes)
+		subscriptionRoutes.DELETE("/communities/:community_id/mutes/:user_id", sanctionController.LiftMute)
+		subscriptionRoutes.POST("/communities/:community_id/roles", customRoleController.CreateRole)
+		subscriptionRoutes.GET("/communities/:community_id/roles", customRoleController.GetRoles)
+		subscriptionRoutes.PUT("/communities/:community_id/roles/:role_name", customRoleController.UpdateRole)
+		subscriptionRoutes.DELETE("/communities/:community_id/roles/:role_name", customRoleController.DeleteRole)
 		subscriptionRoutes.GET("/users/:user_id/communities/:community_id", subscriptionController.GetSubscriptionByUserAndCommunity)
 	}
 
-	// Reaction routes (protected with JWT)
+	// Mention, reaction and comment routes (protected with JWT)
 	postRoutes := api.Group("/posts")
 	postRoutes.Use(jwtMiddleware.AuthMiddleware())
 	{
+		postRoutes.GET("/mentions/me", postController.GetMyMentions)
 		postRoutes.POST("/:post_id/reactions", reactionController.AddReaction)
 		postRoutes.DELETE("/:post_id/reactions", reactionController.RemoveReaction)
 		postRoutes.GET("/:post_id/reactions/count", reactionController.GetReactionCountByPost)
 		postRoutes.GET("/:post_id/reactions/me", reactionController.GetUserReactionOnPost)
+		postRoutes.POST("/:post_id/comments", commentController.CreateComment)
+		postRoutes.GET("/:post_id/comments", commentController.GetCommentsByPost)
+		postRoutes.GET("/:post_id/comments/:comment_id/replies", commentController.GetCommentReplies)
+		postRoutes.PUT("/:post_id/comments/:comment_id", commentController.UpdateComment)
+		postRoutes.DELETE("/:post_id/comments/:comment_id", commentController.DeleteComment)
 	}
 
 	// Feed routes (protected with JWT)
@@ -350,6 +714,22 @@ func main() {
 		feedRoutes.GET("", feedController.GetUserFeed)
 	}
 
+	// Search routes (protected with JWT)
+	searchRoutes := api.Group("/search")
+	searchRoutes.Use(jwtMiddleware.AuthMiddleware())
+	{
+		searchRoutes.GET("", searchController.Search)
+	}
+
+	// Admin routes (protected with JWT, platform admins only)
+	adminRoutes := api.Group("/admin")
+	adminRoutes.Use(jwtMiddleware.AuthMiddleware(), jwtMiddleware.RequireRole("ROLE_ADMIN"))
+	{
+		adminRoutes.GET("/dead-letters", deadLetterController.GetDeadLetters)
+		adminRoutes.POST("/dead-letters/:dead_letter_id/replay", deadLetterController.ReplayDeadLetter)
+		adminRoutes.DELETE("/dead-letters/:dead_letter_id", deadLetterController.DiscardDeadLetter)
+	}
+
 	// Setup graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -374,6 +754,15 @@ func main() {
 		}
 	}
 
+	// Stop the background jobs
+	postPublicationScheduler.Stop()
+	membershipExpirySweeper.Stop()
+	memberCountRefresher.Stop()
+	if outboxRelay != nil {
+		outboxRelay.Stop()
+		_ = kafkaProducer.Close()
+	}
+
 	// Cancel Kafka consumer context
 	cancel()
 
@@ -15,6 +15,203 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/api/v1/admin/dead-letters": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the consumed Kafka messages whose handler kept failing after every retry, newest first. Only platform admins can manage dead letters.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List dead-lettered Kafka messages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only messages from this topic",
+                        "name": "topic",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of messages to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.DeadLetterListResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/dead-letters/{dead_letter_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a dead-lettered message without handling it. Only platform admins can manage dead letters.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Discard a dead-lettered Kafka message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Dead letter ID",
+                        "name": "dead_letter_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/dead-letters/{dead_letter_id}/replay": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Hand a dead-lettered message to its handler again. It is removed once handled; otherwise it stays with the new error. Only platform admins can manage dead letters.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Replay a dead-lettered Kafka message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Dead letter ID",
+                        "name": "dead_letter_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/communities": {
             "get": {
                 "security": [
@@ -22,7 +219,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all communities (paginated)",
+                "description": "Get a page of the community directory, with the member count of each community.\nThe body is an array of communities as before, now paged: 20 communities are returned unless limit says otherwise,\nand the X-Total-Count header holds the number of matching communities, so clients that need every community follow offset until it is reached.\nSorting by members uses counts refreshed in the background, so the order can briefly lag behind recent joins.",
                 "consumes": [
                     "application/json"
                 ],
@@ -32,15 +229,86 @@ const docTemplate = `{
                 "tags": [
                     "communities"
                 ],
-                "summary": "Get all communities",
+                "summary": "Get community directory",
+                "parameters": [
+                    {
+                        "enum": [
+                            "public",
+                            "private"
+                        ],
+                        "type": "string",
+                        "description": "Only public or only private communities",
+                        "name": "visibility",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only communities of this owner (UUID)",
+                        "name": "owner_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only communities created at or after this time (RFC 3339)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only communities created at or before this time (RFC 3339)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "name",
+                            "members"
+                        ],
+                        "type": "string",
+                        "default": "newest",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/resources.CommunityResource"
+                                "$ref": "#/definitions/resources.CommunityDirectoryEntryResource"
                             }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Number of communities matching the filters"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "401": {
@@ -359,14 +627,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/communities/{community_id}/posts": {
-            "get": {
+        "/api/v1/communities/{community_id}/admin-role-management": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves posts published inside a community.",
+                "description": "Allow or forbid community admins to promote members to admin and to change other admins' roles (only owner can update)",
                 "consumes": [
                     "application/json"
                 ],
@@ -374,9 +642,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "communities"
                 ],
-                "summary": "List posts by community",
+                "summary": "Let admins manage other admins",
                 "parameters": [
                     {
                         "type": "string",
@@ -386,68 +654,70 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "maximum": 100,
-                        "minimum": 1,
-                        "type": "integer",
-                        "description": "Limit results",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 0,
-                        "type": "integer",
-                        "description": "Skip results",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "Admin role management setting",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.UpdateAdminRoleManagementResource"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/resources.PostResource"
-                            }
+                            "$ref": "#/definitions/resources.CommunityResource"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/api/v1/communities/{community_id}/ownership-transfer": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Only community owners and admins can create posts.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the pending ownership transfer of a community (only the owner and the nominee can see it)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "communities"
                 ],
-                "summary": "Publish a new post",
+                "summary": "Get the pending ownership transfer",
                 "parameters": [
                     {
                         "type": "string",
@@ -455,65 +725,54 @@ const docTemplate = `{
                         "name": "community_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Post payload",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/resources.CreatePostResource"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resources.PostResource"
+                            "$ref": "#/definitions/resources.OwnershipTransferResource"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/communities/{community_id}/posts/{post_id}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves a post using its identifier within a community.",
+                "description": "Start an ownership transfer to an existing admin or member (only owner can nominate). The transfer completes when the nominee accepts it.",
                 "consumes": [
                     "application/json"
                 ],
@@ -521,9 +780,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "communities"
                 ],
-                "summary": "Get post by ID",
+                "summary": "Nominate a new community owner",
                 "parameters": [
                     {
                         "type": "string",
@@ -533,42 +792,56 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Post ID (ObjectID)",
-                        "name": "post_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Nominee",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.NominateOwnerResource"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/resources.PostResource"
+                            "$ref": "#/definitions/resources.OwnershipTransferResource"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
@@ -579,17 +852,14 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Only community admins or owners can delete posts.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Cancel the pending ownership transfer (only owner can cancel)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "communities"
                 ],
-                "summary": "Delete a post",
+                "summary": "Cancel an ownership transfer",
                 "parameters": [
                     {
                         "type": "string",
@@ -597,70 +867,60 @@ const docTemplate = `{
                         "name": "community_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Post ID (ObjectID)",
-                        "name": "post_id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "Post deleted"
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/communities/{community_id}/privacy": {
-            "patch": {
+        "/api/v1/communities/{community_id}/ownership-transfer/accept": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update the privacy status of a community (only owner can update)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Accept the pending ownership transfer (only the nominee can accept). The nominee becomes the owner and the previous owner takes over the nominee's former role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "communities"
                 ],
-                "summary": "Update community privacy status",
+                "summary": "Accept an ownership transfer",
                 "parameters": [
                     {
                         "type": "string",
@@ -668,22 +928,13 @@ const docTemplate = `{
                         "name": "community_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Community privacy update request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/resources.UpdateCommunityPrivacyResource"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resources.CommunityResource"
+                            "$ref": "#/definitions/resources.OwnershipTransferResource"
                         }
                     },
                     "400": {
@@ -710,6 +961,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -719,85 +976,84 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/feed": {
-            "get": {
+        "/api/v1/communities/{community_id}/ownership-transfer/decline": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves announcements from all communities the user is subscribed to",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Decline the pending ownership transfer (only the nominee can decline)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "feed"
+                    "communities"
                 ],
-                "summary": "Get user feed",
+                "summary": "Decline an ownership transfer",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Number of items per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Page offset",
-                        "name": "offset",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Community ID (UUID)",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resources.FeedResponse"
+                            "$ref": "#/definitions/resources.OwnershipTransferResource"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/posts/{post_id}/reactions": {
-            "post": {
+        "/api/v1/communities/{community_id}/posts": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Allows a user to add a reaction to a post. If the user already reacted, the reaction type will be updated.",
+                "description": "Retrieves posts published inside a community, newest first, using cursor pagination. Pinned posts come first, in pin order, on top of the first page.",
                 "consumes": [
                     "application/json"
                 ],
@@ -805,67 +1061,73 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "reactions"
+                    "posts"
                 ],
-                "summary": "Add or update a reaction to a post",
+                "summary": "List posts by community",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID (ObjectID)",
-                        "name": "post_id",
+                        "description": "Community ID (UUID)",
+                        "name": "community_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Reaction payload",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/resources.AddReactionResource"
-                        }
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Limit results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "Skip results (deprecated, use cursor)",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resources.ReactionResource"
+                            "$ref": "#/definitions/resources.PostPageResource"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Allows a user to remove their reaction from a post.",
+                "description": "Only community owners and admins, or members whose custom role grants the publish_post permission, can publish posts. Announcements also require the publish_announcement permission. A poll can be attached, with the post content as its question. Posts can be kept as drafts or scheduled for later; those are only visible to their author and community moderators until published.",
                 "consumes": [
                     "application/json"
                 ],
@@ -873,57 +1135,75 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "reactions"
+                    "posts"
                 ],
-                "summary": "Remove a reaction from a post",
+                "summary": "Publish a new post",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID (ObjectID)",
-                        "name": "post_id",
+                        "description": "Community ID (UUID)",
+                        "name": "community_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Post payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.CreatePostResource"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Reaction removed"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/resources.PostResource"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/posts/{post_id}/reactions/count": {
+        "/api/v1/communities/{community_id}/posts/drafts": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves aggregated reaction counts grouped by reaction type for a specific post.",
+                "description": "Members holding the delete_any_post permission see every unpublished post of the community; other users only see their own.",
                 "consumes": [
                     "application/json"
                 ],
@@ -931,14 +1211,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "reactions"
+                    "posts"
                 ],
-                "summary": "Get reaction counts for a post",
+                "summary": "List draft and scheduled posts",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID (ObjectID)",
-                        "name": "post_id",
+                        "description": "Community ID (UUID)",
+                        "name": "community_id",
                         "in": "path",
                         "required": true
                     }
@@ -947,38 +1227,41 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resources.ReactionCountResource"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/resources.PostResource"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/posts/{post_id}/reactions/me": {
+        "/api/v1/communities/{community_id}/posts/hashtags/{hashtag}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves the authenticated user's reaction on a specific post.",
+                "description": "Retrieves the published posts of a community tagged with a hashtag, newest first. The hashtag is case-insensitive and may omit the leading '#'.",
                 "consumes": [
                     "application/json"
                 ],
@@ -986,60 +1269,82 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "reactions"
+                    "posts"
                 ],
-                "summary": "Get current user's reaction on a post",
+                "summary": "List posts by hashtag",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID (ObjectID)",
-                        "name": "post_id",
+                        "description": "Community ID (UUID)",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hashtag",
+                        "name": "hashtag",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Limit results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "Skip results (deprecated, use cursor)",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resources.ReactionResource"
+                            "$ref": "#/definitions/resources.PostPageResource"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/subscriptions": {
-            "post": {
+        "/api/v1/communities/{community_id}/posts/pinned": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Subscribe a user to a community with a specific role. IMPORTANT: Self-subscriptions (following a community) always receive 'member' role regardless of requested role. In public communities, users can only subscribe themselves. In private communities, owner/admin can add users by username and assign any role.",
+                "description": "Retrieves the pinned posts of a community in pin order.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1047,81 +1352,57 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "subscriptions"
+                    "posts"
                 ],
-                "summary": "Subscribe a user to a community",
+                "summary": "List pinned posts",
                 "parameters": [
                     {
-                        "description": "Subscription request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/resources.SubscribeUserResource"
-                        }
+                        "type": "string",
+                        "description": "Community ID (UUID)",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resources.SubscriptionResource"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/resources.PostResource"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/communities/{community_id}/posts/{post_id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Remove a user's subscription from a community. Users can unsubscribe themselves, or owner/admin can remove users.",
+                "description": "Retrieves a post using its identifier within a community. Drafts and scheduled posts are only returned to their author and community moderators.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1129,206 +1410,239 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "subscriptions"
+                    "posts"
                 ],
-                "summary": "Unsubscribe a user from a community",
+                "summary": "Get post by ID",
                 "parameters": [
                     {
-                        "description": "Unsubscribe request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/resources.UnsubscribeUserResource"
-                        }
+                        "type": "string",
+                        "description": "Community ID (UUID)",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.PostResource"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/subscriptions/communities/{community_id}": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all subscriptions for a specific community with optional pagination",
+                "description": "The author or a member holding the delete_any_post permission can edit the content and images of a post. The previous version is kept as a revision. Published posts can only be edited by members allowed to publish them, and not while muted.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "subscriptions"
+                    "posts"
                 ],
-                "summary": "Get all subscriptions for a community",
+                "summary": "Edit a post",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Community ID",
+                        "description": "Community ID (UUID)",
                         "name": "community_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Offset",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "Post payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.UpdatePostResource"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resources.SubscriptionListResource"
+                            "$ref": "#/definitions/resources.PostResource"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/subscriptions/communities/{community_id}/count": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get the total number of subscriptions for a specific community",
+                "description": "Only members holding the delete_any_post permission can delete posts.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "subscriptions"
+                    "posts"
                 ],
-                "summary": "Get subscription count for a community",
+                "summary": "Delete a post",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Community ID",
+                        "description": "Community ID (UUID)",
                         "name": "community_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/resources.SubscriptionCountResource"
-                        }
+                    "204": {
+                        "description": "Post deleted"
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/subscriptions/users/{user_id}/communities/{community_id}": {
-            "get": {
+        "/api/v1/communities/{community_id}/posts/{post_id}/pin": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a specific subscription for a user in a community",
+                "description": "Only members holding the pin_post permission can pin posts. The post is added after the already pinned ones.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "subscriptions"
+                    "posts"
                 ],
-                "summary": "Get subscription by user and community",
+                "summary": "Pin a post",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "user_id",
+                        "type": "string",
+                        "description": "Community ID (UUID)",
+                        "name": "community_id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Community ID",
-                        "name": "community_id",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
                         "in": "path",
                         "required": true
                     }
@@ -1337,47 +1651,54 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resources.SubscriptionResource"
+                            "$ref": "#/definitions/resources.PostResource"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/users/username/{username}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a specific user by their username",
+                "description": "Only members holding the pin_post permission can unpin posts.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1385,60 +1706,70 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "posts"
                 ],
-                "summary": "Get user by username",
+                "summary": "Unpin a post",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Username",
-                        "name": "username",
+                        "description": "Community ID (UUID)",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/resources.UserResource"
-                        }
+                    "204": {
+                        "description": "Post unpinned"
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/users/{id}": {
+        "/api/v1/communities/{community_id}/posts/{post_id}/poll": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a specific user by their user ID",
+                "description": "Retrieves the poll attached to a post. Vote counts are hidden until the requesting user votes or the poll closes; voters are never shown for anonymous polls.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1446,14 +1777,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "posts"
                 ],
-                "summary": "Get user by ID",
+                "summary": "Get the poll of a post",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "id",
+                        "description": "Community ID (UUID)",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
                         "in": "path",
                         "required": true
                     }
@@ -1462,44 +1800,44 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resources.UserResource"
+                            "$ref": "#/definitions/resources.PollResultsResource"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/users/{id}/banner": {
-            "put": {
+        "/api/v1/communities/{community_id}/posts/{post_id}/poll/votes": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update the banner URL for the authenticated user",
+                "description": "Community members can vote once per poll. Single choice polls accept exactly one option.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1507,132 +1845,4786 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "posts"
                 ],
-                "summary": "Update user banner URL",
+                "summary": "Vote in the poll of a post",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "id",
+                        "description": "Community ID (UUID)",
+                        "name": "community_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Banner URL update request",
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Vote payload",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/resources.UpdateBannerURLResource"
+                            "$ref": "#/definitions/resources.VotePollResource"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/resources.UserResource"
+                            "$ref": "#/definitions/resources.PollResultsResource"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/communities/{community_id}/posts/{post_id}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the previous versions of a post, newest first. Only the author or a member holding the delete_any_post permission can view them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "List post revisions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID (UUID)",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/resources.PostRevisionResource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/communities/{community_id}/posts/{post_id}/revisions/{revision_id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "The author or a member holding the delete_any_post permission can roll a post back to a previous revision. The current version is kept as a new revision. Published posts can only be restored by members allowed to publish them, and not while muted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Restore a post revision",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID (UUID)",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Revision ID (ObjectID)",
+                        "name": "revision_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.PostResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/communities/{community_id}/posts/{post_id}/status": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "The author or a member holding the delete_any_post permission can move an unpublished post back to draft, schedule it or publish it right away. Published posts cannot be unpublished. Scheduling or publishing needs the author to be allowed to publish the post and not be muted; scheduled posts whose author is muted or loses that right by the publish-at time are moved back to draft.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Change the status of a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID (UUID)",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.ChangePostStatusResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.PostResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "Gommunity_platform_community_interfaces_rest_resources.ErrorResponse": {
+        },
+        "/api/v1/communities/{community_id}/privacy": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the privacy status of a community (only owner can update)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "communities"
+                ],
+                "summary": "Update community privacy status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID (UUID)",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Community privacy update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.UpdateCommunityPrivacyResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.CommunityResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_community_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/feed": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves posts from all communities the user is subscribed to. Use type=announcement to only get announcements.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feed"
+                ],
+                "summary": "Get user feed",
+                "parameters": [
+                    {
+                        "enum": [
+                            "all",
+                            "announcement"
+                        ],
+                        "type": "string",
+                        "default": "all",
+                        "description": "Feed mode",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Page offset (deprecated, use cursor)",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.FeedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/posts/mentions/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the published posts, across communities, that mention the authenticated user, newest first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "List posts mentioning me",
+                "parameters": [
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Limit results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "Skip results (deprecated, use cursor)",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.PostPageResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/posts/{post_id}/comments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves top-level comments on a post, oldest first, using cursor pagination.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "List comments on a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.CommentPageResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a comment to a post, or a reply when parentId is provided. Only community members can comment.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Comment on a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.CreateCommentResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/resources.CommentResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/posts/{post_id}/comments/{comment_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Only the author can edit a comment.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Edit a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comment ID (ObjectID)",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.UpdateCommentResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.CommentResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "The author or a member holding the delete_any_post permission can delete a comment. Nested replies are removed as well.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Delete a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comment ID (ObjectID)",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Comment deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/posts/{post_id}/comments/{comment_id}/replies": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves direct replies to a comment, oldest first, using cursor pagination.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "List replies to a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comment ID (ObjectID)",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.CommentPageResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/posts/{post_id}/reactions": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Allows a user to add a reaction to a post. If the user already reacted, the reaction type will be updated.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reactions"
+                ],
+                "summary": "Add or update a reaction to a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reaction payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.AddReactionResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/resources.ReactionResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Allows a user to remove their reaction from a post. Members holding the moderate_reactions permission can pass user_id to remove another member's reaction.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reactions"
+                ],
+                "summary": "Remove a reaction from a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User whose reaction is removed (defaults to the requester)",
+                        "name": "user_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Reaction removed"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/posts/{post_id}/reactions/count": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves aggregated reaction counts grouped by reaction type for a specific post.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reactions"
+                ],
+                "summary": "Get reaction counts for a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.ReactionCountResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/posts/{post_id}/reactions/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the authenticated user's reaction on a specific post.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reactions"
+                ],
+                "summary": "Get current user's reaction on a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID (ObjectID)",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.ReactionResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Full-text search on community name and description and on post content, most relevant first. Posts from private communities are only returned to their members. Pagination applies to each result kind.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Search communities and posts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search text",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "all",
+                            "communities",
+                            "posts"
+                        ],
+                        "type": "string",
+                        "default": "all",
+                        "description": "Result kinds",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Number of items per page (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.SearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Subscribe a user to a community with a specific role. IMPORTANT: Self-subscriptions (following a community) always receive 'member' role regardless of requested role. In public communities, users can only subscribe themselves. In private communities, owner/admin can add users by username and assign any role; other users have to open a join request. Users added by someone else can be given an expires_at date: the membership is then removed on that date, or moved to alumni_role when set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Subscribe a user to a community",
+                "parameters": [
+                    {
+                        "description": "Subscription request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.SubscribeUserResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/resources.SubscriptionResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a user's subscription from a community. Users can unsubscribe themselves, or owner/admin can remove users.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Unsubscribe a user from a community",
+                "parameters": [
+                    {
+                        "description": "Unsubscribe request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.UnsubscribeUserResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/communities/{community_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the members of a community with their username and profile and banner pictures. Members can be searched by username prefix, filtered by role and sorted by join date (newest or oldest) or username. Pages hold 20 members by default and at most 100. Pages sorted by join date continue with the returned cursor; searches and username sorting continue with the returned offset.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Browse the member directory of a community",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username prefix, case insensitive",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role: member, admin, owner or a custom role",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order: newest (default), oldest or username",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset returned by the previous page",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.SubscriptionListResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/communities/{community_id}/bans": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the bans in force in a community, newest first. Only the community owner and admins can see them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "List the bans of a community",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.SanctionListResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ban a user so they cannot subscribe again until the ban expires or is lifted. A current member is removed from the community. Only the community owner and admins can ban; admins can ban other admins only when the owner allows it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Ban a user from a community",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Ban request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.BanMemberResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/resources.SanctionResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/communities/{community_id}/bans/{user_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lift a user's ban so they can subscribe again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Lift a ban",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/communities/{community_id}/count": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the total number of subscriptions for a specific community",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Get subscription count for a community",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.SubscriptionCountResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/communities/{community_id}/invitations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List every invitation of a community, newest first, including revoked, expired and used-up ones. Only the community owner and admins can see them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "List invitations of a community",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.InvitationListResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a shareable invitation for a community. Owners and admins can invite members; invitations that grant the admin role need the owner, or an admin when the owner lets admins manage each other.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Create an invitation link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Invitation settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.CreateInvitationResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/resources.InvitationResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/communities/{community_id}/join-requests": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the pending join requests of a community, oldest first. Only the community owner and admins can see them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "List pending join requests of a community",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.JoinRequestListResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Open a pending join request for a private community. Public communities are joined directly through POST /subscriptions.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Request to join a private community",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional message for the community admins",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/resources.CreateJoinRequestResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/resources.JoinRequestResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/communities/{community_id}/members/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download the members of a community as a CSV file with user_id, username, role and joined_at columns, newest members first. Only the community owner or admins can export members.",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Export community members as CSV",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/communities/{community_id}/members/extend": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move the expiry of several memberships to a new date, for example when a course runs longer. Memberships that do not expire are left untouched. Each user is reported as extended, not_subscribed or no_expiry. Only the community owner can extend memberships.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Extend expiring memberships in bulk",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Members and new expiry date",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.ExtendMembershipsResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.MembershipExtensionReportResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/communities/{community_id}/members/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Subscribe a whole list of users at once. The CSV needs a header row with a username or user_id column and an optional role column; rows without a role join as member. Each row is reported as created, already_subscribed, user_not_found, invalid_role or rejected. Only the community owner or admins can import members, and only into private communities: users join public communities themselves.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Import community members from a CSV file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "CSV file, at most 1 MiB and 1000 rows",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.MemberImportReportResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/communities/{community_id}/mutes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the mutes in force in a community, newest first. Only the community owner and admins can see them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "List the mutes of a community",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.SanctionListResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mute a member until the given time. Muted members stay subscribed but cannot post, comment or react. Only the community owner and admins can mute; admins can mute other admins only when the owner allows it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Mute a community member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Mute request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.MuteMemberResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/resources.SanctionResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/communities/{community_id}/mutes/{user_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lift a member's mute so they can post, comment and react again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Lift a mute",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/communities/{community_id}/roles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the custom roles defined in a community and their permissions, sorted by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "List the custom roles of a community",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.CustomRoleListResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Define a role such as \"teaching_assistant\" with a set of permissions: publish_post, publish_announcement, delete_any_post, manage_members, pin_post, moderate_reactions. The role can then be given to members like the predefined ones. Only the community owner can manage custom roles.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Define a custom community role",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Custom role",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.CreateCustomRoleResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/resources.CustomRoleResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/communities/{community_id}/roles/{role_name}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the permissions of a custom role. Members holding the role get the new permissions at once. Only the community owner can manage custom roles.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Update the permissions of a custom role",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role name",
+                        "name": "role_name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New permissions",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.UpdateCustomRoleResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.CustomRoleResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a custom role. A role still held by members cannot be deleted; give them another role first. Only the community owner can manage custom roles.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Delete a custom role",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role name",
+                        "name": "role_name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/communities/{community_id}/users/{user_id}/role": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Promote a member to admin or demote an admin to member. The owner can change any role except their own; admins can change members' roles, and other admins' roles only when the owner allows it. Ownership cannot be assigned here.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Change the role of a community member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New role",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.ChangeMemberRoleResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.SubscriptionResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/invitations/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Join a community through an invitation token. The user gets the role granted by the invitation, also in private communities.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Accept an invitation",
+                "parameters": [
+                    {
+                        "description": "Invitation token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.AcceptInvitationResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/resources.SubscriptionResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/invitations/{invitation_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoke an invitation so it can no longer be accepted. Existing subscriptions are kept.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Revoke an invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invitation ID",
+                        "name": "invitation_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.InvitationResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/join-requests/{join_request_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Withdraw one of your own pending join requests",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Withdraw a join request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Join request ID",
+                        "name": "join_request_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.JoinRequestResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/join-requests/{join_request_id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Approve a pending join request. The user is subscribed to the community with the member role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Approve a join request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Join request ID",
+                        "name": "join_request_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.JoinRequestResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/join-requests/{join_request_id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Reject a pending join request",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Reject a join request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Join request ID",
+                        "name": "join_request_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.JoinRequestResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the communities the authenticated user belongs to, newest first, with the community name and icon, the user's role, the join date and the member count",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "List my subscriptions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.MySubscriptionListResource"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/users/{user_id}/communities/{community_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a specific subscription for a user in a community",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Get subscription by user and community",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Community ID",
+                        "name": "community_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.SubscriptionResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/username/{username}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a specific user by their username",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user by username",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.UserResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a specific user by their user ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.UserResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}/banner": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the banner URL for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update user banner URL",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Banner URL update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/resources.UpdateBannerURLResource"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resources.UserResource"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/Gommunity_platform_users_interfaces_rest_resources.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "Gommunity_platform_comments_interfaces_rest_resources.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Invalid request"
+                }
+            }
+        },
+        "Gommunity_platform_community_interfaces_rest_resources.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Invalid request"
+                }
+            }
+        },
+        "Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Invalid request"
+                }
+            }
+        },
+        "Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Invalid request"
+                }
+            }
+        },
+        "Gommunity_platform_users_interfaces_rest_resources.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Invalid request"
+                }
+            }
+        },
+        "resources.AcceptInvitationResource": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439015.q3Vx0YzS8f0mHqQb3cV6nS2J3aQ0oK2i9F1fX8l0T3E"
+                }
+            }
+        },
+        "resources.AddReactionResource": {
+            "type": "object",
+            "required": [
+                "reactionType"
+            ],
+            "properties": {
+                "reactionType": {
+                    "type": "string",
+                    "enum": [
+                        "like",
+                        "love",
+                        "haha",
+                        "wow",
+                        "sad",
+                        "angry"
+                    ],
+                    "example": "like"
+                }
+            }
+        },
+        "resources.BanMemberResource": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2023-01-08T00:00:00Z"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Repeated spam after warnings"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439013"
+                }
+            }
+        },
+        "resources.ChangeMemberRoleResource": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "example": "admin"
+                }
+            }
+        },
+        "resources.ChangePostStatusResource": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "publishAt": {
+                    "type": "string",
+                    "example": "2025-01-13T08:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "draft",
+                        "scheduled",
+                        "published"
+                    ],
+                    "example": "scheduled"
+                }
+            }
+        },
+        "resources.CommentPageResource": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.CommentResource"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string",
+                    "example": "MTczNjY4MzIwMDo2NGMyZjFlNWI5ZDNhNDVmNzg5MDlhYmM"
+                }
+            }
+        },
+        "resources.CommentResource": {
+            "type": "object",
+            "properties": {
+                "authorId": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440003"
+                },
+                "commentId": {
+                    "type": "string",
+                    "example": "64c2f1e5b9d3a45f78909abc"
+                },
+                "communityId": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "content": {
+                    "type": "string",
+                    "example": "Great explanation, thanks!"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-01-12T12:00:00Z"
+                },
+                "depth": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "parentId": {
+                    "type": "string",
+                    "example": "64c2f1e5b9d3a45f78905678"
+                },
+                "postId": {
+                    "type": "string",
+                    "example": "64c2f1e5b9d3a45f78901234"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-01-12T12:05:00Z"
+                }
+            }
+        },
+        "resources.CommunityDirectoryEntryResource": {
+            "type": "object",
+            "properties": {
+                "adminsCanManageAdmins": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "archivedAt": {
+                    "type": "string",
+                    "example": "2025-11-13T17:02:46Z"
+                },
+                "bannerUrl": {
+                    "type": "string",
+                    "example": "https://example.com/banner.jpg"
+                },
+                "communityId": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-11-13T17:02:46Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "A community for data science enthusiasts to share knowledge and collaborate"
+                },
+                "iconUrl": {
+                    "type": "string",
+                    "example": "https://example.com/icon.jpg"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "isPrivate": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "memberCount": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Data Science Community"
+                },
+                "ownerId": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-11-13T17:02:46Z"
+                }
+            }
+        },
+        "resources.CommunityResource": {
+            "type": "object",
+            "properties": {
+                "adminsCanManageAdmins": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "archivedAt": {
+                    "type": "string",
+                    "example": "2025-11-13T17:02:46Z"
+                },
+                "bannerUrl": {
+                    "type": "string",
+                    "example": "https://example.com/banner.jpg"
+                },
+                "communityId": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-11-13T17:02:46Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "A community for data science enthusiasts to share knowledge and collaborate"
+                },
+                "iconUrl": {
+                    "type": "string",
+                    "example": "https://example.com/icon.jpg"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "isPrivate": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Data Science Community"
+                },
+                "ownerId": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-11-13T17:02:46Z"
+                }
+            }
+        },
+        "resources.CommunityResultResource": {
+            "type": "object",
+            "properties": {
+                "communityId": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439012"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "A community for Go enthusiasts"
+                },
+                "iconUrl": {
+                    "type": "string",
+                    "example": "https://example.com/icon.png"
+                },
+                "isPrivate": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Go Developers"
+                }
+            }
+        },
+        "resources.CreateCommentResource": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "Great explanation, thanks!"
+                },
+                "parentId": {
+                    "type": "string",
+                    "example": "64c2f1e5b9d3a45f78905678"
+                }
+            }
+        },
+        "resources.CreateCommunityResource": {
+            "type": "object",
+            "required": [
+                "description",
+                "name"
+            ],
+            "properties": {
+                "bannerUrl": {
+                    "type": "string",
+                    "example": "https://example.com/banner.jpg"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "minLength": 10,
+                    "example": "A community for data science enthusiasts to share knowledge and collaborate"
+                },
+                "iconUrl": {
+                    "type": "string",
+                    "example": "https://example.com/icon.jpg"
+                },
+                "isPrivate": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Data Science Community"
+                }
+            }
+        },
+        "resources.CreateCustomRoleResource": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "teaching_assistant"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "publish_post",
+                        "pin_post"
+                    ]
+                }
+            }
+        },
+        "resources.CreateInvitationResource": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2023-01-08T00:00:00Z"
+                },
+                "max_uses": {
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 1,
+                    "example": 25
+                },
+                "role": {
+                    "type": "string",
+                    "example": "member"
+                }
+            }
+        },
+        "resources.CreateJoinRequestResource": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "I'm in Prof. Smith's Monday class"
+                }
+            }
+        },
+        "resources.CreatePollResource": {
+            "type": "object",
+            "required": [
+                "options"
+            ],
+            "properties": {
+                "anonymous": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "closesAt": {
+                    "type": "string",
+                    "example": "2025-01-20T23:59:00Z"
+                },
+                "multipleChoice": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Monday",
+                        "Wednesday",
+                        "Friday"
+                    ]
+                }
+            }
+        },
+        "resources.CreatePostResource": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "Hello community!"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "poll": {
+                    "$ref": "#/definitions/resources.CreatePollResource"
+                },
+                "postType": {
+                    "type": "string",
+                    "enum": [
+                        "message",
+                        "announcement"
+                    ],
+                    "example": "message"
+                },
+                "publishAt": {
+                    "type": "string",
+                    "example": "2025-01-13T08:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "draft",
+                        "scheduled",
+                        "published"
+                    ],
+                    "example": "published"
+                }
+            }
+        },
+        "resources.CustomRoleListResource": {
+            "type": "object",
+            "properties": {
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.CustomRoleResource"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "resources.CustomRoleResource": {
+            "type": "object",
+            "properties": {
+                "community_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439012"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439011"
+                },
+                "custom_role_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439017"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "teaching_assistant"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "publish_post",
+                        "pin_post"
+                    ]
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "resources.DeadLetterListResource": {
+            "type": "object",
+            "properties": {
+                "dead_letters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.DeadLetterResource"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                }
+            }
+        },
+        "resources.DeadLetterResource": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "dead_letter_id": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "failed_at": {
+                    "type": "string"
+                },
+                "headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "key": {
+                    "type": "string"
+                },
+                "last_replay_error": {
+                    "type": "string"
+                },
+                "last_replayed_at": {
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "partition": {
+                    "type": "integer"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "replay_count": {
+                    "type": "integer"
+                },
+                "topic": {
+                    "type": "string"
+                }
+            }
+        },
+        "resources.ExtendMembershipsResource": {
+            "type": "object",
+            "required": [
+                "expires_at",
+                "user_ids"
+            ],
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-12-31T00:00:00Z"
+                },
+                "user_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "507f1f77bcf86cd799439013",
+                        "507f1f77bcf86cd799439014"
+                    ]
+                }
+            }
+        },
+        "resources.FeedItemResource": {
+            "type": "object",
+            "properties": {
+                "authorId": {
+                    "type": "string",
+                    "example": "user123"
+                },
+                "communityId": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439012"
+                },
+                "content": {
+                    "type": "string",
+                    "example": "This is an important announcement"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "messageType": {
+                    "type": "string",
+                    "example": "announcement"
+                },
+                "postId": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439011"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "resources.FeedResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.FeedItemResource"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string",
+                    "example": "MTczNjY4MzIwMDo2NGMyZjFlNWI5ZDNhNDVmNzg5MDlhYmM"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "resources.InvitationListResource": {
+            "type": "object",
+            "properties": {
+                "invitations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.InvitationResource"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "resources.InvitationResource": {
+            "type": "object",
+            "properties": {
+                "community_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439012"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439011"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2023-01-08T00:00:00Z"
+                },
+                "invitation_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439015"
+                },
+                "max_uses": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "revoked_at": {
+                    "type": "string",
+                    "example": "2023-01-02T00:00:00Z"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "member"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439015.q3Vx0YzS8f0mHqQb3cV6nS2J3aQ0oK2i9F1fX8l0T3E"
+                },
+                "uses": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "resources.JoinRequestListResource": {
+            "type": "object",
+            "properties": {
+                "join_requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.JoinRequestResource"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "resources.JoinRequestResource": {
+            "type": "object",
+            "properties": {
+                "community_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439012"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "join_request_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439014"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "I'm in Prof. Smith's Monday class"
+                },
+                "reviewed_at": {
+                    "type": "string",
+                    "example": "2023-01-02T00:00:00Z"
+                },
+                "reviewed_by": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439011"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439013"
+                }
+            }
+        },
+        "resources.MemberImportReportResource": {
+            "type": "object",
+            "properties": {
+                "already_subscribed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "community_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439012"
+                },
+                "created": {
+                    "type": "integer",
+                    "example": 27
+                },
+                "invalid_role": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "rejected": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.MemberImportRowResource"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "user_not_found": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "resources.MemberImportRowResource": {
             "type": "object",
             "properties": {
-                "error": {
+                "line": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "reason": {
                     "type": "string",
-                    "example": "Invalid request"
+                    "example": "user is banned from this community"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "member"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "created"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439013"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "john_doe"
                 }
             }
         },
-        "Gommunity_platform_posts_interfaces_rest_resources.ErrorResponse": {
+        "resources.MemberResource": {
             "type": "object",
             "properties": {
-                "error": {
+                "alumni_role": {
                     "type": "string",
-                    "example": "Invalid request"
+                    "example": "alumni"
+                },
+                "banner_url": {
+                    "type": "string",
+                    "example": "https://example.com/banner.png"
+                },
+                "community_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439012"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-06-30T00:00:00Z"
+                },
+                "profile_url": {
+                    "type": "string",
+                    "example": "https://example.com/profile.png"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "member"
+                },
+                "subscription_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439011"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439013"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "john_doe"
                 }
             }
         },
-        "Gommunity_platform_reactions_interfaces_rest_resources.ErrorResponse": {
+        "resources.MembershipExtensionReportResource": {
             "type": "object",
             "properties": {
-                "error": {
+                "community_id": {
                     "type": "string",
-                    "example": "Invalid request"
+                    "example": "507f1f77bcf86cd799439012"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-12-31T00:00:00Z"
+                },
+                "extended": {
+                    "type": "integer",
+                    "example": 28
+                },
+                "no_expiry": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "not_subscribed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.MembershipExtensionUserResource"
+                    }
                 }
             }
         },
-        "Gommunity_platform_users_interfaces_rest_resources.ErrorResponse": {
+        "resources.MembershipExtensionUserResource": {
             "type": "object",
             "properties": {
-                "error": {
+                "status": {
                     "type": "string",
-                    "example": "Invalid request"
+                    "example": "extended"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439013"
                 }
             }
         },
-        "resources.AddReactionResource": {
+        "resources.MuteMemberResource": {
             "type": "object",
             "required": [
-                "reactionType"
+                "until",
+                "user_id"
             ],
             "properties": {
-                "reactionType": {
+                "reason": {
                     "type": "string",
-                    "enum": [
-                        "like",
-                        "love",
-                        "haha",
-                        "wow",
-                        "sad",
-                        "angry"
-                    ],
-                    "example": "like"
+                    "maxLength": 500,
+                    "example": "Cool down after a heated thread"
+                },
+                "until": {
+                    "type": "string",
+                    "example": "2023-01-02T00:00:00Z"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439013"
                 }
             }
         },
-        "resources.CommunityResource": {
+        "resources.MySubscriptionListResource": {
             "type": "object",
             "properties": {
-                "bannerUrl": {
+                "subscriptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.MySubscriptionResource"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "resources.MySubscriptionResource": {
+            "type": "object",
+            "properties": {
+                "community_id": {
                     "type": "string",
-                    "example": "https://example.com/banner.jpg"
+                    "example": "507f1f77bcf86cd799439012"
+                },
+                "community_name": {
+                    "type": "string",
+                    "example": "Go Study Group"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-06-30T00:00:00Z"
+                },
+                "icon_url": {
+                    "type": "string",
+                    "example": "https://example.com/icon.png"
+                },
+                "is_private": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "joined_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "member_count": {
+                    "type": "integer",
+                    "example": 150
+                },
+                "role": {
+                    "type": "string",
+                    "example": "member"
                 },
+                "subscription_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439011"
+                }
+            }
+        },
+        "resources.NominateOwnerResource": {
+            "type": "object",
+            "required": [
+                "nomineeId"
+            ],
+            "properties": {
+                "nomineeId": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440004"
+                }
+            }
+        },
+        "resources.OwnershipTransferResource": {
+            "type": "object",
+            "properties": {
                 "communityId": {
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440001"
@@ -1641,88 +6633,232 @@ const docTemplate = `{
                     "type": "string",
                     "example": "2025-11-13T17:02:46Z"
                 },
-                "description": {
+                "fromOwnerId": {
                     "type": "string",
-                    "example": "A community for data science enthusiasts to share knowledge and collaborate"
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
                 },
-                "iconUrl": {
+                "nomineeId": {
                     "type": "string",
-                    "example": "https://example.com/icon.jpg"
+                    "example": "550e8400-e29b-41d4-a716-446655440004"
                 },
-                "id": {
+                "respondedAt": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                    "example": "2025-11-14T09:30:00Z"
                 },
-                "isPrivate": {
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "transferId": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440003"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-11-13T17:02:46Z"
+                }
+            }
+        },
+        "resources.PollOptionResource": {
+            "type": "object",
+            "properties": {
+                "optionId": {
+                    "type": "string",
+                    "example": "64c2f1e5b9d3a45f78909999"
+                },
+                "text": {
+                    "type": "string",
+                    "example": "Wednesday"
+                },
+                "voters": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "550e8400-e29b-41d4-a716-446655440003"
+                    ]
+                },
+                "votes": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "resources.PollResource": {
+            "type": "object",
+            "properties": {
+                "anonymous": {
                     "type": "boolean",
                     "example": false
                 },
-                "name": {
+                "closed": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "closesAt": {
                     "type": "string",
-                    "example": "Data Science Community"
+                    "example": "2025-01-20T23:59:00Z"
                 },
-                "ownerId": {
+                "multipleChoice": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.PollOptionResource"
+                    }
+                }
+            }
+        },
+        "resources.PollResultsResource": {
+            "type": "object",
+            "properties": {
+                "anonymous": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "closed": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "closesAt": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                    "example": "2025-01-20T23:59:00Z"
+                },
+                "hasVoted": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "multipleChoice": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "myOptionIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "64c2f1e5b9d3a45f78909999"
+                    ]
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.PollOptionResource"
+                    }
                 },
-                "updatedAt": {
+                "postId": {
                     "type": "string",
-                    "example": "2025-11-13T17:02:46Z"
+                    "example": "64c2f1e5b9d3a45f78901234"
+                },
+                "resultsVisible": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "totalVoters": {
+                    "type": "integer",
+                    "example": 30
                 }
             }
         },
-        "resources.CreateCommunityResource": {
+        "resources.PostPageResource": {
             "type": "object",
-            "required": [
-                "description",
-                "name"
-            ],
             "properties": {
-                "bannerUrl": {
-                    "type": "string",
-                    "example": "https://example.com/banner.jpg"
-                },
-                "description": {
-                    "type": "string",
-                    "maxLength": 500,
-                    "minLength": 10,
-                    "example": "A community for data science enthusiasts to share knowledge and collaborate"
-                },
-                "iconUrl": {
-                    "type": "string",
-                    "example": "https://example.com/icon.jpg"
-                },
-                "isPrivate": {
-                    "type": "boolean",
-                    "example": false
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.PostResource"
+                    }
                 },
-                "name": {
+                "nextCursor": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3,
-                    "example": "Data Science Community"
+                    "example": "MTczNjY4MzIwMDo2NGMyZjFlNWI5ZDNhNDVmNzg5MDEyMzQ"
                 }
             }
         },
-        "resources.CreatePostResource": {
+        "resources.PostResource": {
             "type": "object",
-            "required": [
-                "content"
-            ],
             "properties": {
+                "authorId": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440003"
+                },
+                "communityId": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
                 "content": {
                     "type": "string",
-                    "example": "Hello community!"
+                    "example": "Hello students!\nRemember to submit your projects."
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-01-12T12:00:00Z"
+                },
+                "hashtags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "homework"
+                    ]
                 },
                 "images": {
                     "type": "array",
                     "items": {
                         "type": "string"
-                    }
+                    },
+                    "example": [
+                        "https://example.com/image.png"
+                    ]
+                },
+                "mentions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "550e8400-e29b-41d4-a716-446655440004"
+                    ]
+                },
+                "pinOrder": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pinned": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "poll": {
+                    "$ref": "#/definitions/resources.PollResource"
+                },
+                "postId": {
+                    "type": "string",
+                    "example": "64c2f1e5b9d3a45f78901234"
+                },
+                "postType": {
+                    "type": "string",
+                    "example": "announcement"
+                },
+                "publishAt": {
+                    "type": "string",
+                    "example": "2025-01-13T08:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "published"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-01-12T12:05:00Z"
                 }
             }
         },
-        "resources.FeedItemResource": {
+        "resources.PostResultResource": {
             "type": "object",
             "properties": {
                 "authorId": {
@@ -1735,7 +6871,7 @@ const docTemplate = `{
                 },
                 "content": {
                     "type": "string",
-                    "example": "This is an important announcement"
+                    "example": "Generics in Go are finally here"
                 },
                 "createdAt": {
                     "type": "string",
@@ -1743,7 +6879,7 @@ const docTemplate = `{
                 },
                 "messageType": {
                     "type": "string",
-                    "example": "announcement"
+                    "example": "message"
                 },
                 "postId": {
                     "type": "string",
@@ -1755,39 +6891,24 @@ const docTemplate = `{
                 }
             }
         },
-        "resources.FeedResponse": {
-            "type": "object",
-            "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/resources.FeedItemResource"
-                    }
-                },
-                "total": {
-                    "type": "integer",
-                    "example": 10
-                }
-            }
-        },
-        "resources.PostResource": {
+        "resources.PostRevisionResource": {
             "type": "object",
             "properties": {
-                "authorId": {
-                    "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440003"
-                },
                 "communityId": {
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440002"
                 },
                 "content": {
                     "type": "string",
-                    "example": "Hello students!\nRemember to submit your projects."
+                    "example": "Hello community!"
                 },
                 "createdAt": {
                     "type": "string",
-                    "example": "2025-01-12T12:00:00Z"
+                    "example": "2025-01-12T12:05:00Z"
+                },
+                "editedBy": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440003"
                 },
                 "images": {
                     "type": "array",
@@ -1802,9 +6923,9 @@ const docTemplate = `{
                     "type": "string",
                     "example": "64c2f1e5b9d3a45f78901234"
                 },
-                "updatedAt": {
+                "revisionId": {
                     "type": "string",
-                    "example": "2025-01-12T12:05:00Z"
+                    "example": "64c2f1e5b9d3a45f78905678"
                 }
             }
         },
@@ -1862,6 +6983,79 @@ const docTemplate = `{
                 }
             }
         },
+        "resources.SanctionListResource": {
+            "type": "object",
+            "properties": {
+                "sanctions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.SanctionResource"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "resources.SanctionResource": {
+            "type": "object",
+            "properties": {
+                "community_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439012"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2023-01-08T00:00:00Z"
+                },
+                "issued_by": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439011"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Repeated spam after warnings"
+                },
+                "sanction_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439016"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "ban"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "507f1f77bcf86cd799439013"
+                }
+            }
+        },
+        "resources.SearchResponse": {
+            "type": "object",
+            "properties": {
+                "communities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.CommunityResultResource"
+                    }
+                },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/resources.PostResultResource"
+                    }
+                },
+                "query": {
+                    "type": "string",
+                    "example": "golang"
+                }
+            }
+        },
         "resources.SubscribeUserResource": {
             "type": "object",
             "required": [
@@ -1869,17 +7063,20 @@ const docTemplate = `{
                 "role"
             ],
             "properties": {
+                "alumni_role": {
+                    "type": "string",
+                    "example": "alumni"
+                },
                 "community_id": {
                     "type": "string",
                     "example": "507f1f77bcf86cd799439012"
                 },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-06-30T00:00:00Z"
+                },
                 "role": {
                     "type": "string",
-                    "enum": [
-                        "member",
-                        "admin",
-                        "owner"
-                    ],
                     "example": "member"
                 },
                 "user_id": {
@@ -1910,21 +7107,33 @@ const docTemplate = `{
         "resources.SubscriptionListResource": {
             "type": "object",
             "properties": {
+                "next_cursor": {
+                    "type": "string",
+                    "example": "MTczNjY4MzIwMDo1MDdmMWY3N2JjZjg2Y2Q3OTk0MzkwMTE"
+                },
+                "next_offset": {
+                    "type": "integer",
+                    "example": 20
+                },
                 "subscriptions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/resources.SubscriptionResource"
+                        "$ref": "#/definitions/resources.MemberResource"
                     }
                 },
                 "total": {
                     "type": "integer",
-                    "example": 150
+                    "example": 20
                 }
             }
         },
         "resources.SubscriptionResource": {
             "type": "object",
             "properties": {
+                "alumni_role": {
+                    "type": "string",
+                    "example": "alumni"
+                },
                 "community_id": {
                     "type": "string",
                     "example": "507f1f77bcf86cd799439012"
@@ -1933,6 +7142,10 @@ const docTemplate = `{
                     "type": "string",
                     "example": "2023-01-01T00:00:00Z"
                 },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-06-30T00:00:00Z"
+                },
                 "role": {
                     "type": "string",
                     "example": "member"
@@ -1968,6 +7181,18 @@ const docTemplate = `{
                 }
             }
         },
+        "resources.UpdateAdminRoleManagementResource": {
+            "type": "object",
+            "required": [
+                "adminsCanManageAdmins"
+            ],
+            "properties": {
+                "adminsCanManageAdmins": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
         "resources.UpdateBannerURLResource": {
             "type": "object",
             "required": [
@@ -1980,6 +7205,18 @@ const docTemplate = `{
                 }
             }
         },
+        "resources.UpdateCommentResource": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "Great explanation, thanks! (edited)"
+                }
+            }
+        },
         "resources.UpdateCommunityPrivacyResource": {
             "type": "object",
             "required": [
@@ -2017,6 +7254,40 @@ const docTemplate = `{
                 }
             }
         },
+        "resources.UpdateCustomRoleResource": {
+            "type": "object",
+            "properties": {
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "publish_post",
+                        "pin_post",
+                        "delete_any_post"
+                    ]
+                }
+            }
+        },
+        "resources.UpdatePostResource": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "Hello community! (edited)"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "resources.UserResource": {
             "type": "object",
             "properties": {
@@ -2053,6 +7324,24 @@ const docTemplate = `{
                     "example": "johndoe"
                 }
             }
+        },
+        "resources.VotePollResource": {
+            "type": "object",
+            "required": [
+                "optionIds"
+            ],
+            "properties": {
+                "optionIds": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "64c2f1e5b9d3a45f78909999"
+                    ]
+                }
+            }
         }
     },
     "securityDefinitions": {
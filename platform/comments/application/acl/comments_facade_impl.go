@@ -0,0 +1,68 @@
+package acl
+
+import (
+	"context"
+
+	"Gommunity/platform/comments/domain/model/valueobjects"
+	"Gommunity/platform/comments/domain/repositories"
+	"Gommunity/platform/comments/interfaces/acl"
+)
+
+type commentsFacadeImpl struct {
+	commentRepository repositories.CommentRepository
+}
+
+// NewCommentsFacade constructs the comments facade implementation.
+func NewCommentsFacade(commentRepository repositories.CommentRepository) acl.CommentsFacade {
+	return &commentsFacadeImpl{
+		commentRepository: commentRepository,
+	}
+}
+
+// DeleteCommentsByPostIDs removes every comment on the given posts.
+func (f *commentsFacadeImpl) DeleteCommentsByPostIDs(ctx context.Context, postIDs []string) error {
+	postIDVOs := make([]valueobjects.PostID, 0, len(postIDs))
+	for _, id := range postIDs {
+		postIDVO, err := valueobjects.NewPostID(id)
+		if err != nil {
+			return err
+		}
+		postIDVOs = append(postIDVOs, postIDVO)
+	}
+
+	return f.commentRepository.DeleteByPostIDs(ctx, postIDVOs)
+}
+
+// DeleteCommentsByCommunity removes every comment made inside a community.
+func (f *commentsFacadeImpl) DeleteCommentsByCommunity(ctx context.Context, communityID string) error {
+	communityIDVO, err := valueobjects.NewCommunityID(communityID)
+	if err != nil {
+		return err
+	}
+
+	return f.commentRepository.DeleteByCommunity(ctx, communityIDVO)
+}
+
+// AnonymizeAuthor moves the comments of a removed user to an anonymous user ID.
+func (f *commentsFacadeImpl) AnonymizeAuthor(ctx context.Context, authorID, anonymousID string) error {
+	authorIDVO, err := valueobjects.NewAuthorID(authorID)
+	if err != nil {
+		return err
+	}
+	anonymousIDVO, err := valueobjects.NewAuthorID(anonymousID)
+	if err != nil {
+		return err
+	}
+
+	return f.commentRepository.ReassignAuthor(ctx, authorIDVO, anonymousIDVO)
+}
+
+// DeleteCommentsByAuthor removes every comment of a user together with the replies to them.
+func (f *commentsFacadeImpl) DeleteCommentsByAuthor(ctx context.Context, authorID string) error {
+	authorIDVO, err := valueobjects.NewAuthorID(authorID)
+	if err != nil {
+		return err
+	}
+
+	return f.commentRepository.DeleteByAuthor(ctx, authorIDVO)
+}
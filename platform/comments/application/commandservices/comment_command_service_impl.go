@@ -0,0 +1,166 @@
+package commandservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Gommunity/platform/comments/application/outboundservices/acl"
+	"Gommunity/platform/comments/domain/model/commands"
+	"Gommunity/platform/comments/domain/model/entities"
+	"Gommunity/platform/comments/domain/model/valueobjects"
+	"Gommunity/platform/comments/domain/repositories"
+	"Gommunity/platform/comments/domain/services"
+)
+
+type commentCommandServiceImpl struct {
+	commentRepository            repositories.CommentRepository
+	externalPostsService         *acl.ExternalPostsService
+	externalSubscriptionsService *acl.ExternalSubscriptionsService
+	externalCommunitiesService   *acl.ExternalCommunitiesService
+}
+
+// NewCommentCommandService constructs the comments command service implementation.
+func NewCommentCommandService(
+	commentRepository repositories.CommentRepository,
+	externalPostsService *acl.ExternalPostsService,
+	externalSubscriptionsService *acl.ExternalSubscriptionsService,
+	externalCommunitiesService *acl.ExternalCommunitiesService,
+) services.CommentCommandService {
+	return &commentCommandServiceImpl{
+		commentRepository:            commentRepository,
+		externalPostsService:         externalPostsService,
+		externalSubscriptionsService: externalSubscriptionsService,
+		externalCommunitiesService:   externalCommunitiesService,
+	}
+}
+
+// HandleCreate adds a comment to a post, or a reply when a parent comment is given.
+// Only community members can comment, and not in an archived community.
+func (s *commentCommandServiceImpl) HandleCreate(ctx context.Context, cmd commands.CreateCommentCommand) (*valueobjects.CommentID, error) {
+	communityID, err := s.externalPostsService.GetPostCommunityID(ctx, cmd.PostID())
+	if err != nil {
+		return nil, err
+	}
+	if communityID == nil {
+		return nil, errors.New("post not found")
+	}
+
+	archived, err := s.externalCommunitiesService.IsCommunityArchived(ctx, *communityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check community status: %w", err)
+	}
+	if archived {
+		return nil, errors.New("community is archived")
+	}
+
+	isMember, err := s.isMember(ctx, cmd.AuthorID(), *communityID)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, errors.New("only community members can comment")
+	}
+
+	muted, err := s.externalSubscriptionsService.IsUserMuted(ctx, cmd.AuthorID(), *communityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check mute status: %w", err)
+	}
+	if muted {
+		return nil, errors.New("muted members cannot comment")
+	}
+
+	var comment *entities.Comment
+	if cmd.ParentID().IsZero() {
+		comment, err = entities.NewComment(cmd.PostID(), *communityID, cmd.AuthorID(), cmd.Content())
+	} else {
+		parent, findErr := s.commentRepository.FindByID(ctx, cmd.ParentID())
+		if findErr != nil {
+			return nil, fmt.Errorf("failed to retrieve parent comment: %w", findErr)
+		}
+		if parent == nil || !parent.PostID().Equals(cmd.PostID()) {
+			return nil, errors.New("parent comment not found")
+		}
+		comment, err = entities.NewReply(parent, cmd.AuthorID(), cmd.Content())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.commentRepository.Save(ctx, comment); err != nil {
+		return nil, fmt.Errorf("failed to persist comment: %w", err)
+	}
+
+	commentID := comment.CommentID()
+	return &commentID, nil
+}
+
+// HandleUpdate edits the content of a comment. Only the author can edit.
+func (s *commentCommandServiceImpl) HandleUpdate(ctx context.Context, cmd commands.UpdateCommentCommand) error {
+	comment, err := s.commentRepository.FindByID(ctx, cmd.CommentID())
+	if err != nil {
+		return fmt.Errorf("failed to retrieve comment: %w", err)
+	}
+	if comment == nil {
+		return errors.New("comment not found")
+	}
+
+	if !comment.IsAuthor(cmd.RequestedBy()) {
+		return errors.New("only the author can edit a comment")
+	}
+
+	if err := comment.Edit(cmd.Content()); err != nil {
+		return err
+	}
+
+	if err := s.commentRepository.Update(ctx, comment); err != nil {
+		return fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	return nil
+}
+
+// HandleDelete removes a comment and its replies.
+// The author or a member holding the delete_any_post permission can delete a comment.
+func (s *commentCommandServiceImpl) HandleDelete(ctx context.Context, cmd commands.DeleteCommentCommand) error {
+	comment, err := s.commentRepository.FindByID(ctx, cmd.CommentID())
+	if err != nil {
+		return fmt.Errorf("failed to retrieve comment: %w", err)
+	}
+	if comment == nil {
+		return errors.New("comment not found")
+	}
+
+	if !comment.IsAuthor(cmd.RequestedBy()) {
+		canDelete, err := s.externalSubscriptionsService.HasPermission(ctx, cmd.RequestedBy(), comment.CommunityID(), acl.DeleteAnyPostPermission)
+		if err != nil {
+			return fmt.Errorf("failed to verify requester permissions: %w", err)
+		}
+		if !canDelete {
+			return errors.New("only the author or members allowed to delete any post can delete comments")
+		}
+	}
+
+	if err := s.commentRepository.Delete(ctx, cmd.CommentID()); err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	return nil
+}
+
+// isMember checks whether the user is subscribed to the community or owns it.
+func (s *commentCommandServiceImpl) isMember(ctx context.Context, userID valueobjects.AuthorID, communityID valueobjects.CommunityID) (bool, error) {
+	subscribed, err := s.externalSubscriptionsService.IsUserSubscribed(ctx, userID, communityID)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify membership: %w", err)
+	}
+	if subscribed {
+		return true, nil
+	}
+
+	isOwner, err := s.externalCommunitiesService.ValidateUserIsOwner(ctx, communityID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify ownership: %w", err)
+	}
+	return isOwner, nil
+}
@@ -0,0 +1,30 @@
+package acl
+
+import (
+	"context"
+
+	"Gommunity/platform/comments/domain/model/valueobjects"
+	communities_acl "Gommunity/platform/community/interfaces/acl"
+)
+
+// ExternalCommunitiesService provides access to the Communities bounded context.
+type ExternalCommunitiesService struct {
+	communitiesFacade communities_acl.CommunitiesFacade
+}
+
+// NewExternalCommunitiesService builds a new ExternalCommunitiesService.
+func NewExternalCommunitiesService(communitiesFacade communities_acl.CommunitiesFacade) *ExternalCommunitiesService {
+	return &ExternalCommunitiesService{
+		communitiesFacade: communitiesFacade,
+	}
+}
+
+// ValidateUserIsOwner verifies whether the provided user owns the community.
+func (s *ExternalCommunitiesService) ValidateUserIsOwner(ctx context.Context, communityID valueobjects.CommunityID, userID valueobjects.AuthorID) (bool, error) {
+	return s.communitiesFacade.ValidateUserIsOwner(ctx, communityID.Value(), userID.Value())
+}
+
+// IsCommunityArchived checks whether the community was archived after its owner was removed.
+func (s *ExternalCommunitiesService) IsCommunityArchived(ctx context.Context, communityID valueobjects.CommunityID) (bool, error) {
+	return s.communitiesFacade.IsCommunityArchived(ctx, communityID.Value())
+}
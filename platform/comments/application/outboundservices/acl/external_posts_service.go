@@ -0,0 +1,39 @@
+package acl
+
+import (
+	"context"
+	"fmt"
+
+	"Gommunity/platform/comments/domain/model/valueobjects"
+	posts_acl "Gommunity/platform/posts/interfaces/acl"
+)
+
+// ExternalPostsService provides access to the posts bounded context.
+type ExternalPostsService struct {
+	postsFacade posts_acl.PostsFacade
+}
+
+// NewExternalPostsService constructs the external posts service.
+func NewExternalPostsService(postsFacade posts_acl.PostsFacade) *ExternalPostsService {
+	return &ExternalPostsService{
+		postsFacade: postsFacade,
+	}
+}
+
+// GetPostCommunityID resolves the community a post belongs to.
+// Returns nil when the post does not exist.
+func (s *ExternalPostsService) GetPostCommunityID(ctx context.Context, postID valueobjects.PostID) (*valueobjects.CommunityID, error) {
+	communityIDValue, err := s.postsFacade.GetPostCommunityID(ctx, postID.Value())
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve post community: %w", err)
+	}
+	if communityIDValue == "" {
+		return nil, nil
+	}
+
+	communityID, err := valueobjects.NewCommunityID(communityIDValue)
+	if err != nil {
+		return nil, err
+	}
+	return &communityID, nil
+}
@@ -0,0 +1,40 @@
+package acl
+
+import (
+	"context"
+
+	"Gommunity/platform/comments/domain/model/valueobjects"
+	subscriptions_acl "Gommunity/platform/subscriptions/interfaces/acl"
+)
+
+// Community permissions checked by the Comments BC
+const (
+	DeleteAnyPostPermission = subscriptions_acl.DeleteAnyPostPermission
+)
+
+// ExternalSubscriptionsService provides read access to subscription data.
+type ExternalSubscriptionsService struct {
+	subscriptionsFacade subscriptions_acl.SubscriptionsFacade
+}
+
+// NewExternalSubscriptionsService builds a new ExternalSubscriptionsService.
+func NewExternalSubscriptionsService(subscriptionsFacade subscriptions_acl.SubscriptionsFacade) *ExternalSubscriptionsService {
+	return &ExternalSubscriptionsService{
+		subscriptionsFacade: subscriptionsFacade,
+	}
+}
+
+// IsUserSubscribed checks whether the user belongs to the community.
+func (s *ExternalSubscriptionsService) IsUserSubscribed(ctx context.Context, userID valueobjects.AuthorID, communityID valueobjects.CommunityID) (bool, error) {
+	return s.subscriptionsFacade.IsUserSubscribed(ctx, userID.Value(), communityID.Value())
+}
+
+// HasPermission checks whether the user holds a community permission.
+func (s *ExternalSubscriptionsService) HasPermission(ctx context.Context, userID valueobjects.AuthorID, communityID valueobjects.CommunityID, permission string) (bool, error) {
+	return s.subscriptionsFacade.HasPermission(ctx, userID.Value(), communityID.Value(), permission)
+}
+
+// IsUserMuted checks whether the user is currently muted in the community.
+func (s *ExternalSubscriptionsService) IsUserMuted(ctx context.Context, userID valueobjects.AuthorID, communityID valueobjects.CommunityID) (bool, error) {
+	return s.subscriptionsFacade.IsUserMuted(ctx, userID.Value(), communityID.Value())
+}
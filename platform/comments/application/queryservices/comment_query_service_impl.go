@@ -0,0 +1,45 @@
+package queryservices
+
+import (
+	"context"
+
+	"Gommunity/platform/comments/domain/model/entities"
+	"Gommunity/platform/comments/domain/model/queries"
+	"Gommunity/platform/comments/domain/model/valueobjects"
+	"Gommunity/platform/comments/domain/repositories"
+	"Gommunity/platform/comments/domain/services"
+)
+
+type commentQueryServiceImpl struct {
+	commentRepository repositories.CommentRepository
+}
+
+// NewCommentQueryService creates a query service implementation.
+func NewCommentQueryService(commentRepository repositories.CommentRepository) services.CommentQueryService {
+	return &commentQueryServiceImpl{
+		commentRepository: commentRepository,
+	}
+}
+
+// HandleGetByID retrieves a comment by identifier.
+func (s *commentQueryServiceImpl) HandleGetByID(ctx context.Context, query queries.GetCommentByIDQuery) (*entities.Comment, error) {
+	return s.commentRepository.FindByID(ctx, query.CommentID())
+}
+
+// HandleGetByPost retrieves one page of comments and the cursor for the next one.
+func (s *commentQueryServiceImpl) HandleGetByPost(ctx context.Context, query queries.GetCommentsByPostQuery) ([]*entities.Comment, *valueobjects.Cursor, error) {
+	// Fetch one extra row to know whether another page exists
+	comments, err := s.commentRepository.FindByPost(ctx, query.PostID(), query.ParentID(), query.Limit()+1, query.Cursor())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(comments) <= query.Limit() {
+		return comments, nil, nil
+	}
+
+	comments = comments[:query.Limit()]
+	last := comments[len(comments)-1]
+	next := valueobjects.NewCursor(last.CreatedAt(), last.CommentID())
+	return comments, &next, nil
+}
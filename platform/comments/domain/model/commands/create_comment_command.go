@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/comments/domain/model/valueobjects"
+)
+
+// CreateCommentCommand represents the intent to comment on a post or reply to a comment.
+type CreateCommentCommand struct {
+	postID   valueobjects.PostID
+	authorID valueobjects.AuthorID
+	parentID valueobjects.CommentID
+	content  valueobjects.CommentContent
+}
+
+// NewCreateCommentCommand validates and builds a CreateCommentCommand.
+// parentID is optional and left zero for top-level comments.
+func NewCreateCommentCommand(
+	postID valueobjects.PostID,
+	authorID valueobjects.AuthorID,
+	parentID valueobjects.CommentID,
+	content valueobjects.CommentContent,
+) (CreateCommentCommand, error) {
+	if postID.IsZero() {
+		return CreateCommentCommand{}, errors.New("post ID is required")
+	}
+	if authorID.IsZero() {
+		return CreateCommentCommand{}, errors.New("author ID is required")
+	}
+	if content.IsZero() {
+		return CreateCommentCommand{}, errors.New("content is required")
+	}
+
+	return CreateCommentCommand{
+		postID:   postID,
+		authorID: authorID,
+		parentID: parentID,
+		content:  content,
+	}, nil
+}
+
+// PostID returns the post identifier.
+func (c CreateCommentCommand) PostID() valueobjects.PostID {
+	return c.postID
+}
+
+// AuthorID returns the author identifier.
+func (c CreateCommentCommand) AuthorID() valueobjects.AuthorID {
+	return c.authorID
+}
+
+// ParentID returns the comment being replied to, zero for top-level comments.
+func (c CreateCommentCommand) ParentID() valueobjects.CommentID {
+	return c.parentID
+}
+
+// Content returns the comment content.
+func (c CreateCommentCommand) Content() valueobjects.CommentContent {
+	return c.content
+}
@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/comments/domain/model/valueobjects"
+)
+
+// DeleteCommentCommand represents the intent to remove a comment and its replies.
+type DeleteCommentCommand struct {
+	commentID   valueobjects.CommentID
+	requestedBy valueobjects.AuthorID
+}
+
+// NewDeleteCommentCommand builds a DeleteCommentCommand.
+func NewDeleteCommentCommand(
+	commentID valueobjects.CommentID,
+	requestedBy valueobjects.AuthorID,
+) (DeleteCommentCommand, error) {
+	if commentID.IsZero() {
+		return DeleteCommentCommand{}, errors.New("comment ID is required")
+	}
+	if requestedBy.IsZero() {
+		return DeleteCommentCommand{}, errors.New("requesting user ID is required")
+	}
+
+	return DeleteCommentCommand{
+		commentID:   commentID,
+		requestedBy: requestedBy,
+	}, nil
+}
+
+// CommentID returns the comment identifier.
+func (c DeleteCommentCommand) CommentID() valueobjects.CommentID {
+	return c.commentID
+}
+
+// RequestedBy returns the identifier of the user performing the action.
+func (c DeleteCommentCommand) RequestedBy() valueobjects.AuthorID {
+	return c.requestedBy
+}
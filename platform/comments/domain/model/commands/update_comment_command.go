@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/comments/domain/model/valueobjects"
+)
+
+// UpdateCommentCommand represents the intent to edit a comment.
+// Only the author can edit a comment.
+type UpdateCommentCommand struct {
+	commentID   valueobjects.CommentID
+	requestedBy valueobjects.AuthorID
+	content     valueobjects.CommentContent
+}
+
+// NewUpdateCommentCommand validates and builds an UpdateCommentCommand.
+func NewUpdateCommentCommand(
+	commentID valueobjects.CommentID,
+	requestedBy valueobjects.AuthorID,
+	content valueobjects.CommentContent,
+) (UpdateCommentCommand, error) {
+	if commentID.IsZero() {
+		return UpdateCommentCommand{}, errors.New("comment ID is required")
+	}
+	if requestedBy.IsZero() {
+		return UpdateCommentCommand{}, errors.New("requesting user ID is required")
+	}
+	if content.IsZero() {
+		return UpdateCommentCommand{}, errors.New("content is required")
+	}
+
+	return UpdateCommentCommand{
+		commentID:   commentID,
+		requestedBy: requestedBy,
+		content:     content,
+	}, nil
+}
+
+// CommentID returns the comment identifier.
+func (c UpdateCommentCommand) CommentID() valueobjects.CommentID {
+	return c.commentID
+}
+
+// RequestedBy returns the identifier of the user performing the edit.
+func (c UpdateCommentCommand) RequestedBy() valueobjects.AuthorID {
+	return c.requestedBy
+}
+
+// Content returns the new comment content.
+func (c UpdateCommentCommand) Content() valueobjects.CommentContent {
+	return c.content
+}
@@ -0,0 +1,182 @@
+package entities
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"Gommunity/platform/comments/domain/model/valueobjects"
+)
+
+// MaxReplyDepth limits how deeply replies can be nested under a top-level comment.
+const MaxReplyDepth = 5
+
+// Comment represents a member's reply to a post or to another comment.
+type Comment struct {
+	id          string
+	commentID   valueobjects.CommentID
+	postID      valueobjects.PostID
+	communityID valueobjects.CommunityID
+	authorID    valueobjects.AuthorID
+	parentID    valueobjects.CommentID
+	ancestorIDs []valueobjects.CommentID
+	content     valueobjects.CommentContent
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewComment creates a new top-level comment on a post.
+func NewComment(
+	postID valueobjects.PostID,
+	communityID valueobjects.CommunityID,
+	authorID valueobjects.AuthorID,
+	content valueobjects.CommentContent,
+) (*Comment, error) {
+	if postID.IsZero() {
+		return nil, errors.New("post ID is required")
+	}
+	if communityID.IsZero() {
+		return nil, errors.New("community ID is required")
+	}
+	if authorID.IsZero() {
+		return nil, errors.New("author ID is required")
+	}
+	if content.IsZero() {
+		return nil, errors.New("comment content is required")
+	}
+
+	now := time.Now()
+	commentID := valueobjects.GenerateCommentID()
+
+	return &Comment{
+		id:          commentID.Value(),
+		commentID:   commentID,
+		postID:      postID,
+		communityID: communityID,
+		authorID:    authorID,
+		ancestorIDs: []valueobjects.CommentID{},
+		content:     content,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// NewReply creates a comment nested under the given parent comment.
+func NewReply(
+	parent *Comment,
+	authorID valueobjects.AuthorID,
+	content valueobjects.CommentContent,
+) (*Comment, error) {
+	if parent == nil {
+		return nil, errors.New("parent comment is required")
+	}
+	if len(parent.ancestorIDs) >= MaxReplyDepth {
+		return nil, errors.New("replies cannot be nested any deeper")
+	}
+
+	reply, err := NewComment(parent.postID, parent.communityID, authorID, content)
+	if err != nil {
+		return nil, err
+	}
+
+	reply.parentID = parent.commentID
+	reply.ancestorIDs = append(slices.Clone(parent.ancestorIDs), parent.commentID)
+	return reply, nil
+}
+
+// ReconstructComment rebuilds a comment from persistence.
+func ReconstructComment(
+	id string,
+	commentID valueobjects.CommentID,
+	postID valueobjects.PostID,
+	communityID valueobjects.CommunityID,
+	authorID valueobjects.AuthorID,
+	parentID valueobjects.CommentID,
+	ancestorIDs []valueobjects.CommentID,
+	content valueobjects.CommentContent,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Comment {
+	return &Comment{
+		id:          id,
+		commentID:   commentID,
+		postID:      postID,
+		communityID: communityID,
+		authorID:    authorID,
+		parentID:    parentID,
+		ancestorIDs: ancestorIDs,
+		content:     content,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// Edit replaces the content of the comment.
+func (c *Comment) Edit(content valueobjects.CommentContent) error {
+	if content.IsZero() {
+		return errors.New("comment content is required")
+	}
+	c.content = content
+	c.updatedAt = time.Now()
+	return nil
+}
+
+// IsAuthor indicates whether the given user wrote the comment.
+func (c *Comment) IsAuthor(userID valueobjects.AuthorID) bool {
+	return c.authorID.Equals(userID)
+}
+
+// IsReply indicates whether the comment is nested under another comment.
+func (c *Comment) IsReply() bool {
+	return !c.parentID.IsZero()
+}
+
+// ID returns the persistence identifier.
+func (c *Comment) ID() string {
+	return c.id
+}
+
+// CommentID returns the aggregate identifier.
+func (c *Comment) CommentID() valueobjects.CommentID {
+	return c.commentID
+}
+
+// PostID returns the identifier of the commented post.
+func (c *Comment) PostID() valueobjects.PostID {
+	return c.postID
+}
+
+// CommunityID returns the community identifier.
+func (c *Comment) CommunityID() valueobjects.CommunityID {
+	return c.communityID
+}
+
+// AuthorID returns the author identifier.
+func (c *Comment) AuthorID() valueobjects.AuthorID {
+	return c.authorID
+}
+
+// ParentID returns the parent comment identifier, zero for top-level comments.
+func (c *Comment) ParentID() valueobjects.CommentID {
+	return c.parentID
+}
+
+// AncestorIDs returns the chain of parent comments from the top-level comment down.
+func (c *Comment) AncestorIDs() []valueobjects.CommentID {
+	return slices.Clone(c.ancestorIDs)
+}
+
+// Content returns the comment content.
+func (c *Comment) Content() valueobjects.CommentContent {
+	return c.content
+}
+
+// CreatedAt returns the creation timestamp.
+func (c *Comment) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// UpdatedAt returns the update timestamp.
+func (c *Comment) UpdatedAt() time.Time {
+	return c.updatedAt
+}
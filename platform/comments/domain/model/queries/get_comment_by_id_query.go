@@ -0,0 +1,25 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/comments/domain/model/valueobjects"
+)
+
+// GetCommentByIDQuery retrieves a comment by its identifier.
+type GetCommentByIDQuery struct {
+	commentID valueobjects.CommentID
+}
+
+// NewGetCommentByIDQuery validates input and creates the query.
+func NewGetCommentByIDQuery(commentID valueobjects.CommentID) (GetCommentByIDQuery, error) {
+	if commentID.IsZero() {
+		return GetCommentByIDQuery{}, errors.New("comment ID is required")
+	}
+	return GetCommentByIDQuery{commentID: commentID}, nil
+}
+
+// CommentID returns the identifier for lookup.
+func (q GetCommentByIDQuery) CommentID() valueobjects.CommentID {
+	return q.commentID
+}
@@ -0,0 +1,62 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/comments/domain/model/valueobjects"
+)
+
+// DefaultCommentsPageSize is used when no limit is provided.
+const DefaultCommentsPageSize = 20
+
+// GetCommentsByPostQuery retrieves one page of comments on a post.
+// When a parent is set, the replies to that comment are returned instead of top-level comments.
+type GetCommentsByPostQuery struct {
+	postID   valueobjects.PostID
+	parentID valueobjects.CommentID
+	limit    int
+	cursor   valueobjects.Cursor
+}
+
+// NewGetCommentsByPostQuery validates input and creates the query.
+func NewGetCommentsByPostQuery(postID valueobjects.PostID) (GetCommentsByPostQuery, error) {
+	if postID.IsZero() {
+		return GetCommentsByPostQuery{}, errors.New("post ID is required")
+	}
+	return GetCommentsByPostQuery{postID: postID, limit: DefaultCommentsPageSize}, nil
+}
+
+// WithParent restricts the query to the replies of a comment.
+func (q GetCommentsByPostQuery) WithParent(parentID valueobjects.CommentID) GetCommentsByPostQuery {
+	q.parentID = parentID
+	return q
+}
+
+// WithPage sets the page size and the cursor to continue from.
+func (q GetCommentsByPostQuery) WithPage(limit int, cursor valueobjects.Cursor) GetCommentsByPostQuery {
+	if limit > 0 {
+		q.limit = limit
+	}
+	q.cursor = cursor
+	return q
+}
+
+// PostID returns the post identifier.
+func (q GetCommentsByPostQuery) PostID() valueobjects.PostID {
+	return q.postID
+}
+
+// ParentID returns the parent comment, zero for top-level comments.
+func (q GetCommentsByPostQuery) ParentID() valueobjects.CommentID {
+	return q.parentID
+}
+
+// Limit returns the page size.
+func (q GetCommentsByPostQuery) Limit() int {
+	return q.limit
+}
+
+// Cursor returns the position to continue from, zero for the first page.
+func (q GetCommentsByPostQuery) Cursor() valueobjects.Cursor {
+	return q.cursor
+}
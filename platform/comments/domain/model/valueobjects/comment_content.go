@@ -0,0 +1,36 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxCommentLength is the maximum number of characters allowed in a comment.
+const MaxCommentLength = 2000
+
+// CommentContent represents the text of a comment.
+type CommentContent struct {
+	value string `bson:"content"`
+}
+
+// NewCommentContent validates comment content.
+func NewCommentContent(value string) (CommentContent, error) {
+	if strings.TrimSpace(value) == "" {
+		return CommentContent{}, errors.New("comment content cannot be empty")
+	}
+	if utf8.RuneCountInString(value) > MaxCommentLength {
+		return CommentContent{}, errors.New("comment content cannot exceed 2000 characters")
+	}
+	return CommentContent{value: value}, nil
+}
+
+// Value returns the raw content.
+func (c CommentContent) Value() string {
+	return c.value
+}
+
+// IsZero indicates if the content is empty.
+func (c CommentContent) IsZero() bool {
+	return c.value == ""
+}
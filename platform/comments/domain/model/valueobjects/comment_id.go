@@ -0,0 +1,48 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CommentID represents the identifier for a comment aggregate.
+type CommentID struct {
+	value string `bson:"comment_id"`
+}
+
+// NewCommentID creates a new CommentID from an existing value.
+func NewCommentID(value string) (CommentID, error) {
+	if value == "" {
+		return CommentID{}, errors.New("comment ID cannot be empty")
+	}
+	if !primitive.IsValidObjectID(value) {
+		return CommentID{}, errors.New("comment ID must be a valid ObjectID")
+	}
+	return CommentID{value: value}, nil
+}
+
+// GenerateCommentID generates a new CommentID.
+func GenerateCommentID() CommentID {
+	return CommentID{value: primitive.NewObjectID().Hex()}
+}
+
+// Value returns the string value of the identifier.
+func (c CommentID) Value() string {
+	return c.value
+}
+
+// String returns the string form of the identifier.
+func (c CommentID) String() string {
+	return c.value
+}
+
+// IsZero indicates if the identifier is empty.
+func (c CommentID) IsZero() bool {
+	return c.value == ""
+}
+
+// Equals compares two identifiers.
+func (c CommentID) Equals(other CommentID) bool {
+	return c.value == other.value
+}
@@ -0,0 +1,67 @@
+package valueobjects
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cursor is an opaque keyset position (created_at + comment ID) used to page through comments.
+type Cursor struct {
+	createdAt time.Time
+	commentID string
+}
+
+// NewCursor builds a cursor pointing right after the given comment.
+func NewCursor(createdAt time.Time, commentID CommentID) Cursor {
+	return Cursor{createdAt: createdAt, commentID: commentID.Value()}
+}
+
+// ParseCursor decodes an opaque cursor token.
+func ParseCursor(token string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, errors.New("invalid cursor")
+	}
+
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 {
+		return Cursor{}, errors.New("invalid cursor")
+	}
+
+	unix, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return Cursor{}, errors.New("invalid cursor")
+	}
+
+	commentID, err := NewCommentID(parts[1])
+	if err != nil {
+		return Cursor{}, errors.New("invalid cursor")
+	}
+
+	return Cursor{createdAt: time.Unix(unix, 0), commentID: commentID.Value()}, nil
+}
+
+// Encode returns the opaque token form of the cursor.
+func (c Cursor) Encode() string {
+	raw := fmt.Sprintf("%d:%s", c.createdAt.Unix(), c.commentID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// CreatedAt returns the creation timestamp of the last seen comment.
+func (c Cursor) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// CommentID returns the identifier of the last seen comment.
+func (c Cursor) CommentID() string {
+	return c.commentID
+}
+
+// IsZero indicates if the cursor is unset.
+func (c Cursor) IsZero() bool {
+	return c.commentID == ""
+}
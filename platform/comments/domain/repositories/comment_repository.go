@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"context"
+
+	"Gommunity/platform/comments/domain/model/entities"
+	"Gommunity/platform/comments/domain/model/valueobjects"
+)
+
+// CommentRepository defines persistence operations for comment aggregates.
+type CommentRepository interface {
+	Save(ctx context.Context, comment *entities.Comment) error
+	Update(ctx context.Context, comment *entities.Comment) error
+	FindByID(ctx context.Context, commentID valueobjects.CommentID) (*entities.Comment, error)
+
+	// FindByPost returns comments of a post under the given parent (zero for top-level),
+	// oldest first, starting right after the cursor.
+	FindByPost(ctx context.Context, postID valueobjects.PostID, parentID valueobjects.CommentID, limit int, cursor valueobjects.Cursor) ([]*entities.Comment, error)
+
+	// Delete removes a comment together with all of its nested replies
+	Delete(ctx context.Context, commentID valueobjects.CommentID) error
+
+	// DeleteByPostIDs removes comments linked to the provided posts
+	DeleteByPostIDs(ctx context.Context, postIDs []valueobjects.PostID) error
+
+	// DeleteByCommunity removes all comments for a community
+	DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error
+
+	// ReassignAuthor moves every comment of an author to another author ID
+	ReassignAuthor(ctx context.Context, from, to valueobjects.AuthorID) error
+
+	// DeleteByAuthor removes every comment of an author together with all of their nested replies
+	DeleteByAuthor(ctx context.Context, authorID valueobjects.AuthorID) error
+}
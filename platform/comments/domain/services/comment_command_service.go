@@ -0,0 +1,15 @@
+package services
+
+import (
+	"context"
+
+	"Gommunity/platform/comments/domain/model/commands"
+	"Gommunity/platform/comments/domain/model/valueobjects"
+)
+
+// CommentCommandService defines command handling behavior for comments.
+type CommentCommandService interface {
+	HandleCreate(ctx context.Context, cmd commands.CreateCommentCommand) (*valueobjects.CommentID, error)
+	HandleUpdate(ctx context.Context, cmd commands.UpdateCommentCommand) error
+	HandleDelete(ctx context.Context, cmd commands.DeleteCommentCommand) error
+}
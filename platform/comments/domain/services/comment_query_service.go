@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+
+	"Gommunity/platform/comments/domain/model/entities"
+	"Gommunity/platform/comments/domain/model/queries"
+	"Gommunity/platform/comments/domain/model/valueobjects"
+)
+
+// CommentQueryService defines query handling behavior for comments.
+type CommentQueryService interface {
+	HandleGetByID(ctx context.Context, query queries.GetCommentByIDQuery) (*entities.Comment, error)
+
+	// HandleGetByPost returns one page of comments and the cursor for the next page (nil on the last page).
+	HandleGetByPost(ctx context.Context, query queries.GetCommentsByPostQuery) ([]*entities.Comment, *valueobjects.Cursor, error)
+}
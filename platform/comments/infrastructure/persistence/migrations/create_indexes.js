@@ -0,0 +1,35 @@
+// MongoDB indexes for comments collection
+// Run this in MongoDB shell or using mongosh
+
+db.comments.createIndex(
+  { comment_id: 1 },
+  {
+    unique: true,
+    name: "idx_unique_comment_id",
+    background: true
+  }
+);
+
+db.comments.createIndex(
+  { post_id: 1, parent_id: 1, created_at: 1, _id: 1 },
+  {
+    name: "idx_post_comments_thread",
+    background: true
+  }
+);
+
+db.comments.createIndex(
+  { ancestor_ids: 1 },
+  {
+    name: "idx_comment_ancestors",
+    background: true
+  }
+);
+
+db.comments.createIndex(
+  { community_id: 1 },
+  {
+    name: "idx_community_comments",
+    background: true
+  }
+);
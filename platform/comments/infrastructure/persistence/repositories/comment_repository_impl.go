@@ -0,0 +1,311 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"Gommunity/platform/comments/domain/model/entities"
+	"Gommunity/platform/comments/domain/model/valueobjects"
+	domain_repositories "Gommunity/platform/comments/domain/repositories"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type commentRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+// NewCommentRepository creates a MongoDB-backed CommentRepository.
+func NewCommentRepository(collection *mongo.Collection) domain_repositories.CommentRepository {
+	return &commentRepositoryImpl{
+		collection: collection,
+	}
+}
+
+type commentDocument struct {
+	ID          string   `bson:"_id"`
+	CommentID   string   `bson:"comment_id"`
+	PostID      string   `bson:"post_id"`
+	CommunityID string   `bson:"community_id"`
+	AuthorID    string   `bson:"author_id"`
+	ParentID    string   `bson:"parent_id"`
+	AncestorIDs []string `bson:"ancestor_ids"`
+	Content     string   `bson:"content"`
+	CreatedAt   int64    `bson:"created_at"`
+	UpdatedAt   int64    `bson:"updated_at"`
+}
+
+// Save inserts a new comment document.
+func (r *commentRepositoryImpl) Save(ctx context.Context, comment *entities.Comment) error {
+	doc := r.entityToDocument(comment)
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errors.New("comment already exists")
+		}
+		log.Printf("failed to insert comment: %v", err)
+		return err
+	}
+	return nil
+}
+
+// Update persists the editable fields of a comment.
+func (r *commentRepositoryImpl) Update(ctx context.Context, comment *entities.Comment) error {
+	filter := bson.M{"comment_id": comment.CommentID().Value()}
+	update := bson.M{
+		"$set": bson.M{
+			"content":    comment.Content().Value(),
+			"updated_at": comment.UpdatedAt().Unix(),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		log.Printf("failed to update comment: %v", err)
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return errors.New("comment not found")
+	}
+	return nil
+}
+
+// FindByID retrieves a comment by its identifier.
+func (r *commentRepositoryImpl) FindByID(ctx context.Context, commentID valueobjects.CommentID) (*entities.Comment, error) {
+	filter := bson.M{"comment_id": commentID.Value()}
+
+	var doc commentDocument
+	if err := r.collection.FindOne(ctx, filter).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		log.Printf("failed to find comment by id: %v", err)
+		return nil, err
+	}
+	return r.documentToEntity(&doc)
+}
+
+// FindByPost retrieves comments of a post under a parent using keyset pagination.
+func (r *commentRepositoryImpl) FindByPost(
+	ctx context.Context,
+	postID valueobjects.PostID,
+	parentID valueobjects.CommentID,
+	limit int,
+	cursor valueobjects.Cursor,
+) ([]*entities.Comment, error) {
+	filter := bson.M{
+		"post_id":   postID.Value(),
+		"parent_id": parentID.Value(),
+	}
+	if !cursor.IsZero() {
+		createdAt := cursor.CreatedAt().Unix()
+		filter["$or"] = bson.A{
+			bson.M{"created_at": bson.M{"$gt": createdAt}},
+			bson.M{"created_at": createdAt, "_id": bson.M{"$gt": cursor.CommentID()}},
+		}
+	}
+
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursorResult, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		log.Printf("failed to find comments by post: %v", err)
+		return nil, err
+	}
+	defer cursorResult.Close(ctx)
+
+	var comments []*entities.Comment
+	for cursorResult.Next(ctx) {
+		var doc commentDocument
+		if err := cursorResult.Decode(&doc); err != nil {
+			return nil, err
+		}
+		entity, err := r.documentToEntity(&doc)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, entity)
+	}
+
+	if err := cursorResult.Err(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+// Delete removes a comment together with all of its nested replies.
+func (r *commentRepositoryImpl) Delete(ctx context.Context, commentID valueobjects.CommentID) error {
+	filter := bson.M{"$or": bson.A{
+		bson.M{"comment_id": commentID.Value()},
+		bson.M{"ancestor_ids": commentID.Value()},
+	}}
+
+	result, err := r.collection.DeleteMany(ctx, filter)
+	if err != nil {
+		log.Printf("failed to delete comment: %v", err)
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return errors.New("comment not found")
+	}
+	return nil
+}
+
+// DeleteByPostIDs removes comments linked to the provided posts.
+func (r *commentRepositoryImpl) DeleteByPostIDs(ctx context.Context, postIDs []valueobjects.PostID) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(postIDs))
+	for i, id := range postIDs {
+		ids[i] = id.Value()
+	}
+
+	filter := bson.M{"post_id": bson.M{"$in": ids}}
+	if _, err := r.collection.DeleteMany(ctx, filter); err != nil {
+		log.Printf("failed to delete comments by post ids: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteByCommunity removes all comments for a community.
+func (r *commentRepositoryImpl) DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error {
+	filter := bson.M{"community_id": communityID.Value()}
+	if _, err := r.collection.DeleteMany(ctx, filter); err != nil {
+		log.Printf("failed to delete comments by community: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ReassignAuthor moves every comment of an author to another author ID.
+func (r *commentRepositoryImpl) ReassignAuthor(ctx context.Context, from, to valueobjects.AuthorID) error {
+	filter := bson.M{"author_id": from.Value()}
+	update := bson.M{"$set": bson.M{"author_id": to.Value()}}
+	if _, err := r.collection.UpdateMany(ctx, filter, update); err != nil {
+		log.Printf("failed to reassign comment author: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteByAuthor removes every comment of an author together with all of their nested replies,
+// so that no reply is left pointing at a missing parent.
+func (r *commentRepositoryImpl) DeleteByAuthor(ctx context.Context, authorID valueobjects.AuthorID) error {
+	filter := bson.M{"author_id": authorID.Value()}
+	projection := bson.M{"comment_id": 1}
+
+	cursor, err := r.collection.Find(ctx, filter, options.Find().SetProjection(projection))
+	if err != nil {
+		log.Printf("failed to list comments by author: %v", err)
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	var ids []string
+	for cursor.Next(ctx) {
+		var doc commentDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+		ids = append(ids, doc.CommentID)
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	deleteFilter := bson.M{"$or": bson.A{
+		bson.M{"comment_id": bson.M{"$in": ids}},
+		bson.M{"ancestor_ids": bson.M{"$in": ids}},
+	}}
+	if _, err := r.collection.DeleteMany(ctx, deleteFilter); err != nil {
+		log.Printf("failed to delete comments by author: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *commentRepositoryImpl) entityToDocument(comment *entities.Comment) *commentDocument {
+	ancestors := comment.AncestorIDs()
+	ancestorIDs := make([]string, len(ancestors))
+	for i, id := range ancestors {
+		ancestorIDs[i] = id.Value()
+	}
+
+	return &commentDocument{
+		ID:          comment.CommentID().Value(),
+		CommentID:   comment.CommentID().Value(),
+		PostID:      comment.PostID().Value(),
+		CommunityID: comment.CommunityID().Value(),
+		AuthorID:    comment.AuthorID().Value(),
+		ParentID:    comment.ParentID().Value(),
+		AncestorIDs: ancestorIDs,
+		Content:     comment.Content().Value(),
+		CreatedAt:   comment.CreatedAt().Unix(),
+		UpdatedAt:   comment.UpdatedAt().Unix(),
+	}
+}
+
+func (r *commentRepositoryImpl) documentToEntity(doc *commentDocument) (*entities.Comment, error) {
+	commentID, err := valueobjects.NewCommentID(doc.CommentID)
+	if err != nil {
+		return nil, err
+	}
+	postID, err := valueobjects.NewPostID(doc.PostID)
+	if err != nil {
+		return nil, err
+	}
+	communityID, err := valueobjects.NewCommunityID(doc.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	authorID, err := valueobjects.NewAuthorID(doc.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+	content, err := valueobjects.NewCommentContent(doc.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	var parentID valueobjects.CommentID
+	if doc.ParentID != "" {
+		parentID, err = valueobjects.NewCommentID(doc.ParentID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ancestorIDs := make([]valueobjects.CommentID, 0, len(doc.AncestorIDs))
+	for _, raw := range doc.AncestorIDs {
+		ancestorID, err := valueobjects.NewCommentID(raw)
+		if err != nil {
+			return nil, err
+		}
+		ancestorIDs = append(ancestorIDs, ancestorID)
+	}
+
+	return entities.ReconstructComment(
+		doc.ID,
+		commentID,
+		postID,
+		communityID,
+		authorID,
+		parentID,
+		ancestorIDs,
+		content,
+		time.Unix(doc.CreatedAt, 0),
+		time.Unix(doc.UpdatedAt, 0),
+	), nil
+}
@@ -0,0 +1,18 @@
+package acl
+
+import "context"
+
+// CommentsFacade exposes comments operations to other bounded contexts.
+type CommentsFacade interface {
+	// DeleteCommentsByPostIDs removes every comment on the given posts.
+	DeleteCommentsByPostIDs(ctx context.Context, postIDs []string) error
+
+	// DeleteCommentsByCommunity removes every comment made inside a community.
+	DeleteCommentsByCommunity(ctx context.Context, communityID string) error
+
+	// AnonymizeAuthor moves the comments of a removed user to an anonymous user ID.
+	AnonymizeAuthor(ctx context.Context, authorID, anonymousID string) error
+
+	// DeleteCommentsByAuthor removes every comment of a user together with the replies to them.
+	DeleteCommentsByAuthor(ctx context.Context, authorID string) error
+}
@@ -0,0 +1,393 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"Gommunity/platform/comments/domain/model/commands"
+	"Gommunity/platform/comments/domain/model/entities"
+	"Gommunity/platform/comments/domain/model/queries"
+	"Gommunity/platform/comments/domain/model/valueobjects"
+	"Gommunity/platform/comments/domain/services"
+	"Gommunity/platform/comments/interfaces/rest/resources"
+	"Gommunity/shared/infrastructure/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CommentController handles HTTP requests for comments.
+type CommentController struct {
+	commandService services.CommentCommandService
+	queryService   services.CommentQueryService
+}
+
+// NewCommentController builds a CommentController.
+func NewCommentController(
+	commandService services.CommentCommandService,
+	queryService services.CommentQueryService,
+) *CommentController {
+	return &CommentController{
+		commandService: commandService,
+		queryService:   queryService,
+	}
+}
+
+// CreateComment godoc
+// @Summary Comment on a post
+// @Description Adds a comment to a post, or a reply when parentId is provided. Only community members can comment.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param post_id path string true "Post ID (ObjectID)"
+// @Param request body resources.CreateCommentResource true "Comment payload"
+// @Success 201 {object} resources.CommentResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/posts/{post_id}/comments [post]
+func (c *CommentController) CreateComment(ctx *gin.Context) {
+	postIDValue := ctx.Param("post_id")
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, resources.ErrorResponse{Error: "authentication required"})
+		return
+	}
+
+	var req resources.CreateCommentResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+
+	postID, err := valueobjects.NewPostID(postIDValue)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid post id"})
+		return
+	}
+
+	authorID, err := valueobjects.NewAuthorID(userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid author id"})
+		return
+	}
+
+	var parentID valueobjects.CommentID
+	if req.ParentID != "" {
+		parentID, err = valueobjects.NewCommentID(req.ParentID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid parent id"})
+			return
+		}
+	}
+
+	content, err := valueobjects.NewCommentContent(req.Content)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewCreateCommentCommand(postID, authorID, parentID, content)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	commentID, err := c.commandService.HandleCreate(ctx.Request.Context(), cmd)
+	if err != nil {
+		ctx.JSON(mapErrorToStatus(err), resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	query, _ := queries.NewGetCommentByIDQuery(*commentID)
+	comment, err := c.queryService.HandleGetByID(ctx.Request.Context(), query)
+	if err != nil || comment == nil {
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{Error: "unable to retrieve created comment"})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, c.toResource(comment))
+}
+
+// GetCommentsByPost godoc
+// @Summary List comments on a post
+// @Description Retrieves top-level comments on a post, oldest first, using cursor pagination.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param post_id path string true "Post ID (ObjectID)"
+// @Param limit query int false "Page size" minimum(1) maximum(100)
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Success 200 {object} resources.CommentPageResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/posts/{post_id}/comments [get]
+func (c *CommentController) GetCommentsByPost(ctx *gin.Context) {
+	postID, err := valueobjects.NewPostID(ctx.Param("post_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid post id"})
+		return
+	}
+
+	query, _ := queries.NewGetCommentsByPostQuery(postID)
+	c.respondWithPage(ctx, query)
+}
+
+// GetCommentReplies godoc
+// @Summary List replies to a comment
+// @Description Retrieves direct replies to a comment, oldest first, using cursor pagination.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param post_id path string true "Post ID (ObjectID)"
+// @Param comment_id path string true "Comment ID (ObjectID)"
+// @Param limit query int false "Page size" minimum(1) maximum(100)
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Success 200 {object} resources.CommentPageResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/posts/{post_id}/comments/{comment_id}/replies [get]
+func (c *CommentController) GetCommentReplies(ctx *gin.Context) {
+	postID, err := valueobjects.NewPostID(ctx.Param("post_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid post id"})
+		return
+	}
+
+	commentID, err := valueobjects.NewCommentID(ctx.Param("comment_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid comment id"})
+		return
+	}
+
+	query, _ := queries.NewGetCommentsByPostQuery(postID)
+	c.respondWithPage(ctx, query.WithParent(commentID))
+}
+
+// UpdateComment godoc
+// @Summary Edit a comment
+// @Description Only the author can edit a comment.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param post_id path string true "Post ID (ObjectID)"
+// @Param comment_id path string true "Comment ID (ObjectID)"
+// @Param request body resources.UpdateCommentResource true "Comment payload"
+// @Success 200 {object} resources.CommentResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/posts/{post_id}/comments/{comment_id} [put]
+func (c *CommentController) UpdateComment(ctx *gin.Context) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, resources.ErrorResponse{Error: "authentication required"})
+		return
+	}
+
+	var req resources.UpdateCommentResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+
+	commentID, ok := c.commentOnPost(ctx)
+	if !ok {
+		return
+	}
+
+	requesterID, err := valueobjects.NewAuthorID(userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid requester id"})
+		return
+	}
+
+	content, err := valueobjects.NewCommentContent(req.Content)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewUpdateCommentCommand(commentID, requesterID, content)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleUpdate(ctx.Request.Context(), cmd); err != nil {
+		ctx.JSON(mapErrorToStatus(err), resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	query, _ := queries.NewGetCommentByIDQuery(commentID)
+	comment, err := c.queryService.HandleGetByID(ctx.Request.Context(), query)
+	if err != nil || comment == nil {
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{Error: "unable to retrieve updated comment"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.toResource(comment))
+}
+
+// DeleteComment godoc
+// @Summary Delete a comment
+// @Description The author or a member holding the delete_any_post permission can delete a comment. Nested replies are removed as well.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param post_id path string true "Post ID (ObjectID)"
+// @Param comment_id path string true "Comment ID (ObjectID)"
+// @Success 204 "Comment deleted"
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/posts/{post_id}/comments/{comment_id} [delete]
+func (c *CommentController) DeleteComment(ctx *gin.Context) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, resources.ErrorResponse{Error: "authentication required"})
+		return
+	}
+
+	commentID, ok := c.commentOnPost(ctx)
+	if !ok {
+		return
+	}
+
+	requesterID, err := valueobjects.NewAuthorID(userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid requester id"})
+		return
+	}
+
+	cmd, err := commands.NewDeleteCommentCommand(commentID, requesterID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleDelete(ctx.Request.Context(), cmd); err != nil {
+		ctx.JSON(mapErrorToStatus(err), resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// commentOnPost parses the path identifiers and checks that the comment belongs to the post.
+// It writes the error response itself and returns false on failure.
+func (c *CommentController) commentOnPost(ctx *gin.Context) (valueobjects.CommentID, bool) {
+	postID, err := valueobjects.NewPostID(ctx.Param("post_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid post id"})
+		return valueobjects.CommentID{}, false
+	}
+
+	commentID, err := valueobjects.NewCommentID(ctx.Param("comment_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid comment id"})
+		return valueobjects.CommentID{}, false
+	}
+
+	query, _ := queries.NewGetCommentByIDQuery(commentID)
+	comment, err := c.queryService.HandleGetByID(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{Error: "failed to retrieve comment"})
+		return valueobjects.CommentID{}, false
+	}
+	if comment == nil || !comment.PostID().Equals(postID) {
+		ctx.JSON(http.StatusNotFound, resources.ErrorResponse{Error: "comment not found"})
+		return valueobjects.CommentID{}, false
+	}
+
+	return commentID, true
+}
+
+func (c *CommentController) respondWithPage(ctx *gin.Context, query queries.GetCommentsByPostQuery) {
+	limit := 0
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed <= 0 {
+			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "limit must be a positive number"})
+			return
+		}
+		if parsed > 100 {
+			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "limit cannot exceed 100"})
+			return
+		}
+		limit = parsed
+	}
+
+	var cursor valueobjects.Cursor
+	if cursorStr := ctx.Query("cursor"); cursorStr != "" {
+		parsed, err := valueobjects.ParseCursor(cursorStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+			return
+		}
+		cursor = parsed
+	}
+
+	comments, next, err := c.queryService.HandleGetByPost(ctx.Request.Context(), query.WithPage(limit, cursor))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{Error: "failed to list comments"})
+		return
+	}
+
+	response := resources.CommentPageResource{
+		Items: make([]resources.CommentResource, 0, len(comments)),
+	}
+	for _, comment := range comments {
+		response.Items = append(response.Items, c.toResource(comment))
+	}
+	if next != nil {
+		response.NextCursor = next.Encode()
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *CommentController) toResource(comment *entities.Comment) resources.CommentResource {
+	return resources.CommentResource{
+		CommentID:   comment.CommentID().Value(),
+		PostID:      comment.PostID().Value(),
+		CommunityID: comment.CommunityID().Value(),
+		AuthorID:    comment.AuthorID().Value(),
+		ParentID:    comment.ParentID().Value(),
+		Depth:       len(comment.AncestorIDs()),
+		Content:     comment.Content().Value(),
+		CreatedAt:   comment.CreatedAt(),
+		UpdatedAt:   comment.UpdatedAt(),
+	}
+}
+
+func mapErrorToStatus(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	lower := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(lower, "not found"):
+		return http.StatusNotFound
+	case strings.Contains(lower, "already") || strings.Contains(lower, "archived"):
+		return http.StatusConflict
+	case strings.Contains(lower, "only") || strings.Contains(lower, "not allowed") || strings.Contains(lower, "must") || strings.Contains(lower, "muted"):
+		return http.StatusForbidden
+	default:
+		return http.StatusBadRequest
+	}
+}
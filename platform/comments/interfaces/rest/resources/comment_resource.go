@@ -0,0 +1,38 @@
+package resources
+
+import "time"
+
+// CommentResource represents a comment in responses.
+type CommentResource struct {
+	CommentID   string    `json:"commentId" example:"64c2f1e5b9d3a45f78909abc"`
+	PostID      string    `json:"postId" example:"64c2f1e5b9d3a45f78901234"`
+	CommunityID string    `json:"communityId" example:"550e8400-e29b-41d4-a716-446655440002"`
+	AuthorID    string    `json:"authorId" example:"550e8400-e29b-41d4-a716-446655440003"`
+	ParentID    string    `json:"parentId,omitempty" example:"64c2f1e5b9d3a45f78905678"`
+	Depth       int       `json:"depth" example:"0"`
+	Content     string    `json:"content" example:"Great explanation, thanks!"`
+	CreatedAt   time.Time `json:"createdAt" example:"2025-01-12T12:00:00Z"`
+	UpdatedAt   time.Time `json:"updatedAt" example:"2025-01-12T12:05:00Z"`
+}
+
+// CommentPageResource represents one page of comments.
+type CommentPageResource struct {
+	Items      []CommentResource `json:"items"`
+	NextCursor string            `json:"nextCursor,omitempty" example:"MTczNjY4MzIwMDo2NGMyZjFlNWI5ZDNhNDVmNzg5MDlhYmM"`
+}
+
+// CreateCommentResource represents the payload to add a comment or reply.
+type CreateCommentResource struct {
+	Content  string `json:"content" example:"Great explanation, thanks!" binding:"required"`
+	ParentID string `json:"parentId" example:"64c2f1e5b9d3a45f78905678"`
+}
+
+// UpdateCommentResource represents the payload to edit a comment.
+type UpdateCommentResource struct {
+	Content string `json:"content" example:"Great explanation, thanks! (edited)" binding:"required"`
+}
+
+// ErrorResponse represents an error payload.
+type ErrorResponse struct {
+	Error string `json:"error" example:"Invalid request"`
+}
@@ -51,6 +51,44 @@ func (f *communitiesFacadeImpl) IsCommunityPrivate(ctx context.Context, communit
 	return community.IsPrivate(), nil
 }
 
+// IsCommunityArchived checks if a community was archived
+func (f *communitiesFacadeImpl) IsCommunityArchived(ctx context.Context, communityID string) (bool, error) {
+	communityIDVO, err := valueobjects.NewCommunityID(communityID)
+	if err != nil {
+		return false, err
+	}
+
+	community, err := f.communityRepository.FindByID(ctx, communityIDVO)
+	if err != nil {
+		return false, err
+	}
+
+	if community == nil {
+		return false, errors.New("community not found")
+	}
+
+	return community.IsArchived(), nil
+}
+
+// AdminsCanManageAdmins reports whether the owner lets admins manage other admins
+func (f *communitiesFacadeImpl) AdminsCanManageAdmins(ctx context.Context, communityID string) (bool, error) {
+	communityIDVO, err := valueobjects.NewCommunityID(communityID)
+	if err != nil {
+		return false, err
+	}
+
+	community, err := f.communityRepository.FindByID(ctx, communityIDVO)
+	if err != nil {
+		return false, err
+	}
+
+	if community == nil {
+		return false, errors.New("community not found")
+	}
+
+	return community.AdminsCanManageAdmins(), nil
+}
+
 // GetCommunityOwnerID retrieves the owner ID of a community (as string UUID)
 func (f *communitiesFacadeImpl) GetCommunityOwnerID(ctx context.Context, communityID string) (string, error) {
 	communityIDVO, err := valueobjects.NewCommunityID(communityID)
@@ -79,3 +117,65 @@ func (f *communitiesFacadeImpl) ValidateUserIsOwner(ctx context.Context, communi
 
 	return actualOwnerID == ownerID, nil
 }
+
+// SearchCommunities runs a full-text search on community name and description, most relevant first
+func (f *communitiesFacadeImpl) SearchCommunities(ctx context.Context, text string, limit, offset int) ([]*acl.CommunitySearchData, error) {
+	communities, err := f.communityRepository.Search(ctx, text, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*acl.CommunitySearchData, len(communities))
+	for i, community := range communities {
+		results[i] = &acl.CommunitySearchData{
+			CommunityID: community.CommunityID().Value(),
+			Name:        community.Name().Value(),
+			Description: community.Description().Value(),
+			IconURL:     community.IconURL(),
+			IsPrivate:   community.IsPrivate(),
+		}
+	}
+	return results, nil
+}
+
+// GetCommunitiesByIDs retrieves the details of several communities in a single lookup
+func (f *communitiesFacadeImpl) GetCommunitiesByIDs(ctx context.Context, communityIDs []string) ([]*acl.CommunitySummaryData, error) {
+	communityIDVOs := make([]valueobjects.CommunityID, 0, len(communityIDs))
+	for _, communityID := range communityIDs {
+		communityIDVO, err := valueobjects.NewCommunityID(communityID)
+		if err != nil {
+			return nil, err
+		}
+		communityIDVOs = append(communityIDVOs, communityIDVO)
+	}
+
+	communities, err := f.communityRepository.FindByIDs(ctx, communityIDVOs)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*acl.CommunitySummaryData, len(communities))
+	for i, community := range communities {
+		results[i] = &acl.CommunitySummaryData{
+			CommunityID: community.CommunityID().Value(),
+			Name:        community.Name().Value(),
+			IconURL:     community.IconURL(),
+			IsPrivate:   community.IsPrivate(),
+		}
+	}
+	return results, nil
+}
+
+// GetPublicCommunityIDs retrieves the IDs of every public community
+func (f *communitiesFacadeImpl) GetPublicCommunityIDs(ctx context.Context) ([]string, error) {
+	communityIDs, err := f.communityRepository.FindPublicIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(communityIDs))
+	for i, communityID := range communityIDs {
+		ids[i] = communityID.Value()
+	}
+	return ids, nil
+}
@@ -8,29 +8,45 @@ import (
 	"Gommunity/platform/community/application/outboundservices/acl"
 	"Gommunity/platform/community/domain/model/commands"
 	"Gommunity/platform/community/domain/model/entities"
+	"Gommunity/platform/community/domain/model/events"
 	"Gommunity/platform/community/domain/model/valueobjects"
 	"Gommunity/platform/community/domain/repositories"
 	"Gommunity/platform/community/domain/services"
 )
 
+// memberCountBatchSize bounds how many communities are counted at once when refreshing member counts.
+const memberCountBatchSize = 200
+
 type communityCommandServiceImpl struct {
 	communityRepo                repositories.CommunityRepository
+	ownershipTransferRepo        repositories.OwnershipTransferRepository
+	outboxRepo                   repositories.OutboxRepository
+	unitOfWork                   repositories.UnitOfWork
 	externalSubscriptionsService *acl.ExternalSubscriptionsService
 	externalPostsService         *acl.ExternalPostsService
 	externalReactionsService     *acl.ExternalReactionsService
+	externalCommentsService      *acl.ExternalCommentsService
 }
 
 func NewCommunityCommandService(
 	communityRepo repositories.CommunityRepository,
+	ownershipTransferRepo repositories.OwnershipTransferRepository,
+	outboxRepo repositories.OutboxRepository,
+	unitOfWork repositories.UnitOfWork,
 	externalSubscriptionsService *acl.ExternalSubscriptionsService,
 	externalPostsService *acl.ExternalPostsService,
 	externalReactionsService *acl.ExternalReactionsService,
+	externalCommentsService *acl.ExternalCommentsService,
 ) services.CommunityCommandService {
 	return &communityCommandServiceImpl{
 		communityRepo:                communityRepo,
+		ownershipTransferRepo:        ownershipTransferRepo,
+		outboxRepo:                   outboxRepo,
+		unitOfWork:                   unitOfWork,
 		externalSubscriptionsService: externalSubscriptionsService,
 		externalPostsService:         externalPostsService,
 		externalReactionsService:     externalReactionsService,
+		externalCommentsService:      externalCommentsService,
 	}
 }
 
@@ -51,8 +67,15 @@ func (s *communityCommandServiceImpl) HandleCreate(ctx context.Context, cmd comm
 		return nil, err
 	}
 
-	// Save community
-	if err := s.communityRepo.Save(ctx, community); err != nil {
+	// Save community and record its creation for other services in the same transaction
+	event := events.NewCommunityCreatedEvent(community.CommunityID(), community.OwnerID(), community.Name().Value())
+	err = s.unitOfWork.Execute(ctx, func(txCtx context.Context) error {
+		if err := s.communityRepo.Save(txCtx, community); err != nil {
+			return err
+		}
+		return s.outboxRepo.SaveCommunityCreated(txCtx, event)
+	})
+	if err != nil {
 		log.Printf("Error saving community: %v", err)
 		return nil, err
 	}
@@ -99,7 +122,7 @@ func (s *communityCommandServiceImpl) HandleDelete(ctx context.Context, cmd comm
 		return err
 	}
 
-	// Cascade delete: reactions -> posts -> subscriptions (followers)
+	// Cascade delete: reactions -> comments -> posts -> subscriptions (followers)
 	postIDs, err := s.externalPostsService.GetPostIDsByCommunity(ctx, cmd.CommunityID())
 	if err != nil {
 		log.Printf("Error fetching post IDs for cascade delete: %v", err)
@@ -111,6 +134,11 @@ func (s *communityCommandServiceImpl) HandleDelete(ctx context.Context, cmd comm
 		return err
 	}
 
+	if err := s.externalCommentsService.DeleteCommentsByCommunity(ctx, cmd.CommunityID()); err != nil {
+		log.Printf("Error deleting comments for community %s: %v", cmd.CommunityID().Value(), err)
+		return err
+	}
+
 	if err := s.externalPostsService.DeletePostsByCommunity(ctx, cmd.CommunityID()); err != nil {
 		log.Printf("Error deleting posts for community %s: %v", cmd.CommunityID().Value(), err)
 		return err
@@ -121,6 +149,11 @@ func (s *communityCommandServiceImpl) HandleDelete(ctx context.Context, cmd comm
 		return err
 	}
 
+	if err := s.ownershipTransferRepo.DeleteByCommunity(ctx, cmd.CommunityID()); err != nil {
+		log.Printf("Error deleting ownership transfers for community %s: %v", cmd.CommunityID().Value(), err)
+		return err
+	}
+
 	log.Printf("Community deleted successfully: %s", cmd.CommunityID().Value())
 	return nil
 }
@@ -152,6 +185,31 @@ func (s *communityCommandServiceImpl) HandleUpdatePrivacy(ctx context.Context, c
 	return nil
 }
 
+func (s *communityCommandServiceImpl) HandleUpdateAdminRoleManagement(ctx context.Context, cmd commands.UpdateAdminRoleManagementCommand) error {
+	log.Printf("Updating admin role management for community: %s", cmd.CommunityID().Value())
+
+	// Find community
+	community, err := s.communityRepo.FindByID(ctx, cmd.CommunityID())
+	if err != nil {
+		log.Printf("Error finding community: %v", err)
+		return err
+	}
+
+	if community == nil {
+		return errors.New("community not found")
+	}
+
+	community.UpdateAdminRoleManagement(cmd.Allowed())
+
+	if err := s.communityRepo.Update(ctx, community); err != nil {
+		log.Printf("Error updating community admin role management: %v", err)
+		return err
+	}
+
+	log.Printf("Community admin role management updated successfully: %s, adminsCanManageAdmins: %v", cmd.CommunityID().Value(), cmd.Allowed())
+	return nil
+}
+
 func (s *communityCommandServiceImpl) HandleUpdateInfo(ctx context.Context, cmd commands.UpdateCommunityInfoCommand) error {
 	log.Printf("Updating info for community: %s", cmd.CommunityID().Value())
 
@@ -178,3 +236,39 @@ func (s *communityCommandServiceImpl) HandleUpdateInfo(ctx context.Context, cmd
 	log.Printf("Community info updated successfully: %s", cmd.CommunityID().Value())
 	return nil
 }
+
+// HandleRefreshMemberCounts walks every community in batches, counting the members of a whole batch
+// in a single call. Communities created in the meantime are picked up by the next run.
+func (s *communityCommandServiceImpl) HandleRefreshMemberCounts(ctx context.Context) (int, error) {
+	refreshed := 0
+	var after valueobjects.CommunityID
+	for {
+		communityIDs, err := s.communityRepo.FindIDsAfter(ctx, after, memberCountBatchSize)
+		if err != nil {
+			return refreshed, err
+		}
+		if len(communityIDs) == 0 {
+			return refreshed, nil
+		}
+
+		counts, err := s.externalSubscriptionsService.CountMembers(ctx, communityIDs)
+		if err != nil {
+			return refreshed, err
+		}
+
+		// Communities without members are absent from the counts but still need a zero stored
+		batch := make(map[string]int64, len(communityIDs))
+		for _, communityID := range communityIDs {
+			batch[communityID.Value()] = counts[communityID.Value()]
+		}
+		if err := s.communityRepo.UpdateMemberCounts(ctx, batch); err != nil {
+			return refreshed, err
+		}
+		refreshed += len(communityIDs)
+
+		if len(communityIDs) < memberCountBatchSize {
+			return refreshed, nil
+		}
+		after = communityIDs[len(communityIDs)-1]
+	}
+}
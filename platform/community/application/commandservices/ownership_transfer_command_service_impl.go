@@ -0,0 +1,363 @@
+package commandservices
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"Gommunity/platform/community/application/outboundservices/acl"
+	"Gommunity/platform/community/domain/model/commands"
+	"Gommunity/platform/community/domain/model/entities"
+	"Gommunity/platform/community/domain/model/events"
+	"Gommunity/platform/community/domain/model/valueobjects"
+	"Gommunity/platform/community/domain/repositories"
+	"Gommunity/platform/community/domain/services"
+)
+
+type ownershipTransferCommandServiceImpl struct {
+	communityRepo                repositories.CommunityRepository
+	ownershipTransferRepo        repositories.OwnershipTransferRepository
+	auditLogRepo                 repositories.AuditLogRepository
+	outboxRepo                   repositories.OutboxRepository
+	unitOfWork                   repositories.UnitOfWork
+	externalSubscriptionsService *acl.ExternalSubscriptionsService
+}
+
+func NewOwnershipTransferCommandService(
+	communityRepo repositories.CommunityRepository,
+	ownershipTransferRepo repositories.OwnershipTransferRepository,
+	auditLogRepo repositories.AuditLogRepository,
+	outboxRepo repositories.OutboxRepository,
+	unitOfWork repositories.UnitOfWork,
+	externalSubscriptionsService *acl.ExternalSubscriptionsService,
+) services.OwnershipTransferCommandService {
+	return &ownershipTransferCommandServiceImpl{
+		communityRepo:                communityRepo,
+		ownershipTransferRepo:        ownershipTransferRepo,
+		auditLogRepo:                 auditLogRepo,
+		outboxRepo:                   outboxRepo,
+		unitOfWork:                   unitOfWork,
+		externalSubscriptionsService: externalSubscriptionsService,
+	}
+}
+
+func (s *ownershipTransferCommandServiceImpl) HandleNominate(ctx context.Context, cmd commands.NominateOwnerCommand) (*entities.OwnershipTransfer, error) {
+	log.Printf("Nominating user %s as owner of community %s", cmd.NomineeID().Value(), cmd.CommunityID().Value())
+
+	community, err := s.findCommunity(ctx, cmd.CommunityID())
+	if err != nil {
+		return nil, err
+	}
+
+	if !community.IsOwner(cmd.OwnerID().Value()) {
+		return nil, errors.New("only the owner can transfer ownership")
+	}
+
+	// The nominee has to be an existing admin or member of the community
+	role, err := s.externalSubscriptionsService.GetMemberRole(ctx, cmd.NomineeID().Value(), cmd.CommunityID())
+	if err != nil {
+		log.Printf("Error checking nominee membership: %v", err)
+		return nil, err
+	}
+	if role == "" {
+		return nil, errors.New("the nominee is not a member of this community")
+	}
+
+	pending, err := s.ownershipTransferRepo.FindPendingByCommunity(ctx, cmd.CommunityID())
+	if err != nil {
+		log.Printf("Error finding pending ownership transfer: %v", err)
+		return nil, err
+	}
+	if pending != nil {
+		return nil, errors.New("an ownership transfer is already pending for this community")
+	}
+
+	transfer, err := entities.NewOwnershipTransfer(cmd.CommunityID(), community.OwnerID(), cmd.NomineeID())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ownershipTransferRepo.Save(ctx, transfer); err != nil {
+		log.Printf("Error saving ownership transfer: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Ownership transfer created successfully: %s", transfer.TransferID().Value())
+	return transfer, nil
+}
+
+func (s *ownershipTransferCommandServiceImpl) HandleRespond(ctx context.Context, cmd commands.RespondToOwnershipTransferCommand) (*entities.OwnershipTransfer, error) {
+	log.Printf("User %s responding to ownership transfer of community %s, accept: %v", cmd.NomineeID().Value(), cmd.CommunityID().Value(), cmd.Accept())
+
+	community, err := s.findCommunity(ctx, cmd.CommunityID())
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := s.findPendingTransfer(ctx, cmd.CommunityID())
+	if err != nil {
+		return nil, err
+	}
+
+	if !transfer.IsNominee(cmd.NomineeID().Value()) {
+		return nil, errors.New("only the nominee can respond to the ownership transfer")
+	}
+
+	if !cmd.Accept() {
+		if err := transfer.Decline(); err != nil {
+			return nil, err
+		}
+		if err := s.ownershipTransferRepo.Update(ctx, transfer); err != nil {
+			log.Printf("Error updating ownership transfer: %v", err)
+			return nil, err
+		}
+
+		log.Printf("Ownership transfer declined: %s", transfer.TransferID().Value())
+		return transfer, nil
+	}
+
+	if err := transfer.Accept(); err != nil {
+		return nil, err
+	}
+
+	previousOwnerID := community.OwnerID()
+	if err := community.TransferOwnership(transfer.NomineeID()); err != nil {
+		return nil, err
+	}
+
+	event := events.NewCommunityOwnershipTransferredEvent(
+		community.CommunityID(),
+		transfer.TransferID(),
+		previousOwnerID,
+		transfer.NomineeID(),
+	)
+	auditEntry := entities.NewAuditEntry(
+		community.CommunityID(),
+		entities.AuditActionOwnershipTransferred,
+		transfer.NomineeID().Value(),
+		map[string]string{
+			"transferId":      event.TransferID().Value(),
+			"previousOwnerId": event.PreviousOwnerID().Value(),
+			"newOwnerId":      event.NewOwnerID().Value(),
+		},
+		event.OccurredOn(),
+	)
+
+	// The community owner, both subscription roles, the transfer status, the audit entry and the event change together
+	err = s.unitOfWork.Execute(ctx, func(txCtx context.Context) error {
+		if err := s.communityRepo.Update(txCtx, community); err != nil {
+			return err
+		}
+		if err := s.externalSubscriptionsService.SwapOwnerRole(txCtx, community.CommunityID(), previousOwnerID.Value(), transfer.NomineeID().Value()); err != nil {
+			return err
+		}
+		if err := s.ownershipTransferRepo.Update(txCtx, transfer); err != nil {
+			return err
+		}
+		if err := s.auditLogRepo.Save(txCtx, auditEntry); err != nil {
+			return err
+		}
+		return s.outboxRepo.SaveCommunityOwnershipTransferred(txCtx, event)
+	})
+	if err != nil {
+		log.Printf("Error completing ownership transfer %s: %v", transfer.TransferID().Value(), err)
+		return nil, err
+	}
+
+	log.Printf("Ownership transfer completed: %s", transfer.TransferID().Value())
+
+	return transfer, nil
+}
+
+func (s *ownershipTransferCommandServiceImpl) HandleCancel(ctx context.Context, cmd commands.CancelOwnershipTransferCommand) error {
+	log.Printf("Cancelling ownership transfer of community %s", cmd.CommunityID().Value())
+
+	community, err := s.findCommunity(ctx, cmd.CommunityID())
+	if err != nil {
+		return err
+	}
+
+	if !community.IsOwner(cmd.OwnerID().Value()) {
+		return errors.New("only the owner can cancel the ownership transfer")
+	}
+
+	transfer, err := s.findPendingTransfer(ctx, cmd.CommunityID())
+	if err != nil {
+		return err
+	}
+
+	if err := transfer.Cancel(); err != nil {
+		return err
+	}
+
+	if err := s.ownershipTransferRepo.Update(ctx, transfer); err != nil {
+		log.Printf("Error updating ownership transfer: %v", err)
+		return err
+	}
+
+	log.Printf("Ownership transfer cancelled: %s", transfer.TransferID().Value())
+	return nil
+}
+
+func (s *ownershipTransferCommandServiceImpl) HandleReleaseOwnerships(ctx context.Context, cmd commands.ReleaseOwnershipsCommand) error {
+	log.Printf("Releasing the communities owned by removed user %s", cmd.UserID().Value())
+
+	// Transfers started by or nominating the user can no longer complete
+	transfers, err := s.ownershipTransferRepo.FindPendingByUser(ctx, cmd.UserID())
+	if err != nil {
+		log.Printf("Error finding pending ownership transfers: %v", err)
+		return err
+	}
+	for _, transfer := range transfers {
+		if err := transfer.Cancel(); err != nil {
+			return err
+		}
+		if err := s.ownershipTransferRepo.Update(ctx, transfer); err != nil {
+			log.Printf("Error updating ownership transfer: %v", err)
+			return err
+		}
+		log.Printf("Ownership transfer cancelled: %s", transfer.TransferID().Value())
+	}
+
+	communities, err := s.communityRepo.FindByOwnerID(ctx, cmd.UserID())
+	if err != nil {
+		log.Printf("Error finding owned communities: %v", err)
+		return err
+	}
+
+	for _, community := range communities {
+		if community.IsArchived() {
+			continue
+		}
+
+		successorID, err := s.externalSubscriptionsService.FindSuccessor(ctx, community.CommunityID(), cmd.UserID().Value())
+		if err != nil {
+			log.Printf("Error finding a successor for community %s: %v", community.CommunityID().Value(), err)
+			return err
+		}
+
+		if successorID == "" {
+			err = s.archive(ctx, community)
+		} else {
+			err = s.reassign(ctx, community, successorID)
+		}
+		if err != nil {
+			log.Printf("Error releasing community %s: %v", community.CommunityID().Value(), err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reassign makes the successor the owner of a community whose owner was removed
+func (s *ownershipTransferCommandServiceImpl) reassign(ctx context.Context, community *entities.Community, successorID string) error {
+	newOwnerID, err := valueobjects.NewOwnerID(successorID)
+	if err != nil {
+		return err
+	}
+
+	previousOwnerID := community.OwnerID()
+	if err := community.TransferOwnership(newOwnerID); err != nil {
+		return err
+	}
+
+	event := events.NewCommunityOwnershipReassignedEvent(community.CommunityID(), previousOwnerID, newOwnerID)
+	auditEntry := entities.NewAuditEntry(
+		community.CommunityID(),
+		entities.AuditActionOwnershipReassigned,
+		previousOwnerID.Value(),
+		map[string]string{
+			"previousOwnerId": previousOwnerID.Value(),
+			"newOwnerId":      newOwnerID.Value(),
+			"reason":          event.Reason(),
+		},
+		event.OccurredOn(),
+	)
+
+	err = s.unitOfWork.Execute(ctx, func(txCtx context.Context) error {
+		if err := s.communityRepo.Update(txCtx, community); err != nil {
+			return err
+		}
+		if err := s.externalSubscriptionsService.SwapOwnerRole(txCtx, community.CommunityID(), previousOwnerID.Value(), newOwnerID.Value()); err != nil {
+			return err
+		}
+		if err := s.auditLogRepo.Save(txCtx, auditEntry); err != nil {
+			return err
+		}
+		return s.outboxRepo.SaveCommunityOwnershipTransferred(txCtx, event)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Community %s reassigned from removed owner %s to %s",
+		community.CommunityID().Value(), previousOwnerID.Value(), newOwnerID.Value())
+
+	return nil
+}
+
+// archive keeps a community whose owner was removed and that has no admin to take over
+func (s *ownershipTransferCommandServiceImpl) archive(ctx context.Context, community *entities.Community) error {
+	if err := community.Archive(); err != nil {
+		return err
+	}
+
+	event := events.NewCommunityArchivedEvent(community.CommunityID(), community.OwnerID(), *community.ArchivedAt())
+	auditEntry := entities.NewAuditEntry(
+		community.CommunityID(),
+		entities.AuditActionArchived,
+		community.OwnerID().Value(),
+		map[string]string{
+			"ownerId": community.OwnerID().Value(),
+			"reason":  events.OwnerRemoved,
+		},
+		event.OccurredOn(),
+	)
+
+	err := s.unitOfWork.Execute(ctx, func(txCtx context.Context) error {
+		if err := s.communityRepo.Update(txCtx, community); err != nil {
+			return err
+		}
+		if err := s.auditLogRepo.Save(txCtx, auditEntry); err != nil {
+			return err
+		}
+		return s.outboxRepo.SaveCommunityArchived(txCtx, event)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Community %s archived after its owner %s was removed",
+		community.CommunityID().Value(), community.OwnerID().Value())
+
+	return nil
+}
+
+func (s *ownershipTransferCommandServiceImpl) findCommunity(ctx context.Context, communityID valueobjects.CommunityID) (*entities.Community, error) {
+	community, err := s.communityRepo.FindByID(ctx, communityID)
+	if err != nil {
+		log.Printf("Error finding community: %v", err)
+		return nil, err
+	}
+
+	if community == nil {
+		return nil, errors.New("community not found")
+	}
+
+	return community, nil
+}
+
+func (s *ownershipTransferCommandServiceImpl) findPendingTransfer(ctx context.Context, communityID valueobjects.CommunityID) (*entities.OwnershipTransfer, error) {
+	transfer, err := s.ownershipTransferRepo.FindPendingByCommunity(ctx, communityID)
+	if err != nil {
+		log.Printf("Error finding pending ownership transfer: %v", err)
+		return nil, err
+	}
+
+	if transfer == nil {
+		return nil, errors.New("no pending ownership transfer")
+	}
+
+	return transfer, nil
+}
@@ -0,0 +1,24 @@
+package acl
+
+import (
+	"context"
+
+	comments_acl "Gommunity/platform/comments/interfaces/acl"
+	community_vo "Gommunity/platform/community/domain/model/valueobjects"
+)
+
+// ExternalCommentsService provides deletion support for comments made inside a community.
+type ExternalCommentsService struct {
+	commentsFacade comments_acl.CommentsFacade
+}
+
+func NewExternalCommentsService(commentsFacade comments_acl.CommentsFacade) *ExternalCommentsService {
+	return &ExternalCommentsService{
+		commentsFacade: commentsFacade,
+	}
+}
+
+// DeleteCommentsByCommunity deletes all comments for the community.
+func (s *ExternalCommentsService) DeleteCommentsByCommunity(ctx context.Context, communityID community_vo.CommunityID) error {
+	return s.commentsFacade.DeleteCommentsByCommunity(ctx, communityID.Value())
+}
@@ -10,12 +10,20 @@ import (
 
 // ExternalPostsService provides minimal operations against Posts BC needed for cascades.
 type ExternalPostsService struct {
-	postRepository posts_repos.PostRepository
+	postRepository         posts_repos.PostRepository
+	postRevisionRepository posts_repos.PostRevisionRepository
+	pollVoteRepository     posts_repos.PollVoteRepository
 }
 
-func NewExternalPostsService(postRepository posts_repos.PostRepository) *ExternalPostsService {
+func NewExternalPostsService(
+	postRepository posts_repos.PostRepository,
+	postRevisionRepository posts_repos.PostRevisionRepository,
+	pollVoteRepository posts_repos.PollVoteRepository,
+) *ExternalPostsService {
 	return &ExternalPostsService{
-		postRepository: postRepository,
+		postRepository:         postRepository,
+		postRevisionRepository: postRevisionRepository,
+		pollVoteRepository:     pollVoteRepository,
 	}
 }
 
@@ -28,11 +36,17 @@ func (s *ExternalPostsService) GetPostIDsByCommunity(ctx context.Context, commun
 	return s.postRepository.FindPostIDsByCommunity(ctx, postCommunityID)
 }
 
-// DeletePostsByCommunity deletes all posts for the community.
+// DeletePostsByCommunity deletes all posts, their revisions and their poll votes for the community.
 func (s *ExternalPostsService) DeletePostsByCommunity(ctx context.Context, communityID community_vo.CommunityID) error {
 	postCommunityID, err := posts_vo.NewCommunityID(communityID.Value())
 	if err != nil {
 		return err
 	}
+	if err := s.postRevisionRepository.DeleteByCommunity(ctx, postCommunityID); err != nil {
+		return err
+	}
+	if err := s.pollVoteRepository.DeleteByCommunity(ctx, postCommunityID); err != nil {
+		return err
+	}
 	return s.postRepository.DeleteByCommunity(ctx, postCommunityID)
 }
@@ -8,18 +8,22 @@ import (
 	subscription_commands "Gommunity/platform/subscriptions/domain/model/commands"
 	subscription_vo "Gommunity/platform/subscriptions/domain/model/valueobjects"
 	subscription_services "Gommunity/platform/subscriptions/domain/services"
+	subscriptions_acl "Gommunity/platform/subscriptions/interfaces/acl"
 )
 
 // ExternalSubscriptionsService provides access to Subscriptions BC operations
 type ExternalSubscriptionsService struct {
 	subscriptionCommandService subscription_services.SubscriptionCommandService
+	subscriptionsFacade        subscriptions_acl.SubscriptionsFacade
 }
 
 func NewExternalSubscriptionsService(
 	subscriptionCommandService subscription_services.SubscriptionCommandService,
+	subscriptionsFacade subscriptions_acl.SubscriptionsFacade,
 ) *ExternalSubscriptionsService {
 	return &ExternalSubscriptionsService{
 		subscriptionCommandService: subscriptionCommandService,
+		subscriptionsFacade:        subscriptionsFacade,
 	}
 }
 
@@ -71,3 +75,69 @@ func (s *ExternalSubscriptionsService) DeleteSubscriptionsByCommunity(ctx contex
 
 	return s.subscriptionCommandService.HandleDeleteByCommunity(ctx, subCommunityID)
 }
+
+// CountMembers returns the member count of each community, keyed by community ID, in a single call
+func (s *ExternalSubscriptionsService) CountMembers(ctx context.Context, communityIDs []community_vo.CommunityID) (map[string]int64, error) {
+	ids := make([]string, len(communityIDs))
+	for i, communityID := range communityIDs {
+		ids[i] = communityID.Value()
+	}
+
+	counts, err := s.subscriptionsFacade.CountMembersByCommunities(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count members: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetMemberRole returns the role of a user in the community, or an empty string when the user is not a member
+func (s *ExternalSubscriptionsService) GetMemberRole(ctx context.Context, userID string, communityID community_vo.CommunityID) (string, error) {
+	role, err := s.subscriptionsFacade.GetUserRoleInCommunity(ctx, userID, communityID.Value())
+	if err != nil {
+		return "", fmt.Errorf("failed to get member role: %w", err)
+	}
+
+	return role, nil
+}
+
+// FindSuccessor returns the admin to hand a community over to when its owner is removed,
+// or an empty string when the community has no other admin
+func (s *ExternalSubscriptionsService) FindSuccessor(ctx context.Context, communityID community_vo.CommunityID, ownerID string) (string, error) {
+	successorID, err := s.subscriptionsFacade.GetLongestStandingAdminID(ctx, communityID.Value(), ownerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find a successor: %w", err)
+	}
+
+	return successorID, nil
+}
+
+// SwapOwnerRole hands the owner role over to the new owner and gives the previous owner the new owner's former role
+func (s *ExternalSubscriptionsService) SwapOwnerRole(
+	ctx context.Context,
+	communityID community_vo.CommunityID,
+	previousOwnerID string,
+	newOwnerID string,
+) error {
+	subCommunityID, err := subscription_vo.NewCommunityID(communityID.Value())
+	if err != nil {
+		return fmt.Errorf("failed to create community ID: %w", err)
+	}
+
+	subPreviousOwnerID, err := subscription_vo.NewUserID(previousOwnerID)
+	if err != nil {
+		return fmt.Errorf("failed to create user ID: %w", err)
+	}
+
+	subNewOwnerID, err := subscription_vo.NewUserID(newOwnerID)
+	if err != nil {
+		return fmt.Errorf("failed to create user ID: %w", err)
+	}
+
+	cmd, err := subscription_commands.NewSwapOwnerRoleCommand(subCommunityID, subPreviousOwnerID, subNewOwnerID)
+	if err != nil {
+		return fmt.Errorf("failed to create swap owner role command: %w", err)
+	}
+
+	return s.subscriptionCommandService.HandleSwapOwnerRole(ctx, cmd)
+}
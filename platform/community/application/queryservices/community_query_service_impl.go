@@ -3,19 +3,28 @@ package queryservices
 import (
 	"context"
 
+	"Gommunity/platform/community/application/outboundservices/acl"
 	"Gommunity/platform/community/domain/model/entities"
 	"Gommunity/platform/community/domain/model/queries"
+	"Gommunity/platform/community/domain/model/valueobjects"
 	"Gommunity/platform/community/domain/repositories"
 	"Gommunity/platform/community/domain/services"
 )
 
+const defaultDirectoryLimit = 20
+
 type communityQueryServiceImpl struct {
-	communityRepo repositories.CommunityRepository
+	communityRepo                repositories.CommunityRepository
+	externalSubscriptionsService *acl.ExternalSubscriptionsService
 }
 
-func NewCommunityQueryService(communityRepo repositories.CommunityRepository) services.CommunityQueryService {
+func NewCommunityQueryService(
+	communityRepo repositories.CommunityRepository,
+	externalSubscriptionsService *acl.ExternalSubscriptionsService,
+) services.CommunityQueryService {
 	return &communityQueryServiceImpl{
-		communityRepo: communityRepo,
+		communityRepo:                communityRepo,
+		externalSubscriptionsService: externalSubscriptionsService,
 	}
 }
 
@@ -27,7 +36,56 @@ func (s *communityQueryServiceImpl) HandleGetByOwner(ctx context.Context, query
 	return s.communityRepo.FindByOwnerID(ctx, query.OwnerID())
 }
 
-func (s *communityQueryServiceImpl) HandleGetAll(ctx context.Context, query queries.GetAllCommunitiesQuery) ([]*entities.Community, error) {
-	// TODO: Implement pagination using query.Limit() and query.Offset()
-	return s.communityRepo.FindAll(ctx)
+// HandleGetAll lists one page of the community directory with the member count of each entry.
+// Sorting by members uses the counts stored by HandleRefreshMemberCounts, so the order may lag
+// behind recent joins; the counts returned for the page are always current.
+func (s *communityQueryServiceImpl) HandleGetAll(ctx context.Context, query queries.GetAllCommunitiesQuery) (*services.CommunityDirectory, error) {
+	filter := repositories.CommunityFilter{
+		IsPrivate:   query.IsPrivate(),
+		OwnerID:     query.OwnerID(),
+		CreatedFrom: query.CreatedFrom(),
+		CreatedTo:   query.CreatedTo(),
+	}
+
+	limit := defaultDirectoryLimit
+	if query.Limit() != nil {
+		limit = *query.Limit()
+	}
+	offset := 0
+	if query.Offset() != nil {
+		offset = *query.Offset()
+	}
+
+	total, err := s.communityRepo.CountFiltered(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	communities, err := s.communityRepo.FindFiltered(ctx, filter, query.Sort(), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	communityIDs := make([]valueobjects.CommunityID, len(communities))
+	for i, community := range communities {
+		communityIDs[i] = community.CommunityID()
+	}
+
+	counts, err := s.externalSubscriptionsService.CountMembers(ctx, communityIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDirectory(communities, counts, total), nil
+}
+
+func toDirectory(communities []*entities.Community, counts map[string]int64, total int64) *services.CommunityDirectory {
+	entries := make([]services.CommunityDirectoryEntry, len(communities))
+	for i, community := range communities {
+		entries[i] = services.CommunityDirectoryEntry{
+			Community:   community,
+			MemberCount: counts[community.CommunityID().Value()],
+		}
+	}
+	return &services.CommunityDirectory{Entries: entries, Total: total}
 }
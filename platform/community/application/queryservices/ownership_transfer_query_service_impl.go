@@ -0,0 +1,26 @@
+package queryservices
+
+import (
+	"context"
+
+	"Gommunity/platform/community/domain/model/entities"
+	"Gommunity/platform/community/domain/model/queries"
+	"Gommunity/platform/community/domain/repositories"
+	"Gommunity/platform/community/domain/services"
+)
+
+type ownershipTransferQueryServiceImpl struct {
+	ownershipTransferRepo repositories.OwnershipTransferRepository
+}
+
+func NewOwnershipTransferQueryService(
+	ownershipTransferRepo repositories.OwnershipTransferRepository,
+) services.OwnershipTransferQueryService {
+	return &ownershipTransferQueryServiceImpl{
+		ownershipTransferRepo: ownershipTransferRepo,
+	}
+}
+
+func (s *ownershipTransferQueryServiceImpl) HandleGetPending(ctx context.Context, query queries.GetPendingOwnershipTransferQuery) (*entities.OwnershipTransfer, error) {
+	return s.ownershipTransferRepo.FindPendingByCommunity(ctx, query.CommunityID())
+}
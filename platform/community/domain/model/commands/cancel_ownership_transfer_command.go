@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/community/domain/model/valueobjects"
+)
+
+type CancelOwnershipTransferCommand struct {
+	communityID valueobjects.CommunityID
+	ownerID     valueobjects.OwnerID
+}
+
+func NewCancelOwnershipTransferCommand(
+	communityID valueobjects.CommunityID,
+	ownerID valueobjects.OwnerID,
+) (CancelOwnershipTransferCommand, error) {
+	if communityID.IsZero() {
+		return CancelOwnershipTransferCommand{}, errors.New("communityID cannot be empty")
+	}
+
+	if ownerID.IsZero() {
+		return CancelOwnershipTransferCommand{}, errors.New("ownerID cannot be empty")
+	}
+
+	return CancelOwnershipTransferCommand{
+		communityID: communityID,
+		ownerID:     ownerID,
+	}, nil
+}
+
+func (c CancelOwnershipTransferCommand) CommunityID() valueobjects.CommunityID {
+	return c.communityID
+}
+
+func (c CancelOwnershipTransferCommand) OwnerID() valueobjects.OwnerID {
+	return c.ownerID
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/community/domain/model/valueobjects"
+)
+
+type NominateOwnerCommand struct {
+	communityID valueobjects.CommunityID
+	ownerID     valueobjects.OwnerID
+	nomineeID   valueobjects.OwnerID
+}
+
+func NewNominateOwnerCommand(
+	communityID valueobjects.CommunityID,
+	ownerID valueobjects.OwnerID,
+	nomineeID valueobjects.OwnerID,
+) (NominateOwnerCommand, error) {
+	if communityID.IsZero() {
+		return NominateOwnerCommand{}, errors.New("communityID cannot be empty")
+	}
+
+	if ownerID.IsZero() {
+		return NominateOwnerCommand{}, errors.New("ownerID cannot be empty")
+	}
+
+	if nomineeID.IsZero() {
+		return NominateOwnerCommand{}, errors.New("nomineeID cannot be empty")
+	}
+
+	return NominateOwnerCommand{
+		communityID: communityID,
+		ownerID:     ownerID,
+		nomineeID:   nomineeID,
+	}, nil
+}
+
+func (c NominateOwnerCommand) CommunityID() valueobjects.CommunityID {
+	return c.communityID
+}
+
+// OwnerID is the user requesting the transfer, who has to be the current owner
+func (c NominateOwnerCommand) OwnerID() valueobjects.OwnerID {
+	return c.ownerID
+}
+
+func (c NominateOwnerCommand) NomineeID() valueobjects.OwnerID {
+	return c.nomineeID
+}
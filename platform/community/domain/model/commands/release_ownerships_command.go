@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/community/domain/model/valueobjects"
+)
+
+// ReleaseOwnershipsCommand represents handing over every community of a user whose account was removed
+type ReleaseOwnershipsCommand struct {
+	userID valueobjects.OwnerID
+}
+
+func NewReleaseOwnershipsCommand(userID valueobjects.OwnerID) (ReleaseOwnershipsCommand, error) {
+	if userID.IsZero() {
+		return ReleaseOwnershipsCommand{}, errors.New("userID cannot be empty")
+	}
+
+	return ReleaseOwnershipsCommand{
+		userID: userID,
+	}, nil
+}
+
+func (c ReleaseOwnershipsCommand) UserID() valueobjects.OwnerID {
+	return c.userID
+}
@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/community/domain/model/valueobjects"
+)
+
+type RespondToOwnershipTransferCommand struct {
+	communityID valueobjects.CommunityID
+	nomineeID   valueobjects.OwnerID
+	accept      bool
+}
+
+func NewRespondToOwnershipTransferCommand(
+	communityID valueobjects.CommunityID,
+	nomineeID valueobjects.OwnerID,
+	accept bool,
+) (RespondToOwnershipTransferCommand, error) {
+	if communityID.IsZero() {
+		return RespondToOwnershipTransferCommand{}, errors.New("communityID cannot be empty")
+	}
+
+	if nomineeID.IsZero() {
+		return RespondToOwnershipTransferCommand{}, errors.New("nomineeID cannot be empty")
+	}
+
+	return RespondToOwnershipTransferCommand{
+		communityID: communityID,
+		nomineeID:   nomineeID,
+		accept:      accept,
+	}, nil
+}
+
+func (c RespondToOwnershipTransferCommand) CommunityID() valueobjects.CommunityID {
+	return c.communityID
+}
+
+// NomineeID is the user responding, who has to be the nominee of the pending transfer
+func (c RespondToOwnershipTransferCommand) NomineeID() valueobjects.OwnerID {
+	return c.nomineeID
+}
+
+func (c RespondToOwnershipTransferCommand) Accept() bool {
+	return c.accept
+}
@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/community/domain/model/valueobjects"
+)
+
+type UpdateAdminRoleManagementCommand struct {
+	communityID valueobjects.CommunityID
+	allowed     bool
+}
+
+func NewUpdateAdminRoleManagementCommand(
+	communityID valueobjects.CommunityID,
+	allowed bool,
+) (UpdateAdminRoleManagementCommand, error) {
+	if communityID.IsZero() {
+		return UpdateAdminRoleManagementCommand{}, errors.New("communityID cannot be empty")
+	}
+
+	return UpdateAdminRoleManagementCommand{
+		communityID: communityID,
+		allowed:     allowed,
+	}, nil
+}
+
+func (c UpdateAdminRoleManagementCommand) CommunityID() valueobjects.CommunityID {
+	return c.communityID
+}
+
+func (c UpdateAdminRoleManagementCommand) Allowed() bool {
+	return c.allowed
+}
@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"time"
+
+	"Gommunity/platform/community/domain/model/valueobjects"
+
+	"github.com/google/uuid"
+)
+
+// Audit actions recorded for a community
+const (
+	AuditActionOwnershipTransferred = "ownership_transferred"
+	AuditActionOwnershipReassigned  = "ownership_reassigned"
+	AuditActionArchived             = "archived"
+)
+
+// AuditEntry is an immutable record of a sensitive change made to a community
+type AuditEntry struct {
+	entryID     string
+	communityID valueobjects.CommunityID
+	action      string
+	actorID     string
+	details     map[string]string
+	occurredAt  time.Time
+}
+
+// NewAuditEntry records that actorID performed action on the community at occurredAt
+func NewAuditEntry(
+	communityID valueobjects.CommunityID,
+	action string,
+	actorID string,
+	details map[string]string,
+	occurredAt time.Time,
+) *AuditEntry {
+	return &AuditEntry{
+		entryID:     uuid.New().String(),
+		communityID: communityID,
+		action:      action,
+		actorID:     actorID,
+		details:     details,
+		occurredAt:  occurredAt,
+	}
+}
+
+// Getters
+func (e *AuditEntry) EntryID() string {
+	return e.entryID
+}
+
+func (e *AuditEntry) CommunityID() valueobjects.CommunityID {
+	return e.communityID
+}
+
+func (e *AuditEntry) Action() string {
+	return e.action
+}
+
+func (e *AuditEntry) ActorID() string {
+	return e.actorID
+}
+
+func (e *AuditEntry) Details() map[string]string {
+	return e.details
+}
+
+func (e *AuditEntry) OccurredAt() time.Time {
+	return e.occurredAt
+}
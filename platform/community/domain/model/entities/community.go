@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"errors"
 	"time"
 
 	"Gommunity/platform/community/domain/model/valueobjects"
@@ -18,6 +19,14 @@ type Community struct {
 	isPrivate   bool                       `bson:"is_private"`
 	createdAt   time.Time                  `bson:"created_at"`
 	updatedAt   time.Time                  `bson:"updated_at"`
+
+	// adminsCanManageAdmins lets admins promote members to admin and change other admins' roles.
+	// Off by default: only the owner manages admins.
+	adminsCanManageAdmins bool
+
+	// archivedAt is set when the owner's account was removed and no admin could take over.
+	// The community is kept for its members and history instead of being left without an owner.
+	archivedAt *time.Time
 }
 
 // NewCommunity creates a new Community entity
@@ -83,6 +92,19 @@ func (c *Community) IsPrivate() bool {
 	return c.isPrivate
 }
 
+func (c *Community) AdminsCanManageAdmins() bool {
+	return c.adminsCanManageAdmins
+}
+
+// ArchivedAt returns when the community was archived, or nil when it is active
+func (c *Community) ArchivedAt() *time.Time {
+	return c.archivedAt
+}
+
+func (c *Community) IsArchived() bool {
+	return c.archivedAt != nil
+}
+
 func (c *Community) CreatedAt() time.Time {
 	return c.createdAt
 }
@@ -129,6 +151,37 @@ func (c *Community) UpdatePrivacy(isPrivate bool) {
 	c.updatedAt = time.Now()
 }
 
+// TransferOwnership hands the community over to a new owner
+func (c *Community) TransferOwnership(newOwnerID valueobjects.OwnerID) error {
+	if newOwnerID.IsZero() {
+		return errors.New("new owner ID cannot be empty")
+	}
+	if c.ownerID.Value() == newOwnerID.Value() {
+		return errors.New("the nominee already owns the community")
+	}
+
+	c.ownerID = newOwnerID
+	c.updatedAt = time.Now()
+	return nil
+}
+
+// Archive marks a community whose owner is gone and could not be replaced
+func (c *Community) Archive() error {
+	if c.IsArchived() {
+		return errors.New("community is already archived")
+	}
+
+	now := time.Now()
+	c.archivedAt = &now
+	c.updatedAt = now
+	return nil
+}
+
+func (c *Community) UpdateAdminRoleManagement(allowed bool) {
+	c.adminsCanManageAdmins = allowed
+	c.updatedAt = time.Now()
+}
+
 // ReconstructCommunity rebuilds a community from persisted data without generating new IDs or timestamps.
 func ReconstructCommunity(
 	communityID valueobjects.CommunityID,
@@ -138,19 +191,23 @@ func ReconstructCommunity(
 	iconURL *string,
 	bannerURL *string,
 	isPrivate bool,
+	adminsCanManageAdmins bool,
+	archivedAt *time.Time,
 	createdAt time.Time,
 	updatedAt time.Time,
 ) *Community {
 	return &Community{
-		communityID: communityID,
-		ownerID:     ownerID,
-		name:        name,
-		description: description,
-		iconURL:     iconURL,
-		bannerURL:   bannerURL,
-		isPrivate:   isPrivate,
-		createdAt:   createdAt,
-		updatedAt:   updatedAt,
+		communityID:           communityID,
+		ownerID:               ownerID,
+		name:                  name,
+		description:           description,
+		iconURL:               iconURL,
+		bannerURL:             bannerURL,
+		isPrivate:             isPrivate,
+		adminsCanManageAdmins: adminsCanManageAdmins,
+		archivedAt:            archivedAt,
+		createdAt:             createdAt,
+		updatedAt:             updatedAt,
 	}
 }
 
@@ -0,0 +1,141 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"Gommunity/platform/community/domain/model/valueobjects"
+)
+
+// OwnershipTransfer is the owner's nomination of another community member as the new owner.
+// Ownership only changes once the nominee accepts.
+type OwnershipTransfer struct {
+	transferID  valueobjects.OwnershipTransferID
+	communityID valueobjects.CommunityID
+	fromOwnerID valueobjects.OwnerID
+	nomineeID   valueobjects.OwnerID
+	status      valueobjects.TransferStatus
+	respondedAt *time.Time
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewOwnershipTransfer creates a pending ownership transfer
+func NewOwnershipTransfer(
+	communityID valueobjects.CommunityID,
+	fromOwnerID valueobjects.OwnerID,
+	nomineeID valueobjects.OwnerID,
+) (*OwnershipTransfer, error) {
+	if communityID.IsZero() {
+		return nil, errors.New("community ID cannot be empty")
+	}
+	if fromOwnerID.IsZero() {
+		return nil, errors.New("owner ID cannot be empty")
+	}
+	if nomineeID.IsZero() {
+		return nil, errors.New("nominee ID cannot be empty")
+	}
+	if fromOwnerID.Value() == nomineeID.Value() {
+		return nil, errors.New("the nominee already owns the community")
+	}
+
+	now := time.Now()
+	return &OwnershipTransfer{
+		transferID:  valueobjects.GenerateOwnershipTransferID(),
+		communityID: communityID,
+		fromOwnerID: fromOwnerID,
+		nomineeID:   nomineeID,
+		status:      valueobjects.TransferPending,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// ReconstructOwnershipTransfer rebuilds an ownership transfer from persisted data
+func ReconstructOwnershipTransfer(
+	transferID valueobjects.OwnershipTransferID,
+	communityID valueobjects.CommunityID,
+	fromOwnerID valueobjects.OwnerID,
+	nomineeID valueobjects.OwnerID,
+	status valueobjects.TransferStatus,
+	respondedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *OwnershipTransfer {
+	return &OwnershipTransfer{
+		transferID:  transferID,
+		communityID: communityID,
+		fromOwnerID: fromOwnerID,
+		nomineeID:   nomineeID,
+		status:      status,
+		respondedAt: respondedAt,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// Getters
+func (t *OwnershipTransfer) TransferID() valueobjects.OwnershipTransferID {
+	return t.transferID
+}
+
+func (t *OwnershipTransfer) CommunityID() valueobjects.CommunityID {
+	return t.communityID
+}
+
+func (t *OwnershipTransfer) FromOwnerID() valueobjects.OwnerID {
+	return t.fromOwnerID
+}
+
+func (t *OwnershipTransfer) NomineeID() valueobjects.OwnerID {
+	return t.nomineeID
+}
+
+func (t *OwnershipTransfer) Status() valueobjects.TransferStatus {
+	return t.status
+}
+
+func (t *OwnershipTransfer) RespondedAt() *time.Time {
+	return t.respondedAt
+}
+
+func (t *OwnershipTransfer) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *OwnershipTransfer) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// Business methods
+func (t *OwnershipTransfer) IsPending() bool {
+	return t.status.IsPending()
+}
+
+func (t *OwnershipTransfer) IsNominee(userID string) bool {
+	return t.nomineeID.Value() == userID
+}
+
+func (t *OwnershipTransfer) Accept() error {
+	return t.close(valueobjects.TransferAccepted)
+}
+
+func (t *OwnershipTransfer) Decline() error {
+	return t.close(valueobjects.TransferDeclined)
+}
+
+func (t *OwnershipTransfer) Cancel() error {
+	return t.close(valueobjects.TransferCancelled)
+}
+
+func (t *OwnershipTransfer) close(status valueobjects.TransferStatus) error {
+	if !t.IsPending() {
+		return errors.New("ownership transfer is no longer pending")
+	}
+
+	now := time.Now()
+	t.status = status
+	t.respondedAt = &now
+	t.updatedAt = now
+	return nil
+}
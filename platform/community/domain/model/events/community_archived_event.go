@@ -0,0 +1,39 @@
+package events
+
+import (
+	"time"
+
+	"Gommunity/platform/community/domain/model/valueobjects"
+)
+
+// CommunityArchivedEvent records that a community was archived because its owner was removed
+// and no admin was left to take over
+type CommunityArchivedEvent struct {
+	communityID valueobjects.CommunityID
+	ownerID     valueobjects.OwnerID
+	occurredOn  time.Time
+}
+
+func NewCommunityArchivedEvent(
+	communityID valueobjects.CommunityID,
+	ownerID valueobjects.OwnerID,
+	archivedAt time.Time,
+) CommunityArchivedEvent {
+	return CommunityArchivedEvent{
+		communityID: communityID,
+		ownerID:     ownerID,
+		occurredOn:  archivedAt,
+	}
+}
+
+func (e CommunityArchivedEvent) CommunityID() valueobjects.CommunityID {
+	return e.communityID
+}
+
+func (e CommunityArchivedEvent) OwnerID() valueobjects.OwnerID {
+	return e.ownerID
+}
+
+func (e CommunityArchivedEvent) OccurredOn() time.Time {
+	return e.occurredOn
+}
@@ -0,0 +1,78 @@
+package events
+
+import (
+	"time"
+
+	"Gommunity/platform/community/domain/model/valueobjects"
+)
+
+// Reasons a community changes owner
+const (
+	OwnershipTransferAccepted = "transfer_accepted"
+	OwnerRemoved              = "owner_removed"
+)
+
+// CommunityOwnershipTransferredEvent records a change of owner, either through an accepted transfer
+// or because the owner was removed and an admin took over. Only accepted transfers carry a transfer ID.
+type CommunityOwnershipTransferredEvent struct {
+	communityID     valueobjects.CommunityID
+	transferID      valueobjects.OwnershipTransferID
+	previousOwnerID valueobjects.OwnerID
+	newOwnerID      valueobjects.OwnerID
+	reason          string
+	occurredOn      time.Time
+}
+
+func NewCommunityOwnershipTransferredEvent(
+	communityID valueobjects.CommunityID,
+	transferID valueobjects.OwnershipTransferID,
+	previousOwnerID valueobjects.OwnerID,
+	newOwnerID valueobjects.OwnerID,
+) CommunityOwnershipTransferredEvent {
+	return CommunityOwnershipTransferredEvent{
+		communityID:     communityID,
+		transferID:      transferID,
+		previousOwnerID: previousOwnerID,
+		newOwnerID:      newOwnerID,
+		reason:          OwnershipTransferAccepted,
+		occurredOn:      time.Now(),
+	}
+}
+
+func NewCommunityOwnershipReassignedEvent(
+	communityID valueobjects.CommunityID,
+	previousOwnerID valueobjects.OwnerID,
+	newOwnerID valueobjects.OwnerID,
+) CommunityOwnershipTransferredEvent {
+	return CommunityOwnershipTransferredEvent{
+		communityID:     communityID,
+		previousOwnerID: previousOwnerID,
+		newOwnerID:      newOwnerID,
+		reason:          OwnerRemoved,
+		occurredOn:      time.Now(),
+	}
+}
+
+func (e CommunityOwnershipTransferredEvent) CommunityID() valueobjects.CommunityID {
+	return e.communityID
+}
+
+func (e CommunityOwnershipTransferredEvent) TransferID() valueobjects.OwnershipTransferID {
+	return e.transferID
+}
+
+func (e CommunityOwnershipTransferredEvent) PreviousOwnerID() valueobjects.OwnerID {
+	return e.previousOwnerID
+}
+
+func (e CommunityOwnershipTransferredEvent) NewOwnerID() valueobjects.OwnerID {
+	return e.newOwnerID
+}
+
+func (e CommunityOwnershipTransferredEvent) Reason() string {
+	return e.reason
+}
+
+func (e CommunityOwnershipTransferredEvent) OccurredOn() time.Time {
+	return e.occurredOn
+}
@@ -1,8 +1,21 @@
 package queries
 
+import (
+	"errors"
+	"time"
+
+	"Gommunity/platform/community/domain/model/valueobjects"
+)
+
+// GetAllCommunitiesQuery lists the community directory
 type GetAllCommunitiesQuery struct {
-	limit  *int
-	offset *int
+	limit       *int
+	offset      *int
+	isPrivate   *bool
+	ownerID     *valueobjects.OwnerID
+	createdFrom *time.Time
+	createdTo   *time.Time
+	sort        valueobjects.CommunitySort
 }
 
 func NewGetAllCommunitiesQuery() GetAllCommunitiesQuery {
@@ -15,6 +28,37 @@ func (q GetAllCommunitiesQuery) WithPagination(limit, offset int) GetAllCommunit
 	return q
 }
 
+// WithPrivacy keeps only private or only public communities
+func (q GetAllCommunitiesQuery) WithPrivacy(isPrivate bool) GetAllCommunitiesQuery {
+	q.isPrivate = &isPrivate
+	return q
+}
+
+// WithOwner keeps only the communities of an owner
+func (q GetAllCommunitiesQuery) WithOwner(ownerID valueobjects.OwnerID) GetAllCommunitiesQuery {
+	q.ownerID = &ownerID
+	return q
+}
+
+// WithCreatedBetween keeps only the communities created within the range. Zero bounds are open.
+func (q GetAllCommunitiesQuery) WithCreatedBetween(from, to time.Time) (GetAllCommunitiesQuery, error) {
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return q, errors.New("created range end cannot be before its start")
+	}
+	if !from.IsZero() {
+		q.createdFrom = &from
+	}
+	if !to.IsZero() {
+		q.createdTo = &to
+	}
+	return q, nil
+}
+
+func (q GetAllCommunitiesQuery) WithSort(sort valueobjects.CommunitySort) GetAllCommunitiesQuery {
+	q.sort = sort
+	return q
+}
+
 func (q GetAllCommunitiesQuery) Limit() *int {
 	return q.limit
 }
@@ -22,3 +66,23 @@ func (q GetAllCommunitiesQuery) Limit() *int {
 func (q GetAllCommunitiesQuery) Offset() *int {
 	return q.offset
 }
+
+func (q GetAllCommunitiesQuery) IsPrivate() *bool {
+	return q.isPrivate
+}
+
+func (q GetAllCommunitiesQuery) OwnerID() *valueobjects.OwnerID {
+	return q.ownerID
+}
+
+func (q GetAllCommunitiesQuery) CreatedFrom() *time.Time {
+	return q.createdFrom
+}
+
+func (q GetAllCommunitiesQuery) CreatedTo() *time.Time {
+	return q.createdTo
+}
+
+func (q GetAllCommunitiesQuery) Sort() valueobjects.CommunitySort {
+	return q.sort
+}
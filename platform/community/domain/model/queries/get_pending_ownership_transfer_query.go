@@ -0,0 +1,25 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/community/domain/model/valueobjects"
+)
+
+type GetPendingOwnershipTransferQuery struct {
+	communityID valueobjects.CommunityID
+}
+
+func NewGetPendingOwnershipTransferQuery(communityID valueobjects.CommunityID) (GetPendingOwnershipTransferQuery, error) {
+	if communityID.IsZero() {
+		return GetPendingOwnershipTransferQuery{}, errors.New("communityID cannot be empty")
+	}
+
+	return GetPendingOwnershipTransferQuery{
+		communityID: communityID,
+	}, nil
+}
+
+func (q GetPendingOwnershipTransferQuery) CommunityID() valueobjects.CommunityID {
+	return q.communityID
+}
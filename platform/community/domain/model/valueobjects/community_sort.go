@@ -0,0 +1,49 @@
+package valueobjects
+
+import "errors"
+
+const (
+	NewestCommunitySort  = "newest"
+	NameCommunitySort    = "name"
+	MembersCommunitySort = "members"
+)
+
+// CommunitySort is the order of the community directory
+type CommunitySort struct {
+	value string
+}
+
+func NewCommunitySort(value string) (CommunitySort, error) {
+	switch value {
+	case NewestCommunitySort, NameCommunitySort, MembersCommunitySort:
+		return CommunitySort{value: value}, nil
+	default:
+		return CommunitySort{}, errors.New("sort has to be one of newest, name or members")
+	}
+}
+
+func (s CommunitySort) Value() string {
+	if s.value == "" {
+		return NewestCommunitySort
+	}
+	return s.value
+}
+
+func (s CommunitySort) String() string {
+	return s.Value()
+}
+
+// IsNewest reports whether communities are listed newest first. The zero sort is newest.
+func (s CommunitySort) IsNewest() bool {
+	return s.Value() == NewestCommunitySort
+}
+
+// IsName reports whether communities are listed alphabetically
+func (s CommunitySort) IsName() bool {
+	return s.value == NameCommunitySort
+}
+
+// IsMembers reports whether communities are listed by member count, largest first
+func (s CommunitySort) IsMembers() bool {
+	return s.value == MembersCommunitySort
+}
@@ -0,0 +1,40 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type OwnershipTransferID struct {
+	value string
+}
+
+func NewOwnershipTransferID(value string) (OwnershipTransferID, error) {
+	if value == "" {
+		return OwnershipTransferID{}, errors.New("ownership transfer ID cannot be empty")
+	}
+
+	// Validate UUID format
+	if _, err := uuid.Parse(value); err != nil {
+		return OwnershipTransferID{}, errors.New("ownership transfer ID must be a valid UUID")
+	}
+
+	return OwnershipTransferID{value: value}, nil
+}
+
+func GenerateOwnershipTransferID() OwnershipTransferID {
+	return OwnershipTransferID{value: uuid.New().String()}
+}
+
+func (o OwnershipTransferID) Value() string {
+	return o.value
+}
+
+func (o OwnershipTransferID) String() string {
+	return o.value
+}
+
+func (o OwnershipTransferID) IsZero() bool {
+	return o.value == ""
+}
@@ -0,0 +1,57 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	TransferPendingName   = "pending"
+	TransferAcceptedName  = "accepted"
+	TransferDeclinedName  = "declined"
+	TransferCancelledName = "cancelled"
+)
+
+// TransferStatus is the state of an ownership transfer: pending until the nominee accepts or declines
+// it, or the owner cancels it
+type TransferStatus struct {
+	value string
+}
+
+var validTransferStatuses = map[string]bool{
+	TransferPendingName:   true,
+	TransferAcceptedName:  true,
+	TransferDeclinedName:  true,
+	TransferCancelledName: true,
+}
+
+func NewTransferStatus(value string) (TransferStatus, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	if !validTransferStatuses[normalized] {
+		return TransferStatus{}, errors.New("invalid transfer status: must be one of pending, accepted, declined, cancelled")
+	}
+	return TransferStatus{value: normalized}, nil
+}
+
+func (t TransferStatus) Value() string {
+	return t.value
+}
+
+func (t TransferStatus) String() string {
+	return t.value
+}
+
+func (t TransferStatus) IsZero() bool {
+	return t.value == ""
+}
+
+func (t TransferStatus) IsPending() bool {
+	return t.value == TransferPendingName
+}
+
+var (
+	TransferPending   = TransferStatus{value: TransferPendingName}
+	TransferAccepted  = TransferStatus{value: TransferAcceptedName}
+	TransferDeclined  = TransferStatus{value: TransferDeclinedName}
+	TransferCancelled = TransferStatus{value: TransferCancelledName}
+)
@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"context"
+
+	"Gommunity/platform/community/domain/model/entities"
+)
+
+// AuditLogRepository stores the audit trail of sensitive community changes. Entries are never updated.
+type AuditLogRepository interface {
+	Save(ctx context.Context, entry *entities.AuditEntry) error
+}
@@ -2,17 +2,51 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"Gommunity/platform/community/domain/model/entities"
 	"Gommunity/platform/community/domain/model/valueobjects"
 )
 
+// CommunityFilter narrows down the community directory. Nil fields match every community;
+// archived communities are never listed.
+type CommunityFilter struct {
+	IsPrivate   *bool
+	OwnerID     *valueobjects.OwnerID
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
 type CommunityRepository interface {
 	Save(ctx context.Context, community *entities.Community) error
 	Update(ctx context.Context, community *entities.Community) error
 	FindByID(ctx context.Context, communityID valueobjects.CommunityID) (*entities.Community, error)
 	FindByOwnerID(ctx context.Context, ownerID valueobjects.OwnerID) ([]*entities.Community, error)
-	FindAll(ctx context.Context) ([]*entities.Community, error)
+
+	// FindFiltered returns one page of the communities matching the filter, newest first, by name,
+	// or by the member count last stored with UpdateMemberCounts
+	FindFiltered(ctx context.Context, filter CommunityFilter, sort valueobjects.CommunitySort, limit, offset int) ([]*entities.Community, error)
+
+	// CountFiltered returns the number of communities matching the filter
+	CountFiltered(ctx context.Context, filter CommunityFilter) (int64, error)
+
+	// FindIDsAfter returns up to limit community identifiers following after, in identifier order.
+	// A zero after starts from the first community.
+	FindIDsAfter(ctx context.Context, after valueobjects.CommunityID, limit int) ([]valueobjects.CommunityID, error)
+
+	// UpdateMemberCounts stores the member count of each community, keyed by community ID,
+	// for the directory to sort on
+	UpdateMemberCounts(ctx context.Context, counts map[string]int64) error
+
+	// FindByIDs returns the communities with the given identifiers, in no particular order
+	FindByIDs(ctx context.Context, communityIDs []valueobjects.CommunityID) ([]*entities.Community, error)
+
 	Delete(ctx context.Context, communityID valueobjects.CommunityID) error
 	ExistsByID(ctx context.Context, communityID valueobjects.CommunityID) (bool, error)
+
+	// Search runs a full-text search on name and description, most relevant first, leaving out archived communities
+	Search(ctx context.Context, text string, limit, offset int) ([]*entities.Community, error)
+
+	// FindPublicIDs returns the identifiers of every public community that is not archived
+	FindPublicIDs(ctx context.Context) ([]valueobjects.CommunityID, error)
 }
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"Gommunity/platform/community/domain/model/events"
+)
+
+// OutboxRepository records community events to be published to other services.
+// Used inside a unit of work, an event is only recorded when the community change commits.
+type OutboxRepository interface {
+	SaveCommunityCreated(ctx context.Context, event events.CommunityCreatedEvent) error
+	SaveCommunityOwnershipTransferred(ctx context.Context, event events.CommunityOwnershipTransferredEvent) error
+	SaveCommunityArchived(ctx context.Context, event events.CommunityArchivedEvent) error
+}
@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+
+	"Gommunity/platform/community/domain/model/entities"
+	"Gommunity/platform/community/domain/model/valueobjects"
+)
+
+type OwnershipTransferRepository interface {
+	Save(ctx context.Context, transfer *entities.OwnershipTransfer) error
+
+	// Update persists the status of an existing transfer
+	Update(ctx context.Context, transfer *entities.OwnershipTransfer) error
+
+	// FindPendingByCommunity returns the pending transfer of a community, or nil when there is none
+	FindPendingByCommunity(ctx context.Context, communityID valueobjects.CommunityID) (*entities.OwnershipTransfer, error)
+
+	// FindPendingByUser returns the pending transfers the user started as owner or was nominated for
+	FindPendingByUser(ctx context.Context, userID valueobjects.OwnerID) ([]*entities.OwnershipTransfer, error)
+
+	DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error
+}
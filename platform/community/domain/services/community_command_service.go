@@ -12,4 +12,9 @@ type CommunityCommandService interface {
 	HandleDelete(ctx context.Context, cmd commands.DeleteCommunityCommand) error
 	HandleUpdatePrivacy(ctx context.Context, cmd commands.UpdateCommunityPrivacyCommand) error
 	HandleUpdateInfo(ctx context.Context, cmd commands.UpdateCommunityInfoCommand) error
+	HandleUpdateAdminRoleManagement(ctx context.Context, cmd commands.UpdateAdminRoleManagementCommand) error
+
+	// HandleRefreshMemberCounts stores the current member count of every community for the directory
+	// to sort on, and returns how many communities were refreshed
+	HandleRefreshMemberCounts(ctx context.Context) (int, error)
 }
@@ -7,8 +7,20 @@ import (
 	"Gommunity/platform/community/domain/model/queries"
 )
 
+// CommunityDirectoryEntry is a community listed in the directory together with its member count
+type CommunityDirectoryEntry struct {
+	Community   *entities.Community
+	MemberCount int64
+}
+
+// CommunityDirectory is one page of the community directory
+type CommunityDirectory struct {
+	Entries []CommunityDirectoryEntry
+	Total   int64
+}
+
 type CommunityQueryService interface {
 	HandleGetByID(ctx context.Context, query queries.GetCommunityByIDQuery) (*entities.Community, error)
 	HandleGetByOwner(ctx context.Context, query queries.GetCommunitiesByOwnerQuery) ([]*entities.Community, error)
-	HandleGetAll(ctx context.Context, query queries.GetAllCommunitiesQuery) ([]*entities.Community, error)
+	HandleGetAll(ctx context.Context, query queries.GetAllCommunitiesQuery) (*CommunityDirectory, error)
 }
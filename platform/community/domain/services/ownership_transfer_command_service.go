@@ -0,0 +1,21 @@
+package services
+
+import (
+	"context"
+
+	"Gommunity/platform/community/domain/model/commands"
+	"Gommunity/platform/community/domain/model/entities"
+)
+
+type OwnershipTransferCommandService interface {
+	HandleNominate(ctx context.Context, cmd commands.NominateOwnerCommand) (*entities.OwnershipTransfer, error)
+
+	// HandleRespond accepts or declines the pending transfer and returns it with its final status
+	HandleRespond(ctx context.Context, cmd commands.RespondToOwnershipTransferCommand) (*entities.OwnershipTransfer, error)
+
+	HandleCancel(ctx context.Context, cmd commands.CancelOwnershipTransferCommand) error
+
+	// HandleReleaseOwnerships hands over the communities of a user whose account was removed.
+	// Each goes to its longest-standing admin, or is archived when it has none.
+	HandleReleaseOwnerships(ctx context.Context, cmd commands.ReleaseOwnershipsCommand) error
+}
@@ -0,0 +1,12 @@
+package services
+
+import (
+	"context"
+
+	"Gommunity/platform/community/domain/model/entities"
+	"Gommunity/platform/community/domain/model/queries"
+)
+
+type OwnershipTransferQueryService interface {
+	HandleGetPending(ctx context.Context, query queries.GetPendingOwnershipTransferQuery) (*entities.OwnershipTransfer, error)
+}
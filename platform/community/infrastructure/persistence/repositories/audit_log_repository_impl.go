@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+	"log"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"Gommunity/platform/community/domain/model/entities"
+	"Gommunity/platform/community/domain/repositories"
+)
+
+type auditLogRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func NewAuditLogRepository(collection *mongo.Collection) repositories.AuditLogRepository {
+	return &auditLogRepositoryImpl{
+		collection: collection,
+	}
+}
+
+// auditEntryDocument represents the MongoDB document structure
+type auditEntryDocument struct {
+	EntryID     string            `bson:"_id"`
+	CommunityID string            `bson:"community_id"`
+	Action      string            `bson:"action"`
+	ActorID     string            `bson:"actor_id"`
+	Details     map[string]string `bson:"details,omitempty"`
+	OccurredAt  int64             `bson:"occurred_at"`
+}
+
+// Save appends an entry to the audit log
+func (r *auditLogRepositoryImpl) Save(ctx context.Context, entry *entities.AuditEntry) error {
+	doc := &auditEntryDocument{
+		EntryID:     entry.EntryID(),
+		CommunityID: entry.CommunityID().Value(),
+		Action:      entry.Action(),
+		ActorID:     entry.ActorID(),
+		Details:     entry.Details(),
+		OccurredAt:  entry.OccurredAt().Unix(),
+	}
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		log.Printf("Error saving audit entry to MongoDB: %v", err)
+		return err
+	}
+
+	return nil
+}
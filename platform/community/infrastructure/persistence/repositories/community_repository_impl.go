@@ -12,6 +12,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type communityRepositoryImpl struct {
@@ -36,6 +37,9 @@ type communityDocument struct {
 	IsPrivate   bool    `bson:"is_private"`
 	CreatedAt   int64   `bson:"created_at"`
 	UpdatedAt   int64   `bson:"updated_at"`
+
+	AdminsCanManageAdmins bool   `bson:"admins_can_manage_admins"`
+	ArchivedAt            *int64 `bson:"archived_at,omitempty"`
 }
 
 // Save saves a new community to the database
@@ -61,12 +65,16 @@ func (r *communityRepositoryImpl) Update(ctx context.Context, community *entitie
 
 	update := bson.M{
 		"$set": bson.M{
+			"owner_id":    community.OwnerID().Value(),
 			"name":        community.Name().Value(),
 			"description": community.Description().Value(),
 			"icon_url":    community.IconURL(),
 			"banner_url":  community.BannerURL(),
 			"is_private":  community.IsPrivate(),
 			"updated_at":  community.UpdatedAt().Unix(),
+
+			"admins_can_manage_admins": community.AdminsCanManageAdmins(),
+			"archived_at":              unixOrNil(community.ArchivedAt()),
 		},
 	}
 
@@ -137,11 +145,136 @@ func (r *communityRepositoryImpl) FindByOwnerID(ctx context.Context, ownerID val
 	return communities, nil
 }
 
-// FindAll finds all communities
-func (r *communityRepositoryImpl) FindAll(ctx context.Context) ([]*entities.Community, error) {
-	cursor, err := r.collection.Find(ctx, bson.M{})
+// FindFiltered finds one page of the communities matching the filter
+func (r *communityRepositoryImpl) FindFiltered(ctx context.Context, filter domain_repos.CommunityFilter, sort valueobjects.CommunitySort, limit, offset int) ([]*entities.Community, error) {
+	findOptions := options.Find().
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	if sort.IsName() {
+		// Case-insensitive alphabetical order
+		findOptions.
+			SetSort(bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}}).
+			SetCollation(&options.Collation{Locale: "en", Strength: 2})
+	} else if sort.IsMembers() {
+		findOptions.SetSort(bson.D{{Key: "member_count", Value: -1}, {Key: "_id", Value: 1}})
+	} else {
+		findOptions.SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}})
+	}
+
+	cursor, err := r.collection.Find(ctx, toFilterDocument(filter), findOptions)
+	if err != nil {
+		log.Printf("Error finding filtered communities in MongoDB: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	return r.decodeCommunities(ctx, cursor)
+}
+
+// CountFiltered counts the communities matching the filter
+func (r *communityRepositoryImpl) CountFiltered(ctx context.Context, filter domain_repos.CommunityFilter) (int64, error) {
+	count, err := r.collection.CountDocuments(ctx, toFilterDocument(filter))
+	if err != nil {
+		log.Printf("Error counting filtered communities in MongoDB: %v", err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// FindIDsAfter returns the next page of community identifiers in identifier order
+func (r *communityRepositoryImpl) FindIDsAfter(ctx context.Context, after valueobjects.CommunityID, limit int) ([]valueobjects.CommunityID, error) {
+	filter := bson.M{"_id": bson.M{"$gt": after.Value()}}
+	findOptions := options.Find().
+		SetProjection(bson.M{"_id": 1}).
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		log.Printf("Error finding community IDs in MongoDB: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var ids []valueobjects.CommunityID
+	for cursor.Next(ctx) {
+		var doc struct {
+			CommunityID string `bson:"_id"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		communityID, err := valueobjects.NewCommunityID(doc.CommunityID)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, communityID)
+	}
+
+	if err := cursor.Err(); err != nil {
+		log.Printf("Cursor error: %v", err)
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// UpdateMemberCounts stores the member count of each community in a single bulk write
+func (r *communityRepositoryImpl) UpdateMemberCounts(ctx context.Context, counts map[string]int64) error {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(counts))
+	for communityID, count := range counts {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": communityID}).
+			SetUpdate(bson.M{"$set": bson.M{"member_count": count}}))
+	}
+
+	_, err := r.collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		log.Printf("Error updating member counts in MongoDB: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// FindByIDs finds the communities with the given identifiers
+func (r *communityRepositoryImpl) FindByIDs(ctx context.Context, communityIDs []valueobjects.CommunityID) ([]*entities.Community, error) {
+	if len(communityIDs) == 0 {
+		return []*entities.Community{}, nil
+	}
+
+	ids := make([]string, len(communityIDs))
+	for i, communityID := range communityIDs {
+		ids[i] = communityID.Value()
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		log.Printf("Error finding communities by IDs in MongoDB: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	return r.decodeCommunities(ctx, cursor)
+}
+
+// Search runs a full-text search on name and description, most relevant first
+func (r *communityRepositoryImpl) Search(ctx context.Context, text string, limit, offset int) ([]*entities.Community, error) {
+	filter := bson.M{"$text": bson.M{"$search": text}, "archived_at": nil}
+	findOptions := options.Find().
+		SetProjection(bson.M{"score": bson.M{"$meta": "textScore"}}).
+		SetSort(bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}, {Key: "_id", Value: 1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
 	if err != nil {
-		log.Printf("Error finding all communities in MongoDB: %v", err)
+		log.Printf("Error searching communities in MongoDB: %v", err)
 		return nil, err
 	}
 	defer cursor.Close(ctx)
@@ -171,6 +304,41 @@ func (r *communityRepositoryImpl) FindAll(ctx context.Context) ([]*entities.Comm
 	return communities, nil
 }
 
+// FindPublicIDs returns the identifiers of every public community that is not archived
+func (r *communityRepositoryImpl) FindPublicIDs(ctx context.Context) ([]valueobjects.CommunityID, error) {
+	filter := bson.M{"is_private": false, "archived_at": nil}
+	findOptions := options.Find().SetProjection(bson.M{"_id": 1})
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		log.Printf("Error finding public communities in MongoDB: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var ids []valueobjects.CommunityID
+	for cursor.Next(ctx) {
+		var doc struct {
+			CommunityID string `bson:"_id"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		communityID, err := valueobjects.NewCommunityID(doc.CommunityID)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, communityID)
+	}
+
+	if err := cursor.Err(); err != nil {
+		log.Printf("Cursor error: %v", err)
+		return nil, err
+	}
+
+	return ids, nil
+}
+
 // Delete deletes a community by community ID
 func (r *communityRepositoryImpl) Delete(ctx context.Context, communityID valueobjects.CommunityID) error {
 	filter := bson.M{"_id": communityID.Value()}
@@ -215,6 +383,9 @@ func (r *communityRepositoryImpl) entityToDocument(community *entities.Community
 		IsPrivate:   community.IsPrivate(),
 		CreatedAt:   community.CreatedAt().Unix(),
 		UpdatedAt:   community.UpdatedAt().Unix(),
+
+		AdminsCanManageAdmins: community.AdminsCanManageAdmins(),
+		ArchivedAt:            unixOrNil(community.ArchivedAt()),
 	}
 }
 
@@ -242,6 +413,12 @@ func (r *communityRepositoryImpl) documentToEntity(doc *communityDocument) (*ent
 	createdAt := time.Unix(doc.CreatedAt, 0)
 	updatedAt := time.Unix(doc.UpdatedAt, 0)
 
+	var archivedAt *time.Time
+	if doc.ArchivedAt != nil {
+		t := time.Unix(*doc.ArchivedAt, 0)
+		archivedAt = &t
+	}
+
 	return entities.ReconstructCommunity(
 		communityID,
 		ownerID,
@@ -250,7 +427,67 @@ func (r *communityRepositoryImpl) documentToEntity(doc *communityDocument) (*ent
 		doc.IconURL,
 		doc.BannerURL,
 		doc.IsPrivate,
+		doc.AdminsCanManageAdmins,
+		archivedAt,
 		createdAt,
 		updatedAt,
 	), nil
 }
+
+// decodeCommunities converts every document of a cursor to an entity
+func (r *communityRepositoryImpl) decodeCommunities(ctx context.Context, cursor *mongo.Cursor) ([]*entities.Community, error) {
+	var communities []*entities.Community
+	for cursor.Next(ctx) {
+		var doc communityDocument
+		if err := cursor.Decode(&doc); err != nil {
+			log.Printf("Error decoding community document: %v", err)
+			return nil, err
+		}
+
+		community, err := r.documentToEntity(&doc)
+		if err != nil {
+			log.Printf("Error converting document to entity: %v", err)
+			return nil, err
+		}
+
+		communities = append(communities, community)
+	}
+
+	if err := cursor.Err(); err != nil {
+		log.Printf("Cursor error: %v", err)
+		return nil, err
+	}
+
+	return communities, nil
+}
+
+// toFilterDocument translates a directory filter to a MongoDB filter
+func toFilterDocument(filter domain_repos.CommunityFilter) bson.M {
+	doc := bson.M{"archived_at": nil}
+	if filter.IsPrivate != nil {
+		doc["is_private"] = *filter.IsPrivate
+	}
+	if filter.OwnerID != nil {
+		doc["owner_id"] = filter.OwnerID.Value()
+	}
+	createdAt := bson.M{}
+	if filter.CreatedFrom != nil {
+		createdAt["$gte"] = filter.CreatedFrom.Unix()
+	}
+	if filter.CreatedTo != nil {
+		createdAt["$lte"] = filter.CreatedTo.Unix()
+	}
+	if len(createdAt) > 0 {
+		doc["created_at"] = createdAt
+	}
+	return doc
+}
+
+// unixOrNil converts an optional timestamp to its stored form
+func unixOrNil(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	unix := t.Unix()
+	return &unix
+}
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"Gommunity/platform/community/domain/model/events"
+	"Gommunity/platform/community/domain/repositories"
+	"Gommunity/shared/infrastructure/messaging/outbox"
+)
+
+// Kafka topics community events are published to
+const (
+	TopicCommunityCreated              = "community.created"
+	TopicCommunityOwnershipTransferred = "community.ownership.transferred"
+	TopicCommunityArchived             = "community.archived"
+)
+
+const communityAggregateType = "community"
+
+type outboxRepositoryImpl struct {
+	store *outbox.Store
+}
+
+func NewOutboxRepository(store *outbox.Store) repositories.OutboxRepository {
+	return &outboxRepositoryImpl{
+		store: store,
+	}
+}
+
+type communityCreatedPayload struct {
+	CommunityID string    `json:"community_id"`
+	OwnerID     string    `json:"owner_id"`
+	Name        string    `json:"name"`
+	OccurredOn  time.Time `json:"occurred_on"`
+}
+
+// SaveCommunityCreated records a CommunityCreated event keyed by community ID
+func (r *outboxRepositoryImpl) SaveCommunityCreated(ctx context.Context, event events.CommunityCreatedEvent) error {
+	payload := communityCreatedPayload{
+		CommunityID: event.CommunityID().Value(),
+		OwnerID:     event.OwnerID().Value(),
+		Name:        event.Name(),
+		OccurredOn:  event.OccurredOn().UTC(),
+	}
+
+	message, err := outbox.NewMessage(TopicCommunityCreated, "CommunityCreated", communityAggregateType, payload.CommunityID, payload, event.OccurredOn())
+	if err != nil {
+		return err
+	}
+	return r.store.Append(ctx, message)
+}
+
+type communityOwnershipTransferredPayload struct {
+	CommunityID     string    `json:"community_id"`
+	TransferID      string    `json:"transfer_id,omitempty"`
+	PreviousOwnerID string    `json:"previous_owner_id"`
+	NewOwnerID      string    `json:"new_owner_id"`
+	Reason          string    `json:"reason"`
+	OccurredOn      time.Time `json:"occurred_on"`
+}
+
+// SaveCommunityOwnershipTransferred records a CommunityOwnershipTransferred event keyed by community ID
+func (r *outboxRepositoryImpl) SaveCommunityOwnershipTransferred(ctx context.Context, event events.CommunityOwnershipTransferredEvent) error {
+	payload := communityOwnershipTransferredPayload{
+		CommunityID:     event.CommunityID().Value(),
+		TransferID:      event.TransferID().Value(),
+		PreviousOwnerID: event.PreviousOwnerID().Value(),
+		NewOwnerID:      event.NewOwnerID().Value(),
+		Reason:          event.Reason(),
+		OccurredOn:      event.OccurredOn().UTC(),
+	}
+
+	message, err := outbox.NewMessage(TopicCommunityOwnershipTransferred, "CommunityOwnershipTransferred", communityAggregateType, payload.CommunityID, payload, event.OccurredOn())
+	if err != nil {
+		return err
+	}
+	return r.store.Append(ctx, message)
+}
+
+type communityArchivedPayload struct {
+	CommunityID string    `json:"community_id"`
+	OwnerID     string    `json:"owner_id"`
+	OccurredOn  time.Time `json:"occurred_on"`
+}
+
+// SaveCommunityArchived records a CommunityArchived event keyed by community ID
+func (r *outboxRepositoryImpl) SaveCommunityArchived(ctx context.Context, event events.CommunityArchivedEvent) error {
+	payload := communityArchivedPayload{
+		CommunityID: event.CommunityID().Value(),
+		OwnerID:     event.OwnerID().Value(),
+		OccurredOn:  event.OccurredOn().UTC(),
+	}
+
+	message, err := outbox.NewMessage(TopicCommunityArchived, "CommunityArchived", communityAggregateType, payload.CommunityID, payload, event.OccurredOn())
+	if err != nil {
+		return err
+	}
+	return r.store.Append(ctx, message)
+}
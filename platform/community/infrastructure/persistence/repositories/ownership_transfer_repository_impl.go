@@ -0,0 +1,211 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"Gommunity/platform/community/domain/model/entities"
+	"Gommunity/platform/community/domain/model/valueobjects"
+	"Gommunity/platform/community/domain/repositories"
+)
+
+type ownershipTransferRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func NewOwnershipTransferRepository(collection *mongo.Collection) repositories.OwnershipTransferRepository {
+	return &ownershipTransferRepositoryImpl{
+		collection: collection,
+	}
+}
+
+// ownershipTransferDocument represents the MongoDB document structure
+type ownershipTransferDocument struct {
+	TransferID  string `bson:"_id"`
+	CommunityID string `bson:"community_id"`
+	FromOwnerID string `bson:"from_owner_id"`
+	NomineeID   string `bson:"nominee_id"`
+	Status      string `bson:"status"`
+	RespondedAt *int64 `bson:"responded_at,omitempty"`
+	CreatedAt   int64  `bson:"created_at"`
+	UpdatedAt   int64  `bson:"updated_at"`
+}
+
+// Save saves a new ownership transfer to the database
+func (r *ownershipTransferRepositoryImpl) Save(ctx context.Context, transfer *entities.OwnershipTransfer) error {
+	doc := r.entityToDocument(transfer)
+
+	_, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errors.New("an ownership transfer is already pending for this community")
+		}
+		log.Printf("Error saving ownership transfer to MongoDB: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// Update persists the status of an existing ownership transfer
+func (r *ownershipTransferRepositoryImpl) Update(ctx context.Context, transfer *entities.OwnershipTransfer) error {
+	doc := r.entityToDocument(transfer)
+
+	filter := bson.M{"_id": doc.TransferID}
+	update := bson.M{
+		"$set": bson.M{
+			"status":       doc.Status,
+			"responded_at": doc.RespondedAt,
+			"updated_at":   doc.UpdatedAt,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		log.Printf("Error updating ownership transfer in MongoDB: %v", err)
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("ownership transfer not found")
+	}
+
+	return nil
+}
+
+// FindPendingByCommunity returns the pending ownership transfer of a community
+func (r *ownershipTransferRepositoryImpl) FindPendingByCommunity(ctx context.Context, communityID valueobjects.CommunityID) (*entities.OwnershipTransfer, error) {
+	filter := bson.M{
+		"community_id": communityID.Value(),
+		"status":       valueobjects.TransferPendingName,
+	}
+
+	var doc ownershipTransferDocument
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Printf("Error finding ownership transfer: %v", err)
+		return nil, err
+	}
+
+	return r.documentToEntity(&doc)
+}
+
+// FindPendingByUser returns the pending ownership transfers started by or nominating the user
+func (r *ownershipTransferRepositoryImpl) FindPendingByUser(ctx context.Context, userID valueobjects.OwnerID) ([]*entities.OwnershipTransfer, error) {
+	filter := bson.M{
+		"status": valueobjects.TransferPendingName,
+		"$or": []bson.M{
+			{"from_owner_id": userID.Value()},
+			{"nominee_id": userID.Value()},
+		},
+	}
+
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		log.Printf("Error finding ownership transfers: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var transfers []*entities.OwnershipTransfer
+	for cursor.Next(ctx) {
+		var doc ownershipTransferDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		transfer, err := r.documentToEntity(&doc)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, transfer)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// DeleteByCommunity removes every ownership transfer of a community
+func (r *ownershipTransferRepositoryImpl) DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"community_id": communityID.Value()})
+	if err != nil {
+		log.Printf("Error deleting ownership transfers: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *ownershipTransferRepositoryImpl) entityToDocument(transfer *entities.OwnershipTransfer) *ownershipTransferDocument {
+	doc := &ownershipTransferDocument{
+		TransferID:  transfer.TransferID().Value(),
+		CommunityID: transfer.CommunityID().Value(),
+		FromOwnerID: transfer.FromOwnerID().Value(),
+		NomineeID:   transfer.NomineeID().Value(),
+		Status:      transfer.Status().Value(),
+		CreatedAt:   transfer.CreatedAt().Unix(),
+		UpdatedAt:   transfer.UpdatedAt().Unix(),
+	}
+
+	if respondedAt := transfer.RespondedAt(); respondedAt != nil {
+		value := respondedAt.Unix()
+		doc.RespondedAt = &value
+	}
+
+	return doc
+}
+
+func (r *ownershipTransferRepositoryImpl) documentToEntity(doc *ownershipTransferDocument) (*entities.OwnershipTransfer, error) {
+	transferID, err := valueobjects.NewOwnershipTransferID(doc.TransferID)
+	if err != nil {
+		return nil, err
+	}
+
+	communityID, err := valueobjects.NewCommunityID(doc.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	fromOwnerID, err := valueobjects.NewOwnerID(doc.FromOwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	nomineeID, err := valueobjects.NewOwnerID(doc.NomineeID)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := valueobjects.NewTransferStatus(doc.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	var respondedAt *time.Time
+	if doc.RespondedAt != nil {
+		t := time.Unix(*doc.RespondedAt, 0)
+		respondedAt = &t
+	}
+
+	return entities.ReconstructOwnershipTransfer(
+		transferID,
+		communityID,
+		fromOwnerID,
+		nomineeID,
+		status,
+		respondedAt,
+		time.Unix(doc.CreatedAt, 0),
+		time.Unix(doc.UpdatedAt, 0),
+	), nil
+}
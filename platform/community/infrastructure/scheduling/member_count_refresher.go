@@ -0,0 +1,69 @@
+package scheduling
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"Gommunity/platform/community/domain/services"
+)
+
+// MemberCountRefresher periodically stores the member count of every community,
+// which the directory uses to sort communities by size.
+type MemberCountRefresher struct {
+	commandService services.CommunityCommandService
+	interval       time.Duration
+	stop           chan struct{}
+	done           sync.WaitGroup
+}
+
+// NewMemberCountRefresher creates a refresher that recounts members every interval.
+func NewMemberCountRefresher(commandService services.CommunityCommandService, interval time.Duration) *MemberCountRefresher {
+	return &MemberCountRefresher{
+		commandService: commandService,
+		interval:       interval,
+		stop:           make(chan struct{}),
+	}
+}
+
+// Start runs the refresher in a background goroutine until Stop is called or ctx is cancelled.
+// The first refresh runs right away so that a fresh deployment sorts by members without waiting a full interval.
+func (r *MemberCountRefresher) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	r.done.Add(1)
+	go func() {
+		defer r.done.Done()
+		defer ticker.Stop()
+
+		log.Printf("Member count refresher started (interval %s)", r.interval)
+		r.refresh(ctx)
+		for {
+			select {
+			case <-ticker.C:
+				r.refresh(ctx)
+			case <-r.stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop signals the refresher to exit and waits for the current run to finish.
+func (r *MemberCountRefresher) Stop() {
+	close(r.stop)
+	r.done.Wait()
+	log.Println("Member count refresher stopped")
+}
+
+func (r *MemberCountRefresher) refresh(ctx context.Context) {
+	refreshed, err := r.commandService.HandleRefreshMemberCounts(ctx)
+	if err != nil {
+		log.Printf("Member count refresh error: %v", err)
+	}
+	if refreshed > 0 {
+		log.Printf("Refreshed the member counts of %d communities", refreshed)
+	}
+}
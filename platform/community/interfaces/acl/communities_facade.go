@@ -2,6 +2,23 @@ package acl
 
 import "context"
 
+// CommunitySearchData represents a community search hit exposed to other bounded contexts
+type CommunitySearchData struct {
+	CommunityID string
+	Name        string
+	Description string
+	IconURL     *string
+	IsPrivate   bool
+}
+
+// CommunitySummaryData represents the public details of a community exposed to other bounded contexts
+type CommunitySummaryData struct {
+	CommunityID string
+	Name        string
+	IconURL     *string
+	IsPrivate   bool
+}
+
 // CommunitiesFacade provides an anti-corruption layer for accessing Community bounded context functionality
 // This facade exposes only the necessary operations needed by other bounded contexts
 type CommunitiesFacade interface {
@@ -11,9 +28,27 @@ type CommunitiesFacade interface {
 	// IsCommunityPrivate checks if a community is private
 	IsCommunityPrivate(ctx context.Context, communityID string) (bool, error)
 
+	// IsCommunityArchived checks if a community was archived after its owner was removed.
+	// An archived community stays readable but takes no new members, posts, comments or reactions.
+	IsCommunityArchived(ctx context.Context, communityID string) (bool, error)
+
+	// AdminsCanManageAdmins reports whether the owner lets admins promote members to admin and change other admins' roles
+	AdminsCanManageAdmins(ctx context.Context, communityID string) (bool, error)
+
 	// GetCommunityOwnerID retrieves the owner ID of a community (as string UUID)
 	GetCommunityOwnerID(ctx context.Context, communityID string) (string, error)
 
 	// ValidateUserIsOwner checks if a user is the owner of a community
 	ValidateUserIsOwner(ctx context.Context, communityID string, ownerID string) (bool, error)
+
+	// SearchCommunities runs a full-text search on community name and description, most relevant first.
+	// Archived communities are left out.
+	SearchCommunities(ctx context.Context, text string, limit, offset int) ([]*CommunitySearchData, error)
+
+	// GetCommunitiesByIDs retrieves the details of several communities in a single lookup.
+	// Unknown communities are absent from the result.
+	GetCommunitiesByIDs(ctx context.Context, communityIDs []string) ([]*CommunitySummaryData, error)
+
+	// GetPublicCommunityIDs retrieves the IDs of every public community that is not archived
+	GetPublicCommunityIDs(ctx context.Context) ([]string, error)
 }
@@ -3,6 +3,8 @@ package controllers
 import (
 	"log"
 	"net/http"
+	"strconv"
+	"time"
 
 	"Gommunity/platform/community/domain/model/commands"
 	"Gommunity/platform/community/domain/model/entities"
@@ -250,20 +252,35 @@ func (c *CommunityController) GetMyCommunitiesAsOwner(ctx *gin.Context) {
 }
 
 // GetAllCommunities godoc
-// @Summary Get all communities
-// @Description Get all communities (paginated)
+// @Summary Get community directory
+// @Description Get a page of the community directory, with the member count of each community.
+// @Description The body is an array of communities as before, now paged: 20 communities are returned unless limit says otherwise,
+// @Description and the X-Total-Count header holds the number of matching communities, so clients that need every community follow offset until it is reached.
+// @Description Sorting by members uses counts refreshed in the background, so the order can briefly lag behind recent joins.
 // @Tags communities
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {array} resources.CommunityResource
+// @Param visibility query string false "Only public or only private communities" Enums(public, private)
+// @Param owner_id query string false "Only communities of this owner (UUID)"
+// @Param created_from query string false "Only communities created at or after this time (RFC 3339)"
+// @Param created_to query string false "Only communities created at or before this time (RFC 3339)"
+// @Param sort query string false "Sort order" Enums(newest, name, members) default(newest)
+// @Param limit query int false "Number of items per page" minimum(1) maximum(100) default(20)
+// @Param offset query int false "Page offset" minimum(0) default(0)
+// @Success 200 {array} resources.CommunityDirectoryEntryResource
+// @Header 200 {integer} X-Total-Count "Number of communities matching the filters"
+// @Failure 400 {object} resources.ErrorResponse
 // @Failure 401 {object} resources.ErrorResponse
 // @Failure 500 {object} resources.ErrorResponse
 // @Router /api/v1/communities [get]
 func (c *CommunityController) GetAllCommunities(ctx *gin.Context) {
-	query := queries.NewGetAllCommunitiesQuery()
+	query, ok := c.parseDirectoryQuery(ctx)
+	if !ok {
+		return
+	}
 
-	communities, err := c.queryService.HandleGetAll(ctx.Request.Context(), query)
+	directory, err := c.queryService.HandleGetAll(ctx.Request.Context(), query)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{
 			Error: "Failed to retrieve communities",
@@ -271,14 +288,97 @@ func (c *CommunityController) GetAllCommunities(ctx *gin.Context) {
 		return
 	}
 
-	response := make([]resources.CommunityResource, 0, len(communities))
-	for _, community := range communities {
-		response = append(response, c.transformCommunityToResource(community))
+	response := make([]resources.CommunityDirectoryEntryResource, 0, len(directory.Entries))
+	for _, entry := range directory.Entries {
+		response = append(response, resources.CommunityDirectoryEntryResource{
+			CommunityResource: c.transformCommunityToResource(entry.Community),
+			MemberCount:       entry.MemberCount,
+		})
 	}
 
+	// The body stays a plain array for existing clients; the total travels in a header
+	ctx.Header("X-Total-Count", strconv.FormatInt(directory.Total, 10))
 	ctx.JSON(http.StatusOK, response)
 }
 
+// parseDirectoryQuery builds the directory query from the query string, writing the error
+// response itself and returning false on invalid input
+func (c *CommunityController) parseDirectoryQuery(ctx *gin.Context) (queries.GetAllCommunitiesQuery, bool) {
+	query := queries.NewGetAllCommunitiesQuery()
+
+	limit := 20
+	if limitParam := ctx.Query("limit"); limitParam != "" {
+		parsed, err := strconv.Atoi(limitParam)
+		if err != nil || parsed <= 0 || parsed > 100 {
+			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "Limit must be between 1 and 100"})
+			return query, false
+		}
+		limit = parsed
+	}
+
+	offset := 0
+	if offsetParam := ctx.Query("offset"); offsetParam != "" {
+		parsed, err := strconv.Atoi(offsetParam)
+		if err != nil || parsed < 0 {
+			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "Offset must be zero or positive"})
+			return query, false
+		}
+		offset = parsed
+	}
+	query = query.WithPagination(limit, offset)
+
+	switch ctx.Query("visibility") {
+	case "":
+	case "public":
+		query = query.WithPrivacy(false)
+	case "private":
+		query = query.WithPrivacy(true)
+	default:
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "Visibility must be either public or private"})
+		return query, false
+	}
+
+	if ownerParam := ctx.Query("owner_id"); ownerParam != "" {
+		ownerID, err := valueobjects.NewOwnerID(ownerParam)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+			return query, false
+		}
+		query = query.WithOwner(ownerID)
+	}
+
+	var createdFrom, createdTo time.Time
+	if fromParam := ctx.Query("created_from"); fromParam != "" {
+		parsed, err := time.Parse(time.RFC3339, fromParam)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "created_from must be an RFC 3339 timestamp"})
+			return query, false
+		}
+		createdFrom = parsed
+	}
+	if toParam := ctx.Query("created_to"); toParam != "" {
+		parsed, err := time.Parse(time.RFC3339, toParam)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "created_to must be an RFC 3339 timestamp"})
+			return query, false
+		}
+		createdTo = parsed
+	}
+	query, err := query.WithCreatedBetween(createdFrom, createdTo)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return query, false
+	}
+
+	sort, err := valueobjects.NewCommunitySort(ctx.DefaultQuery("sort", valueobjects.NewestCommunitySort))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return query, false
+	}
+
+	return query.WithSort(sort), true
+}
+
 // DeleteCommunity godoc
 // @Summary Delete community
 // @Description Delete a community (only owner can delete)
@@ -593,6 +693,109 @@ func (c *CommunityController) UpdateCommunityPrivacy(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+// UpdateAdminRoleManagement godoc
+// @Summary Let admins manage other admins
+// @Description Allow or forbid community admins to promote members to admin and to change other admins' roles (only owner can update)
+// @Tags communities
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Param request body resources.UpdateAdminRoleManagementResource true "Admin role management setting"
+// @Success 200 {object} resources.CommunityResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/admin-role-management [patch]
+func (c *CommunityController) UpdateAdminRoleManagement(ctx *gin.Context) {
+	communityIDParam := ctx.Param("community_id")
+
+	// Get authenticated user ID from context
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, resources.ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(communityIDParam)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: "Invalid community ID format",
+		})
+		return
+	}
+
+	var req resources.UpdateAdminRoleManagementResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: "Invalid request body",
+		})
+		return
+	}
+
+	// First, verify the community exists and the user is the owner
+	query, err := queries.NewGetCommunityByIDQuery(communityID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	community, err := c.queryService.HandleGetByID(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{
+			Error: "Failed to retrieve community",
+		})
+		return
+	}
+
+	if community == nil {
+		ctx.JSON(http.StatusNotFound, resources.ErrorResponse{
+			Error: "Community not found",
+		})
+		return
+	}
+
+	if !community.IsOwner(authenticatedUserID) {
+		ctx.JSON(http.StatusForbidden, resources.ErrorResponse{
+			Error: "Only the owner can change who manages admins",
+		})
+		return
+	}
+
+	cmd, err := commands.NewUpdateAdminRoleManagementCommand(communityID, *req.AdminsCanManageAdmins)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if err := c.commandService.HandleUpdateAdminRoleManagement(ctx.Request.Context(), cmd); err != nil {
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{
+			Error: "Failed to update admin role management",
+		})
+		return
+	}
+
+	// Retrieve updated community
+	updatedCommunity, err := c.queryService.HandleGetByID(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{
+			Error: "Failed to retrieve updated community",
+		})
+		return
+	}
+
+	response := c.transformCommunityToResource(updatedCommunity)
+	ctx.JSON(http.StatusOK, response)
+}
+
 func (c *CommunityController) transformCommunityToResource(community *entities.Community) resources.CommunityResource {
 	return resources.CommunityResource{
 		ID:          community.ID(),
@@ -605,5 +808,8 @@ func (c *CommunityController) transformCommunityToResource(community *entities.C
 		IsPrivate:   community.IsPrivate(),
 		CreatedAt:   community.CreatedAt(),
 		UpdatedAt:   community.UpdatedAt(),
+
+		AdminsCanManageAdmins: community.AdminsCanManageAdmins(),
+		ArchivedAt:            community.ArchivedAt(),
 	}
 }
@@ -0,0 +1,371 @@
+package controllers
+
+import (
+	"net/http"
+
+	"Gommunity/platform/community/domain/model/commands"
+	"Gommunity/platform/community/domain/model/entities"
+	"Gommunity/platform/community/domain/model/queries"
+	"Gommunity/platform/community/domain/model/valueobjects"
+	"Gommunity/platform/community/domain/services"
+	"Gommunity/platform/community/interfaces/rest/resources"
+	"Gommunity/shared/infrastructure/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OwnershipTransferController struct {
+	commandService        services.OwnershipTransferCommandService
+	queryService          services.OwnershipTransferQueryService
+	communityQueryService services.CommunityQueryService
+}
+
+func NewOwnershipTransferController(
+	commandService services.OwnershipTransferCommandService,
+	queryService services.OwnershipTransferQueryService,
+	communityQueryService services.CommunityQueryService,
+) *OwnershipTransferController {
+	return &OwnershipTransferController{
+		commandService:        commandService,
+		queryService:          queryService,
+		communityQueryService: communityQueryService,
+	}
+}
+
+// NominateOwner godoc
+// @Summary Nominate a new community owner
+// @Description Start an ownership transfer to an existing admin or member (only owner can nominate). The transfer completes when the nominee accepts it.
+// @Tags communities
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Param request body resources.NominateOwnerResource true "Nominee"
+// @Success 201 {object} resources.OwnershipTransferResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 409 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/ownership-transfer [post]
+func (c *OwnershipTransferController) NominateOwner(ctx *gin.Context) {
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, resources.ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: "Invalid community ID format",
+		})
+		return
+	}
+
+	var req resources.NominateOwnerResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: "Invalid request body",
+		})
+		return
+	}
+
+	ownerID, err := valueobjects.NewOwnerID(authenticatedUserID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: "Invalid owner ID",
+		})
+		return
+	}
+
+	nomineeID, err := valueobjects.NewOwnerID(req.NomineeID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: "Invalid nominee ID",
+		})
+		return
+	}
+
+	cmd, err := commands.NewNominateOwnerCommand(communityID, ownerID, nomineeID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	transfer, err := c.commandService.HandleNominate(ctx.Request.Context(), cmd)
+	if err != nil {
+		c.respondWithError(ctx, err, "Failed to nominate new owner")
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, c.transformTransferToResource(transfer))
+}
+
+// GetPendingTransfer godoc
+// @Summary Get the pending ownership transfer
+// @Description Get the pending ownership transfer of a community (only the owner and the nominee can see it)
+// @Tags communities
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Success 200 {object} resources.OwnershipTransferResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/ownership-transfer [get]
+func (c *OwnershipTransferController) GetPendingTransfer(ctx *gin.Context) {
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, resources.ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: "Invalid community ID format",
+		})
+		return
+	}
+
+	communityQuery, err := queries.NewGetCommunityByIDQuery(communityID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	community, err := c.communityQueryService.HandleGetByID(ctx.Request.Context(), communityQuery)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{
+			Error: "Failed to retrieve community",
+		})
+		return
+	}
+
+	if community == nil {
+		ctx.JSON(http.StatusNotFound, resources.ErrorResponse{
+			Error: "Community not found",
+		})
+		return
+	}
+
+	query, err := queries.NewGetPendingOwnershipTransferQuery(communityID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	transfer, err := c.queryService.HandleGetPending(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{
+			Error: "Failed to retrieve ownership transfer",
+		})
+		return
+	}
+
+	if transfer == nil {
+		ctx.JSON(http.StatusNotFound, resources.ErrorResponse{
+			Error: "No pending ownership transfer",
+		})
+		return
+	}
+
+	if !community.IsOwner(authenticatedUserID) && !transfer.IsNominee(authenticatedUserID) {
+		ctx.JSON(http.StatusForbidden, resources.ErrorResponse{
+			Error: "Only the owner and the nominee can view the ownership transfer",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.transformTransferToResource(transfer))
+}
+
+// AcceptTransfer godoc
+// @Summary Accept an ownership transfer
+// @Description Accept the pending ownership transfer (only the nominee can accept). The nominee becomes the owner and the previous owner takes over the nominee's former role.
+// @Tags communities
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Success 200 {object} resources.OwnershipTransferResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 409 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/ownership-transfer/accept [post]
+func (c *OwnershipTransferController) AcceptTransfer(ctx *gin.Context) {
+	c.respond(ctx, true)
+}
+
+// DeclineTransfer godoc
+// @Summary Decline an ownership transfer
+// @Description Decline the pending ownership transfer (only the nominee can decline)
+// @Tags communities
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Success 200 {object} resources.OwnershipTransferResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 409 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/ownership-transfer/decline [post]
+func (c *OwnershipTransferController) DeclineTransfer(ctx *gin.Context) {
+	c.respond(ctx, false)
+}
+
+// CancelTransfer godoc
+// @Summary Cancel an ownership transfer
+// @Description Cancel the pending ownership transfer (only owner can cancel)
+// @Tags communities
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Success 204
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/ownership-transfer [delete]
+func (c *OwnershipTransferController) CancelTransfer(ctx *gin.Context) {
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, resources.ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: "Invalid community ID format",
+		})
+		return
+	}
+
+	ownerID, err := valueobjects.NewOwnerID(authenticatedUserID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: "Invalid owner ID",
+		})
+		return
+	}
+
+	cmd, err := commands.NewCancelOwnershipTransferCommand(communityID, ownerID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if err := c.commandService.HandleCancel(ctx.Request.Context(), cmd); err != nil {
+		c.respondWithError(ctx, err, "Failed to cancel ownership transfer")
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *OwnershipTransferController) respond(ctx *gin.Context, accept bool) {
+	authenticatedUserID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, resources.ErrorResponse{
+			Error: "Authentication required",
+		})
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: "Invalid community ID format",
+		})
+		return
+	}
+
+	nomineeID, err := valueobjects.NewOwnerID(authenticatedUserID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: "Invalid user ID",
+		})
+		return
+	}
+
+	cmd, err := commands.NewRespondToOwnershipTransferCommand(communityID, nomineeID, accept)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	transfer, err := c.commandService.HandleRespond(ctx.Request.Context(), cmd)
+	if err != nil {
+		c.respondWithError(ctx, err, "Failed to respond to ownership transfer")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.transformTransferToResource(transfer))
+}
+
+// respondWithError maps ownership transfer errors to HTTP responses
+func (c *OwnershipTransferController) respondWithError(ctx *gin.Context, err error, fallback string) {
+	switch err.Error() {
+	case "community not found":
+		ctx.JSON(http.StatusNotFound, resources.ErrorResponse{Error: "Community not found"})
+	case "no pending ownership transfer":
+		ctx.JSON(http.StatusNotFound, resources.ErrorResponse{Error: "No pending ownership transfer"})
+	case "only the owner can transfer ownership":
+		ctx.JSON(http.StatusForbidden, resources.ErrorResponse{Error: "Only the owner can transfer ownership"})
+	case "only the owner can cancel the ownership transfer":
+		ctx.JSON(http.StatusForbidden, resources.ErrorResponse{Error: "Only the owner can cancel the ownership transfer"})
+	case "only the nominee can respond to the ownership transfer":
+		ctx.JSON(http.StatusForbidden, resources.ErrorResponse{Error: "Only the nominee can respond to the ownership transfer"})
+	case "the nominee is not a member of this community":
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "The nominee is not a member of this community"})
+	case "the nominee already owns the community":
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "The nominee already owns the community"})
+	case "an ownership transfer is already pending for this community":
+		ctx.JSON(http.StatusConflict, resources.ErrorResponse{Error: "An ownership transfer is already pending for this community"})
+	case "ownership transfer is no longer pending":
+		ctx.JSON(http.StatusConflict, resources.ErrorResponse{Error: "Ownership transfer is no longer pending"})
+	case "new owner is not subscribed to this community":
+		ctx.JSON(http.StatusConflict, resources.ErrorResponse{Error: "The nominee is no longer a member of this community"})
+	default:
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{Error: fallback})
+	}
+}
+
+func (c *OwnershipTransferController) transformTransferToResource(transfer *entities.OwnershipTransfer) resources.OwnershipTransferResource {
+	return resources.OwnershipTransferResource{
+		TransferID:  transfer.TransferID().Value(),
+		CommunityID: transfer.CommunityID().Value(),
+		FromOwnerID: transfer.FromOwnerID().Value(),
+		NomineeID:   transfer.NomineeID().Value(),
+		Status:      transfer.Status().Value(),
+		RespondedAt: transfer.RespondedAt(),
+		CreatedAt:   transfer.CreatedAt(),
+		UpdatedAt:   transfer.UpdatedAt(),
+	}
+}
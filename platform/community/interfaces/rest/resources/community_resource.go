@@ -14,6 +14,15 @@ type CommunityResource struct {
 	IsPrivate   bool      `json:"isPrivate" example:"false"`
 	CreatedAt   time.Time `json:"createdAt" example:"2025-11-13T17:02:46Z"`
 	UpdatedAt   time.Time `json:"updatedAt" example:"2025-11-13T17:02:46Z"`
+
+	AdminsCanManageAdmins bool       `json:"adminsCanManageAdmins" example:"false"`
+	ArchivedAt            *time.Time `json:"archivedAt,omitempty" example:"2025-11-13T17:02:46Z"`
+}
+
+// CommunityDirectoryEntryResource represents a community listed in the directory
+type CommunityDirectoryEntryResource struct {
+	CommunityResource
+	MemberCount int64 `json:"memberCount" example:"42"`
 }
 
 // CreateCommunityResource represents the request to create a community
@@ -38,6 +47,11 @@ type UpdateCommunityPrivacyResource struct {
 	IsPrivate bool `json:"isPrivate" binding:"required" example:"true"`
 }
 
+// UpdateAdminRoleManagementResource represents the request to let admins manage other admins
+type UpdateAdminRoleManagementResource struct {
+	AdminsCanManageAdmins *bool `json:"adminsCanManageAdmins" binding:"required" example:"true"`
+}
+
 // ErrorResponse represents an error response
 type ErrorResponse struct {
 	Error string `json:"error" example:"Invalid request"`
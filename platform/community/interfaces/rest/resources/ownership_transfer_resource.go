@@ -0,0 +1,20 @@
+package resources
+
+import "time"
+
+// OwnershipTransferResource represents an ownership transfer in API responses
+type OwnershipTransferResource struct {
+	TransferID  string     `json:"transferId" example:"550e8400-e29b-41d4-a716-446655440003"`
+	CommunityID string     `json:"communityId" example:"550e8400-e29b-41d4-a716-446655440001"`
+	FromOwnerID string     `json:"fromOwnerId" example:"550e8400-e29b-41d4-a716-446655440002"`
+	NomineeID   string     `json:"nomineeId" example:"550e8400-e29b-41d4-a716-446655440004"`
+	Status      string     `json:"status" example:"pending"`
+	RespondedAt *time.Time `json:"respondedAt,omitempty" example:"2025-11-14T09:30:00Z"`
+	CreatedAt   time.Time  `json:"createdAt" example:"2025-11-13T17:02:46Z"`
+	UpdatedAt   time.Time  `json:"updatedAt" example:"2025-11-13T17:02:46Z"`
+}
+
+// NominateOwnerResource represents the request to nominate a new community owner
+type NominateOwnerResource struct {
+	NomineeID string `json:"nomineeId" binding:"required" example:"550e8400-e29b-41d4-a716-446655440004"`
+}
@@ -19,13 +19,29 @@ func NewExternalPostsService(postsFacade posts_acl.PostsFacade) *ExternalPostsSe
 	}
 }
 
-// GetAnnouncementsForCommunities retrieves announcements from multiple communities
-func (s *ExternalPostsService) GetAnnouncementsForCommunities(ctx context.Context, communityIDs []string, limit, offset *int) ([]*entities.FeedItem, error) {
-	postsData, err := s.postsFacade.GetAnnouncementsByCommunities(ctx, communityIDs, limit, offset)
+// GetPostsForCommunities retrieves one page of posts of every type from multiple communities,
+// along with the cursor of the next page
+func (s *ExternalPostsService) GetPostsForCommunities(ctx context.Context, communityIDs []string, limit, offset *int, cursor string) ([]*entities.FeedItem, string, error) {
+	postsData, nextCursor, err := s.postsFacade.GetPostsByCommunities(ctx, communityIDs, limit, offset, cursor)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
+	return toFeedItems(postsData), nextCursor, nil
+}
+
+// GetAnnouncementsForCommunities retrieves one page of announcements from multiple communities,
+// along with the cursor of the next page
+func (s *ExternalPostsService) GetAnnouncementsForCommunities(ctx context.Context, communityIDs []string, limit, offset *int, cursor string) ([]*entities.FeedItem, string, error) {
+	postsData, nextCursor, err := s.postsFacade.GetAnnouncementsByCommunities(ctx, communityIDs, limit, offset, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return toFeedItems(postsData), nextCursor, nil
+}
+
+func toFeedItems(postsData []*posts_acl.PostData) []*entities.FeedItem {
 	feedItems := make([]*entities.FeedItem, len(postsData))
 	for i, postData := range postsData {
 		postID, _ := valueobjects.NewPostID(postData.PostID)
@@ -42,5 +58,5 @@ func (s *ExternalPostsService) GetAnnouncementsForCommunities(ctx context.Contex
 		)
 	}
 
-	return feedItems, nil
+	return feedItems
 }
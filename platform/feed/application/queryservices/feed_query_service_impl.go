@@ -25,30 +25,36 @@ func NewFeedQueryService(
 	}
 }
 
-func (s *feedQueryServiceImpl) Handle(ctx context.Context, query queries.GetUserFeedQuery) ([]*entities.FeedItem, error) {
+func (s *feedQueryServiceImpl) Handle(ctx context.Context, query queries.GetUserFeedQuery) ([]*entities.FeedItem, string, error) {
 	log.Printf("Getting feed for user: %s", query.UserID().Value())
 
 	// Step 1: Get all communities the user is subscribed to
 	communityIDs, err := s.subscriptionsService.GetUserCommunities(ctx, query.UserID().Value())
 	if err != nil {
 		log.Printf("Error getting user communities: %v", err)
-		return nil, err
+		return nil, "", err
 	}
 
 	log.Printf("User is subscribed to %d communities", len(communityIDs))
 
 	// Step 2: If user is not subscribed to any community, return empty feed
 	if len(communityIDs) == 0 {
-		return []*entities.FeedItem{}, nil
+		return []*entities.FeedItem{}, "", nil
 	}
 
-	// Step 3: Get announcements from those communities
-	feedItems, err := s.postsService.GetAnnouncementsForCommunities(ctx, communityIDs, query.Limit(), query.Offset())
+	// Step 3: Get posts (or only announcements) from those communities
+	var feedItems []*entities.FeedItem
+	var nextCursor string
+	if query.AnnouncementsOnly() {
+		feedItems, nextCursor, err = s.postsService.GetAnnouncementsForCommunities(ctx, communityIDs, query.Limit(), query.Offset(), query.Cursor())
+	} else {
+		feedItems, nextCursor, err = s.postsService.GetPostsForCommunities(ctx, communityIDs, query.Limit(), query.Offset(), query.Cursor())
+	}
 	if err != nil {
-		log.Printf("Error getting announcements: %v", err)
-		return nil, err
+		log.Printf("Error getting feed posts: %v", err)
+		return nil, "", err
 	}
 
 	log.Printf("Found %d feed items for user", len(feedItems))
-	return feedItems, nil
+	return feedItems, nextCursor, nil
 }
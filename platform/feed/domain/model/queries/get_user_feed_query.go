@@ -6,9 +6,11 @@ import (
 )
 
 type GetUserFeedQuery struct {
-	userID valueobjects.UserID
-	limit  *int
-	offset *int
+	userID            valueobjects.UserID
+	limit             *int
+	offset            *int
+	cursor            string
+	announcementsOnly bool
 }
 
 func NewGetUserFeedQuery(userID valueobjects.UserID) (GetUserFeedQuery, error) {
@@ -24,6 +26,19 @@ func (q GetUserFeedQuery) WithPagination(limit, offset int) GetUserFeedQuery {
 	return q
 }
 
+// WithCursor continues the feed right after the opaque cursor of a previous page.
+// It takes precedence over the deprecated offset.
+func (q GetUserFeedQuery) WithCursor(cursor string) GetUserFeedQuery {
+	q.cursor = cursor
+	return q
+}
+
+// WithAnnouncementsOnly restricts the feed to announcement posts
+func (q GetUserFeedQuery) WithAnnouncementsOnly() GetUserFeedQuery {
+	q.announcementsOnly = true
+	return q
+}
+
 func (q GetUserFeedQuery) UserID() valueobjects.UserID {
 	return q.userID
 }
@@ -35,3 +50,11 @@ func (q GetUserFeedQuery) Limit() *int {
 func (q GetUserFeedQuery) Offset() *int {
 	return q.offset
 }
+
+func (q GetUserFeedQuery) Cursor() string {
+	return q.cursor
+}
+
+func (q GetUserFeedQuery) AnnouncementsOnly() bool {
+	return q.announcementsOnly
+}
@@ -7,5 +7,6 @@ import (
 )
 
 type FeedQueryService interface {
-	Handle(ctx context.Context, query queries.GetUserFeedQuery) ([]*entities.FeedItem, error)
+	// Handle returns one page of the feed and the cursor of the next page (empty on the last page)
+	Handle(ctx context.Context, query queries.GetUserFeedQuery) ([]*entities.FeedItem, string, error)
 }
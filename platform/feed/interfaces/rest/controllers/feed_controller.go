@@ -3,6 +3,7 @@ package controllers
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
@@ -24,12 +25,14 @@ func NewFeedController(queryService services.FeedQueryService) *FeedController {
 
 // GetUserFeed retrieves the feed for the authenticated user
 // @Summary Get user feed
-// @Description Retrieves announcements from all communities the user is subscribed to
+// @Description Retrieves posts from all communities the user is subscribed to. Use type=announcement to only get announcements.
 // @Tags feed
 // @Accept json
 // @Produce json
+// @Param type query string false "Feed mode" Enums(all, announcement) default(all)
 // @Param limit query int false "Number of items per page" default(20)
-// @Param offset query int false "Page offset" default(0)
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param offset query int false "Page offset (deprecated, use cursor)" default(0)
 // @Success 200 {object} resources.FeedResponse
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
@@ -80,10 +83,23 @@ func (c *FeedController) GetUserFeed(ctx *gin.Context) {
 		return
 	}
 
-	query = query.WithPagination(limit, offset)
+	query = query.WithPagination(limit, offset).WithCursor(ctx.Query("cursor"))
 
-	feedItems, err := c.queryService.Handle(ctx.Request.Context(), query)
+	switch ctx.DefaultQuery("type", "all") {
+	case "all":
+	case "announcement":
+		query = query.WithAnnouncementsOnly()
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "type must be either all or announcement"})
+		return
+	}
+
+	feedItems, nextCursor, err := c.queryService.Handle(ctx.Request.Context(), query)
 	if err != nil {
+		if strings.Contains(err.Error(), "invalid cursor") {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid cursor"})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to retrieve feed"})
 		return
 	}
@@ -103,8 +119,9 @@ func (c *FeedController) GetUserFeed(ctx *gin.Context) {
 	}
 
 	response := resources.FeedResponse{
-		Items: items,
-		Total: len(items),
+		Items:      items,
+		Total:      len(items),
+		NextCursor: nextCursor,
 	}
 
 	ctx.JSON(http.StatusOK, response)
@@ -15,6 +15,7 @@ type FeedItemResource struct {
 
 // FeedResponse represents the feed response with pagination info
 type FeedResponse struct {
-	Items []FeedItemResource `json:"items"`
-	Total int                `json:"total" example:"10"`
+	Items      []FeedItemResource `json:"items"`
+	Total      int                `json:"total" example:"10"`
+	NextCursor string             `json:"nextCursor,omitempty" example:"MTczNjY4MzIwMDo2NGMyZjFlNWI5ZDNhNDVmNzg5MDlhYmM"`
 }
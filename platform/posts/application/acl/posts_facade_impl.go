@@ -3,6 +3,7 @@ package acl
 import (
 	"context"
 
+	"Gommunity/platform/posts/domain/model/entities"
 	"Gommunity/platform/posts/domain/model/queries"
 	"Gommunity/platform/posts/domain/model/valueobjects"
 	"Gommunity/platform/posts/domain/repositories"
@@ -11,15 +12,17 @@ import (
 )
 
 type postsFacadeImpl struct {
-	queryService services.PostQueryService
-	postRepo     repositories.PostRepository
+	queryService   services.PostQueryService
+	commandService services.PostCommandService
+	postRepo       repositories.PostRepository
 }
 
 // NewPostsFacade constructs the posts facade implementation.
-func NewPostsFacade(queryService services.PostQueryService, postRepo repositories.PostRepository) acl.PostsFacade {
+func NewPostsFacade(queryService services.PostQueryService, commandService services.PostCommandService, postRepo repositories.PostRepository) acl.PostsFacade {
 	return &postsFacadeImpl{
-		queryService: queryService,
-		postRepo:     postRepo,
+		queryService:   queryService,
+		commandService: commandService,
+		postRepo:       postRepo,
 	}
 }
 
@@ -43,11 +46,112 @@ func (f *postsFacadeImpl) PostExists(ctx context.Context, postID string) (bool,
 	return post != nil, nil
 }
 
-// GetAnnouncementsByCommunities retrieves posts from multiple communities.
-// Note: Announcements have been removed - all posts are messages now.
-// This method returns all posts for backward compatibility with Feed BC.
-func (f *postsFacadeImpl) GetAnnouncementsByCommunities(ctx context.Context, communityIDs []string, limit, offset *int) ([]*acl.PostData, error) {
-	// Convert string IDs to value objects
+// GetPostCommunityID returns the community identifier of a post.
+func (f *postsFacadeImpl) GetPostCommunityID(ctx context.Context, postID string) (string, error) {
+	postIDVO, err := valueobjects.NewPostID(postID)
+	if err != nil {
+		return "", err
+	}
+
+	query, err := queries.NewGetPostByIDQuery(postIDVO)
+	if err != nil {
+		return "", err
+	}
+
+	post, err := f.queryService.HandleGetByID(ctx, query)
+	if err != nil {
+		return "", err
+	}
+	if post == nil {
+		return "", nil
+	}
+
+	return post.CommunityID().Value(), nil
+}
+
+// GetPostsByCommunities retrieves one page of posts of every type from multiple communities.
+func (f *postsFacadeImpl) GetPostsByCommunities(ctx context.Context, communityIDs []string, limit, offset *int, cursor string) ([]*acl.PostData, string, error) {
+	communityIDVOs := toCommunityIDs(communityIDs)
+	if len(communityIDVOs) == 0 {
+		return []*acl.PostData{}, "", nil
+	}
+
+	cursorVO, err := toCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	posts, err := f.postRepo.FindByCommunities(ctx, communityIDVOs, pageLimit(limit), offset, cursorVO)
+	if err != nil {
+		return nil, "", err
+	}
+
+	posts, next := nextPage(posts, limit)
+	return toPostData(posts), next, nil
+}
+
+// GetAnnouncementsByCommunities retrieves one page of announcements from multiple communities.
+func (f *postsFacadeImpl) GetAnnouncementsByCommunities(ctx context.Context, communityIDs []string, limit, offset *int, cursor string) ([]*acl.PostData, string, error) {
+	communityIDVOs := toCommunityIDs(communityIDs)
+	if len(communityIDVOs) == 0 {
+		return []*acl.PostData{}, "", nil
+	}
+
+	cursorVO, err := toCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	announcementType, err := valueobjects.NewPostType(valueobjects.AnnouncementPostType)
+	if err != nil {
+		return nil, "", err
+	}
+
+	posts, err := f.postRepo.FindByCommunitiesAndType(ctx, communityIDVOs, announcementType, pageLimit(limit), offset, cursorVO)
+	if err != nil {
+		return nil, "", err
+	}
+
+	posts, next := nextPage(posts, limit)
+	return toPostData(posts), next, nil
+}
+
+// SearchPosts runs a full-text search on published post content, most relevant first.
+func (f *postsFacadeImpl) SearchPosts(ctx context.Context, text string, communityIDs []string, limit, offset int) ([]*acl.PostData, error) {
+	posts, err := f.postRepo.Search(ctx, text, toCommunityIDs(communityIDs), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPostData(posts), nil
+}
+
+// AnonymizeAuthor moves the posts, revisions and poll votes of a removed user to an anonymous user ID.
+func (f *postsFacadeImpl) AnonymizeAuthor(ctx context.Context, authorID, anonymousID string) error {
+	authorIDVO, err := valueobjects.NewAuthorID(authorID)
+	if err != nil {
+		return err
+	}
+	anonymousIDVO, err := valueobjects.NewAuthorID(anonymousID)
+	if err != nil {
+		return err
+	}
+
+	return f.commandService.HandleAnonymizeAuthor(ctx, authorIDVO, anonymousIDVO)
+}
+
+// DeletePostsByAuthor deletes the posts of a removed user.
+func (f *postsFacadeImpl) DeletePostsByAuthor(ctx context.Context, authorID string) error {
+	authorIDVO, err := valueobjects.NewAuthorID(authorID)
+	if err != nil {
+		return err
+	}
+
+	return f.commandService.HandleDeleteByAuthor(ctx, authorIDVO)
+}
+
+// toCommunityIDs converts string IDs to value objects, skipping invalid ones.
+func toCommunityIDs(communityIDs []string) []valueobjects.CommunityID {
 	communityIDVOs := make([]valueobjects.CommunityID, 0, len(communityIDs))
 	for _, id := range communityIDs {
 		communityIDVO, err := valueobjects.NewCommunityID(id)
@@ -56,18 +160,38 @@ func (f *postsFacadeImpl) GetAnnouncementsByCommunities(ctx context.Context, com
 		}
 		communityIDVOs = append(communityIDVOs, communityIDVO)
 	}
+	return communityIDVOs
+}
 
-	if len(communityIDVOs) == 0 {
-		return []*acl.PostData{}, nil
+// toCursor parses an opaque cursor token, the empty token being the first page.
+func toCursor(token string) (valueobjects.Cursor, error) {
+	if token == "" {
+		return valueobjects.Cursor{}, nil
 	}
+	return valueobjects.ParseCursor(token)
+}
 
-	// Get all posts from repository (no type filtering)
-	posts, err := f.postRepo.FindByCommunities(ctx, communityIDVOs, limit, offset)
-	if err != nil {
-		return nil, err
+// pageLimit asks for one extra post so nextPage can tell whether another page exists.
+func pageLimit(limit *int) *int {
+	if limit == nil {
+		return nil
+	}
+	extra := *limit + 1
+	return &extra
+}
+
+// nextPage drops the extra post fetched through pageLimit and returns the encoded cursor for the next page.
+func nextPage(posts []*entities.Post, limit *int) ([]*entities.Post, string) {
+	if limit == nil || len(posts) <= *limit {
+		return posts, ""
 	}
 
-	// Transform to PostData
+	posts = posts[:*limit]
+	last := posts[len(posts)-1]
+	return posts, valueobjects.NewCursor(last.CreatedAt(), last.PostID()).Encode()
+}
+
+func toPostData(posts []*entities.Post) []*acl.PostData {
 	result := make([]*acl.PostData, len(posts))
 	for i, post := range posts {
 		result[i] = &acl.PostData{
@@ -75,11 +199,10 @@ func (f *postsFacadeImpl) GetAnnouncementsByCommunities(ctx context.Context, com
 			CommunityID: post.CommunityID().Value(),
 			AuthorID:    post.AuthorID().Value(),
 			Content:     post.Content().Value(),
-			MessageType: "message", // All posts are messages now
+			MessageType: post.PostType().Value(),
 			CreatedAt:   post.CreatedAt(),
 			UpdatedAt:   post.UpdatedAt(),
 		}
 	}
-
-	return result, nil
+	return result
 }
@@ -4,39 +4,74 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
+	"slices"
+	"time"
 
 	"Gommunity/platform/posts/application/outboundservices/acl"
 	"Gommunity/platform/posts/domain/model/commands"
 	"Gommunity/platform/posts/domain/model/entities"
+	"Gommunity/platform/posts/domain/model/events"
 	"Gommunity/platform/posts/domain/model/valueobjects"
 	"Gommunity/platform/posts/domain/repositories"
 	"Gommunity/platform/posts/domain/services"
 )
 
+// maxMentionsPerPost bounds how many @username mentions are resolved for a single post.
+const maxMentionsPerPost = 20
+
+// pinAttempts bounds how often pinning is retried when concurrent pins take the same position.
+const pinAttempts = 3
+
+// publishDueBatchSize bounds how many scheduled posts are loaded at once when publishing due posts.
+const publishDueBatchSize = 100
+
 type postCommandServiceImpl struct {
 	postRepository               repositories.PostRepository
+	postRevisionRepository       repositories.PostRevisionRepository
+	pollVoteRepository           repositories.PollVoteRepository
+	outboxRepository             repositories.OutboxRepository
+	unitOfWork                   repositories.UnitOfWork
 	externalUsersService         *acl.ExternalUsersService
 	externalCommunitiesService   *acl.ExternalCommunitiesService
 	externalSubscriptionsService *acl.ExternalSubscriptionsService
+	externalCommentsService      *acl.ExternalCommentsService
+	externalReactionsService     *acl.ExternalReactionsService
+	maxPinnedPosts               int
 }
 
 // NewPostCommandService constructs the posts command service implementation.
 func NewPostCommandService(
 	postRepository repositories.PostRepository,
+	postRevisionRepository repositories.PostRevisionRepository,
+	pollVoteRepository repositories.PollVoteRepository,
+	outboxRepository repositories.OutboxRepository,
+	unitOfWork repositories.UnitOfWork,
 	externalUsersService *acl.ExternalUsersService,
 	externalCommunitiesService *acl.ExternalCommunitiesService,
 	externalSubscriptionsService *acl.ExternalSubscriptionsService,
+	externalCommentsService *acl.ExternalCommentsService,
+	externalReactionsService *acl.ExternalReactionsService,
+	maxPinnedPosts int,
 ) services.PostCommandService {
 	return &postCommandServiceImpl{
 		postRepository:               postRepository,
+		postRevisionRepository:       postRevisionRepository,
+		pollVoteRepository:           pollVoteRepository,
+		outboxRepository:             outboxRepository,
+		unitOfWork:                   unitOfWork,
 		externalUsersService:         externalUsersService,
 		externalCommunitiesService:   externalCommunitiesService,
 		externalSubscriptionsService: externalSubscriptionsService,
+		externalCommentsService:      externalCommentsService,
+		externalReactionsService:     externalReactionsService,
+		maxPinnedPosts:               maxPinnedPosts,
 	}
 }
 
-// HandlePublish publishes a new post.
-// Only community owners and admins can publish posts.
+// HandlePublish publishes a new post, or keeps it as a draft or scheduled post when requested.
+// Only community owners and admins, or members whose custom role grants the publish_post permission, can publish posts.
+// Announcements also need the publish_announcement permission.
 func (s *postCommandServiceImpl) HandlePublish(ctx context.Context, cmd commands.CreatePostCommand) (*valueobjects.PostID, error) {
 	// Validate community exists
 	exists, err := s.externalCommunitiesService.ValidateCommunityExists(ctx, cmd.CommunityID())
@@ -56,74 +91,98 @@ func (s *postCommandServiceImpl) HandlePublish(ctx context.Context, cmd commands
 		return nil, errors.New("author not found")
 	}
 
-	// Check user's role in the community
-	role, err := s.externalSubscriptionsService.GetUserRole(ctx, cmd.AuthorID(), cmd.CommunityID())
-	if err != nil {
-		return nil, fmt.Errorf("failed to verify membership: %w", err)
-	}
-
-	// If no subscription found, check if user is the community owner
-	if role == nil {
-		isOwner, err := s.externalCommunitiesService.ValidateUserIsOwner(ctx, cmd.CommunityID(), cmd.AuthorID())
-		if err != nil {
-			return nil, fmt.Errorf("failed to verify ownership: %w", err)
-		}
-		if !isOwner {
-			return nil, errors.New("only community owners and admins can publish posts")
-		}
-
-		ownerRole, roleErr := valueobjects.NewCommunityRole(valueobjects.CommunityRoleOwner)
-		if roleErr != nil {
-			return nil, roleErr
-		}
-		role = &ownerRole
-	}
-
-	// Only owners and admins can publish. If the stored role is not admin/owner,
-	// double-check ownership to tolerate cases where the owner got re-subscribed as member.
-	if !role.IsAdminOrOwner() {
-		isOwner, err := s.externalCommunitiesService.ValidateUserIsOwner(ctx, cmd.CommunityID(), cmd.AuthorID())
-		if err != nil {
-			return nil, fmt.Errorf("failed to verify ownership: %w", err)
-		}
-
-		if !isOwner {
-			// Some deployments store the community owner using profileID instead of userID.
-			// Fetch profileID and retry ownership check to avoid false negatives.
-			if profileID, profileErr := s.externalUsersService.GetProfileIDByUserID(ctx, cmd.AuthorID()); profileErr == nil {
-				if profileAuthorID, voErr := valueobjects.NewAuthorID(profileID); voErr == nil {
-					isOwner, err = s.externalCommunitiesService.ValidateUserIsOwner(ctx, cmd.CommunityID(), profileAuthorID)
-					if err != nil {
-						return nil, fmt.Errorf("failed to verify ownership: %w", err)
-					}
-				}
-			}
-		}
-
-		if !isOwner {
-			return nil, errors.New("only community owners and admins can publish posts")
-		}
+	// Check the author's permissions in the community
+	if err := s.ensureCanPublish(ctx, cmd.AuthorID(), cmd.CommunityID(), cmd.PostType()); err != nil {
+		return nil, err
 	}
 
 	// Create and save post
 	post, err := entities.NewPost(
 		cmd.CommunityID(),
 		cmd.AuthorID(),
+		cmd.PostType(),
 		cmd.Content(),
 		cmd.Images(),
+		cmd.Status(),
+		cmd.PublishAt(),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := s.postRepository.Save(ctx, post); err != nil {
-		return nil, fmt.Errorf("failed to persist post: %w", err)
+	if !cmd.Poll().IsZero() {
+		if err := post.AttachPoll(cmd.Poll()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.resolveMentions(ctx, post); err != nil {
+		return nil, err
+	}
+
+	// A post published right away is announced together with its creation
+	err = s.unitOfWork.Execute(ctx, func(txCtx context.Context) error {
+		if err := s.postRepository.Save(txCtx, post); err != nil {
+			return fmt.Errorf("failed to persist post: %w", err)
+		}
+		if post.IsPublished() {
+			return s.recordPublished(txCtx, post)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	postID := post.PostID()
 	return &postID, nil
 }
 
+// HandleUpdate edits the content and images of a post.
+// The author or a member allowed to delete any post can edit; the previous version is kept as a revision.
+// Published posts can only be edited by members who could publish them.
+func (s *postCommandServiceImpl) HandleUpdate(ctx context.Context, cmd commands.UpdatePostCommand) error {
+	post, err := s.postRepository.FindByID(ctx, cmd.PostID())
+	if err != nil {
+		return fmt.Errorf("failed to retrieve post: %w", err)
+	}
+	if post == nil {
+		return errors.New("post not found")
+	}
+
+	if err := s.ensureCanEdit(ctx, post, cmd.RequestedBy()); err != nil {
+		return err
+	}
+
+	return s.applyEdit(ctx, post, cmd.RequestedBy(), cmd.Content(), cmd.Images())
+}
+
+// HandleRestoreRevision rolls a post back to one of its previous revisions.
+// The current version is kept as a revision so the restore can be undone.
+func (s *postCommandServiceImpl) HandleRestoreRevision(ctx context.Context, cmd commands.RestorePostRevisionCommand) error {
+	post, err := s.postRepository.FindByID(ctx, cmd.PostID())
+	if err != nil {
+		return fmt.Errorf("failed to retrieve post: %w", err)
+	}
+	if post == nil {
+		return errors.New("post not found")
+	}
+
+	revision, err := s.postRevisionRepository.FindByID(ctx, cmd.RevisionID())
+	if err != nil {
+		return fmt.Errorf("failed to retrieve revision: %w", err)
+	}
+	if revision == nil || !revision.PostID().Equals(post.PostID()) {
+		return errors.New("revision not found")
+	}
+
+	if err := s.ensureCanEdit(ctx, post, cmd.RequestedBy()); err != nil {
+		return err
+	}
+
+	return s.applyEdit(ctx, post, cmd.RequestedBy(), revision.Content(), revision.Images())
+}
+
 // HandleDelete removes an existing post if the requester has privileges.
 func (s *postCommandServiceImpl) HandleDelete(ctx context.Context, cmd commands.DeletePostCommand) error {
 	post, err := s.postRepository.FindByID(ctx, cmd.PostID())
@@ -134,23 +193,511 @@ func (s *postCommandServiceImpl) HandleDelete(ctx context.Context, cmd commands.
 		return errors.New("post not found")
 	}
 
-	role, err := s.externalSubscriptionsService.GetUserRole(ctx, cmd.RequestedBy(), post.CommunityID())
+	canDelete, err := s.hasPermission(ctx, cmd.RequestedBy(), post.CommunityID(), acl.DeleteAnyPostPermission)
 	if err != nil {
-		return fmt.Errorf("failed to verify requester role: %w", err)
+		return err
+	}
+	if !canDelete {
+		return errors.New("only community members allowed to delete any post can delete posts")
+	}
+
+	return s.deletePost(ctx, post, cmd.RequestedBy())
+}
+
+// HandlePin pins a post at the end of its community's pinned posts.
+// Only members holding the pin_post permission can pin posts.
+func (s *postCommandServiceImpl) HandlePin(ctx context.Context, cmd commands.PinPostCommand) error {
+	post, err := s.postRepository.FindByID(ctx, cmd.PostID())
+	if err != nil {
+		return fmt.Errorf("failed to retrieve post: %w", err)
+	}
+	if post == nil {
+		return errors.New("post not found")
 	}
 
-	if role == nil || !role.IsAdminOrOwner() {
-		isOwner, ownerErr := s.externalCommunitiesService.ValidateUserIsOwner(ctx, post.CommunityID(), cmd.RequestedBy())
-		if ownerErr != nil {
-			return fmt.Errorf("failed to verify ownership: %w", ownerErr)
+	canPin, err := s.hasPermission(ctx, cmd.RequestedBy(), post.CommunityID(), acl.PinPostPermission)
+	if err != nil {
+		return err
+	}
+	if !canPin {
+		return errors.New("only community members allowed to pin posts can pin posts")
+	}
+
+	if !post.IsPublished() {
+		return errors.New("unpublished posts cannot be pinned")
+	}
+	if post.IsPinned() {
+		return errors.New("post is already pinned")
+	}
+
+	// Pins take the next free position. A concurrent pin taking the same position is rejected by the
+	// repository, and counting again afterwards enforces the limit on the pins that made it.
+	for attempt := 0; attempt < pinAttempts; attempt++ {
+		pinned, err := s.postRepository.FindPinnedByCommunity(ctx, post.CommunityID())
+		if err != nil {
+			return fmt.Errorf("failed to retrieve pinned posts: %w", err)
+		}
+		if len(pinned) >= s.maxPinnedPosts {
+			return fmt.Errorf("a community can have at most %d pinned posts", s.maxPinnedPosts)
+		}
+
+		if err := post.Pin(len(pinned) + 1); err != nil {
+			return err
+		}
+
+		err = s.postRepository.Pin(ctx, post.PostID(), post.PinOrder())
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, repositories.ErrPinOrderTaken) {
+			return err
+		}
+		if err := post.Unpin(); err != nil {
+			return err
+		}
+	}
+
+	return errors.New("the pinned posts changed while pinning, please try again")
+}
+
+// HandleUnpin removes a post from its community's pinned posts.
+// Only members holding the pin_post permission can unpin posts.
+func (s *postCommandServiceImpl) HandleUnpin(ctx context.Context, cmd commands.UnpinPostCommand) error {
+	post, err := s.postRepository.FindByID(ctx, cmd.PostID())
+	if err != nil {
+		return fmt.Errorf("failed to retrieve post: %w", err)
+	}
+	if post == nil {
+		return errors.New("post not found")
+	}
+
+	canPin, err := s.hasPermission(ctx, cmd.RequestedBy(), post.CommunityID(), acl.PinPostPermission)
+	if err != nil {
+		return err
+	}
+	if !canPin {
+		return errors.New("only community members allowed to pin posts can unpin posts")
+	}
+
+	removedOrder := post.PinOrder()
+	if err := post.Unpin(); err != nil {
+		return err
+	}
+
+	if err := s.postRepository.Unpin(ctx, post.PostID()); err != nil {
+		return err
+	}
+
+	if err := s.postRepository.CompactPinOrder(ctx, post.CommunityID(), removedOrder); err != nil {
+		return fmt.Errorf("failed to reorder pinned posts: %w", err)
+	}
+
+	return nil
+}
+
+// HandleChangeStatus moves an unpublished post back to draft, schedules it or publishes it right away.
+// The author or a member allowed to delete any post can change the status; published posts cannot be unpublished.
+// Scheduling or publishing also needs the author to be allowed to publish the post, as HandlePublish does.
+func (s *postCommandServiceImpl) HandleChangeStatus(ctx context.Context, cmd commands.ChangePostStatusCommand) error {
+	post, err := s.postRepository.FindByID(ctx, cmd.PostID())
+	if err != nil {
+		return fmt.Errorf("failed to retrieve post: %w", err)
+	}
+	if post == nil {
+		return errors.New("post not found")
+	}
+
+	if err := s.ensureCanEdit(ctx, post, cmd.RequestedBy()); err != nil {
+		return err
+	}
+
+	// The post goes live under the author's name, so the author has to be allowed to publish it
+	if !cmd.Status().IsDraft() {
+		if err := s.ensureCanPublish(ctx, post.AuthorID(), post.CommunityID(), post.PostType()); err != nil {
+			return err
 		}
-		if !isOwner {
-			return errors.New("only community admins or owners can delete posts")
+	}
+
+	fromStatus, fromPublishAt := post.Status(), post.PublishAt()
+	switch {
+	case cmd.Status().IsDraft():
+		err = post.MoveToDraft()
+	case cmd.Status().IsScheduled():
+		err = post.Schedule(cmd.PublishAt())
+	default:
+		err = post.Publish()
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.unitOfWork.Execute(ctx, func(txCtx context.Context) error {
+		updated, err := s.postRepository.UpdateStatus(txCtx, post, fromStatus, fromPublishAt)
+		if err != nil {
+			return fmt.Errorf("failed to update post status: %w", err)
+		}
+		if !updated {
+			return errors.New("post status has already changed, please try again")
+		}
+		// Published posts cannot change status, so reaching here published means it was just published
+		if post.IsPublished() {
+			return s.recordPublished(txCtx, post)
 		}
+		return nil
+	})
+}
+
+// HandleVote records a user's vote in the poll of a published post.
+// Only community members can vote, and each user votes once per poll.
+func (s *postCommandServiceImpl) HandleVote(ctx context.Context, cmd commands.VoteOnPollCommand) error {
+	post, err := s.postRepository.FindByID(ctx, cmd.PostID())
+	if err != nil {
+		return fmt.Errorf("failed to retrieve post: %w", err)
+	}
+	if post == nil || !post.IsPublished() {
+		return errors.New("post not found")
+	}
+	if !post.HasPoll() {
+		return errors.New("poll not found")
 	}
 
-	if err := s.postRepository.Delete(ctx, cmd.PostID()); err != nil {
-		return fmt.Errorf("failed to delete post: %w", err)
+	isMember, err := s.externalSubscriptionsService.IsUserSubscribed(ctx, cmd.VoterID(), post.CommunityID())
+	if err != nil {
+		return fmt.Errorf("failed to verify membership: %w", err)
+	}
+	if !isMember {
+		return errors.New("only community members can vote in polls")
+	}
+
+	existing, err := s.pollVoteRepository.FindByPostAndVoter(ctx, cmd.PostID(), cmd.VoterID())
+	if err != nil {
+		return fmt.Errorf("failed to check existing vote: %w", err)
+	}
+	if existing != nil {
+		return errors.New("user has already voted in this poll")
+	}
+
+	vote, err := entities.NewPollVote(post, cmd.VoterID(), cmd.OptionIDs(), time.Now())
+	if err != nil {
+		return err
+	}
+
+	// The unique (post_id, voter_id) index rejects a concurrent second vote
+	if err := s.pollVoteRepository.Save(ctx, vote); err != nil {
+		return fmt.Errorf("failed to persist vote: %w", err)
+	}
+
+	return nil
+}
+
+// HandlePublishDue publishes every scheduled post whose publish-at time has been reached.
+// Posts whose author has since been muted or lost the right to publish them are moved back to draft.
+// A post that fails to publish is logged and retried on the next run.
+func (s *postCommandServiceImpl) HandlePublishDue(ctx context.Context) (int, error) {
+	published := 0
+	for {
+		due, err := s.postRepository.FindDueScheduled(ctx, time.Now(), publishDueBatchSize)
+		if err != nil {
+			return published, fmt.Errorf("failed to retrieve scheduled posts: %w", err)
+		}
+
+		batchPublished := 0
+		for _, post := range due {
+			fromPublishAt := post.PublishAt()
+
+			// The author may have been muted or lost the right to publish since scheduling the post
+			refusal, err := s.publishRefusal(ctx, post.AuthorID(), post.CommunityID(), post.PostType())
+			if err != nil {
+				log.Printf("failed to publish scheduled post %s: %v", post.PostID().Value(), err)
+				continue
+			}
+			if refusal != "" {
+				s.unschedule(ctx, post, fromPublishAt, refusal)
+				continue
+			}
+
+			if err := post.Publish(); err != nil {
+				log.Printf("failed to publish scheduled post %s: %v", post.PostID().Value(), err)
+				continue
+			}
+			// The post may have been published, moved to draft or rescheduled since it was read,
+			// possibly by another instance; it is then left alone and no event is recorded.
+			updated := false
+			err = s.unitOfWork.Execute(ctx, func(txCtx context.Context) error {
+				var err error
+				updated, err = s.postRepository.UpdateStatus(txCtx, post, valueobjects.ScheduledStatus(), fromPublishAt)
+				if err != nil || !updated {
+					return err
+				}
+				return s.recordPublished(txCtx, post)
+			})
+			if err != nil {
+				log.Printf("failed to publish scheduled post %s: %v", post.PostID().Value(), err)
+				continue
+			}
+			if !updated {
+				log.Printf("skipped scheduled post %s: it changed before it could be published", post.PostID().Value())
+				continue
+			}
+			batchPublished++
+		}
+		published += batchPublished
+
+		// Stop when the batch was the last one, or when nothing in it could be published
+		// so the same failing posts are not fetched over and over.
+		if len(due) < publishDueBatchSize || batchPublished == 0 {
+			return published, nil
+		}
+	}
+}
+
+// unschedule moves a scheduled post whose author may no longer publish it back to draft,
+// so the author can see it was not published and the scheduler stops picking it up.
+func (s *postCommandServiceImpl) unschedule(ctx context.Context, post *entities.Post, fromPublishAt time.Time, refusal string) {
+	if err := post.MoveToDraft(); err != nil {
+		log.Printf("failed to move scheduled post %s back to draft: %v", post.PostID().Value(), err)
+		return
+	}
+	updated, err := s.postRepository.UpdateStatus(ctx, post, valueobjects.ScheduledStatus(), fromPublishAt)
+	if err != nil {
+		log.Printf("failed to move scheduled post %s back to draft: %v", post.PostID().Value(), err)
+		return
+	}
+	if updated {
+		log.Printf("moved scheduled post %s back to draft: %s", post.PostID().Value(), refusal)
+	}
+}
+
+// recordPublished adds a PostPublished event to the outbox so other services learn about the post.
+func (s *postCommandServiceImpl) recordPublished(ctx context.Context, post *entities.Post) error {
+	event := events.NewPostPublishedEvent(post.PostID(), post.CommunityID(), post.AuthorID(), post.PostType())
+	return s.outboxRepository.SavePostPublished(ctx, event)
+}
+
+// ensureCanEdit verifies that the requester is the post author or holds the delete_any_post permission.
+func (s *postCommandServiceImpl) ensureCanEdit(ctx context.Context, post *entities.Post, requester valueobjects.AuthorID) error {
+	if post.AuthorID().Equals(requester) {
+		return nil
+	}
+
+	canEdit, err := s.hasPermission(ctx, requester, post.CommunityID(), acl.DeleteAnyPostPermission)
+	if err != nil {
+		return err
+	}
+	if !canEdit {
+		return errors.New("only the author or community members allowed to delete any post can edit posts")
+	}
+	return nil
+}
+
+// ensureCanPublish verifies that the author may publish a post of the given type in the community.
+func (s *postCommandServiceImpl) ensureCanPublish(ctx context.Context, authorID valueobjects.AuthorID, communityID valueobjects.CommunityID, postType valueobjects.PostType) error {
+	refusal, err := s.publishRefusal(ctx, authorID, communityID, postType)
+	if err != nil {
+		return err
+	}
+	if refusal != "" {
+		return errors.New(refusal)
+	}
+	return nil
+}
+
+// publishRefusal returns why the author may not publish a post of the given type in the community,
+// or an empty string when publishing is allowed. Nothing is published in an archived community; the author
+// needs the publish_post permission and must not be muted, and announcements also need the publish_announcement permission.
+func (s *postCommandServiceImpl) publishRefusal(ctx context.Context, authorID valueobjects.AuthorID, communityID valueobjects.CommunityID, postType valueobjects.PostType) (string, error) {
+	archived, err := s.externalCommunitiesService.IsCommunityArchived(ctx, communityID)
+	if err != nil {
+		return "", fmt.Errorf("failed to check community status: %w", err)
+	}
+	if archived {
+		return "community is archived", nil
+	}
+
+	canPublish, err := s.hasPermission(ctx, authorID, communityID, acl.PublishPostPermission)
+	if err != nil {
+		return "", err
+	}
+	if !canPublish {
+		return "only community members allowed to publish can publish posts", nil
+	}
+
+	muted, err := s.externalSubscriptionsService.IsUserMuted(ctx, authorID, communityID)
+	if err != nil {
+		return "", fmt.Errorf("failed to check mute status: %w", err)
+	}
+	if muted {
+		return "muted members cannot publish posts", nil
+	}
+
+	if postType.IsAnnouncement() {
+		canAnnounce, err := s.hasPermission(ctx, authorID, communityID, acl.PublishAnnouncementPermission)
+		if err != nil {
+			return "", err
+		}
+		if !canAnnounce {
+			return "only community members allowed to announce can publish announcements", nil
+		}
+	}
+	return "", nil
+}
+
+// hasPermission checks whether the user holds a community permission.
+func (s *postCommandServiceImpl) hasPermission(ctx context.Context, userID valueobjects.AuthorID, communityID valueobjects.CommunityID, permission string) (bool, error) {
+	allowed, err := s.externalSubscriptionsService.HasPermission(ctx, userID, communityID, permission)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify requester permissions: %w", err)
+	}
+	return allowed, nil
+}
+
+// resolveMentions looks up the users mentioned in the post content and keeps the community members among them.
+// Unknown usernames, non-members and the author mentioning themselves are ignored.
+func (s *postCommandServiceImpl) resolveMentions(ctx context.Context, post *entities.Post) error {
+	usernames := post.Content().MentionedUsernames()
+	if len(usernames) > maxMentionsPerPost {
+		usernames = usernames[:maxMentionsPerPost]
+	}
+
+	mentions := make([]valueobjects.AuthorID, 0, len(usernames))
+	for _, username := range usernames {
+		userID, err := s.externalUsersService.FindUserIDByUsername(ctx, username)
+		if err != nil {
+			// Unknown usernames are plain text, not mentions
+			continue
+		}
+		if userID.Equals(post.AuthorID()) || slices.ContainsFunc(mentions, userID.Equals) {
+			continue
+		}
+
+		isMember, err := s.externalSubscriptionsService.IsUserSubscribed(ctx, userID, post.CommunityID())
+		if err != nil {
+			return fmt.Errorf("failed to verify mentioned user membership: %w", err)
+		}
+		if isMember {
+			mentions = append(mentions, userID)
+		}
+	}
+
+	post.SetMentions(mentions)
+	return nil
+}
+
+// applyEdit snapshots the current version of the post and then persists the new one.
+// Published posts can only be edited by members who could publish them.
+func (s *postCommandServiceImpl) applyEdit(
+	ctx context.Context,
+	post *entities.Post,
+	editor valueobjects.AuthorID,
+	content valueobjects.PostContent,
+	images valueobjects.PostImages,
+) error {
+	// Rewriting a published post changes what the community reads, so it takes the same rights as publishing it
+	if post.IsPublished() {
+		if err := s.ensureCanPublish(ctx, editor, post.CommunityID(), post.PostType()); err != nil {
+			return err
+		}
+	}
+
+	revision, err := entities.NewPostRevision(post, editor)
+	if err != nil {
+		return err
+	}
+
+	if err := post.Edit(content, images); err != nil {
+		return err
+	}
+
+	if err := s.resolveMentions(ctx, post); err != nil {
+		return err
+	}
+
+	// The revision only makes sense together with the edit it records
+	return s.unitOfWork.Execute(ctx, func(txCtx context.Context) error {
+		if err := s.postRevisionRepository.Save(txCtx, revision); err != nil {
+			return fmt.Errorf("failed to persist revision: %w", err)
+		}
+		if err := s.postRepository.Update(txCtx, post); err != nil {
+			return fmt.Errorf("failed to update post: %w", err)
+		}
+		return nil
+	})
+}
+
+// HandleAnonymizeAuthor moves the posts, revisions and poll votes of a removed user to an anonymous author ID.
+// The content stays in place so that threads and poll results keep making sense.
+func (s *postCommandServiceImpl) HandleAnonymizeAuthor(ctx context.Context, authorID, anonymousID valueobjects.AuthorID) error {
+	if err := s.postRepository.ReassignAuthor(ctx, authorID, anonymousID); err != nil {
+		return fmt.Errorf("failed to anonymize posts: %w", err)
+	}
+	if err := s.postRevisionRepository.ReassignEditor(ctx, authorID, anonymousID); err != nil {
+		return fmt.Errorf("failed to anonymize post revisions: %w", err)
+	}
+	if err := s.pollVoteRepository.ReassignVoter(ctx, authorID, anonymousID); err != nil {
+		return fmt.Errorf("failed to anonymize poll votes: %w", err)
+	}
+	return nil
+}
+
+// HandleDeleteByAuthor deletes every post of a removed user the same way a single post is deleted,
+// then removes the user's votes in the remaining polls.
+func (s *postCommandServiceImpl) HandleDeleteByAuthor(ctx context.Context, authorID valueobjects.AuthorID) error {
+	posts, err := s.postRepository.FindByAuthor(ctx, authorID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve posts by author: %w", err)
+	}
+
+	for _, post := range posts {
+		if err := s.deletePost(ctx, post, authorID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.pollVoteRepository.DeleteByVoter(ctx, authorID); err != nil {
+		return fmt.Errorf("failed to delete poll votes: %w", err)
+	}
+
+	log.Printf("Deleted %d posts by author %s", len(posts), authorID.Value())
+	return nil
+}
+
+// deletePost removes a post together with its revisions, comments, reactions and poll votes,
+// recording the deletion in the outbox.
+func (s *postCommandServiceImpl) deletePost(ctx context.Context, post *entities.Post, deletedBy valueobjects.AuthorID) error {
+	err := s.unitOfWork.Execute(ctx, func(txCtx context.Context) error {
+		if err := s.postRepository.Delete(txCtx, post.PostID()); err != nil {
+			return fmt.Errorf("failed to delete post: %w", err)
+		}
+		event := events.NewPostDeletedEvent(post.PostID(), post.CommunityID(), deletedBy)
+		return s.outboxRepository.SavePostDeleted(txCtx, event)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.postRevisionRepository.DeleteByPostID(ctx, post.PostID()); err != nil {
+		return fmt.Errorf("failed to delete post revisions: %w", err)
+	}
+
+	if err := s.externalCommentsService.DeleteCommentsByPost(ctx, post.PostID()); err != nil {
+		return fmt.Errorf("failed to delete post comments: %w", err)
+	}
+
+	if err := s.externalReactionsService.DeleteReactionsByPost(ctx, post.PostID()); err != nil {
+		return fmt.Errorf("failed to delete post reactions: %w", err)
+	}
+
+	if post.HasPoll() {
+		if err := s.pollVoteRepository.DeleteByPostID(ctx, post.PostID()); err != nil {
+			return fmt.Errorf("failed to delete poll votes: %w", err)
+		}
+	}
+
+	// Deleting a pinned post unpins it, so close the gap in the pin order
+	if post.IsPinned() {
+		if err := s.postRepository.CompactPinOrder(ctx, post.CommunityID(), post.PinOrder()); err != nil {
+			return fmt.Errorf("failed to reorder pinned posts: %w", err)
+		}
 	}
 
 	return nil
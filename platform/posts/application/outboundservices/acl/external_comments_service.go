@@ -0,0 +1,25 @@
+package acl
+
+import (
+	"context"
+
+	comments_acl "Gommunity/platform/comments/interfaces/acl"
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// ExternalCommentsService provides access to the Comments bounded context.
+type ExternalCommentsService struct {
+	commentsFacade comments_acl.CommentsFacade
+}
+
+// NewExternalCommentsService builds a new ExternalCommentsService.
+func NewExternalCommentsService(commentsFacade comments_acl.CommentsFacade) *ExternalCommentsService {
+	return &ExternalCommentsService{
+		commentsFacade: commentsFacade,
+	}
+}
+
+// DeleteCommentsByPost removes every comment on the post.
+func (s *ExternalCommentsService) DeleteCommentsByPost(ctx context.Context, postID valueobjects.PostID) error {
+	return s.commentsFacade.DeleteCommentsByPostIDs(ctx, []string{postID.Value()})
+}
@@ -24,6 +24,11 @@ func (s *ExternalCommunitiesService) ValidateCommunityExists(ctx context.Context
 	return s.communitiesFacade.ValidateCommunityExists(ctx, communityID.Value())
 }
 
+// IsCommunityArchived checks whether the community was archived after its owner was removed.
+func (s *ExternalCommunitiesService) IsCommunityArchived(ctx context.Context, communityID valueobjects.CommunityID) (bool, error) {
+	return s.communitiesFacade.IsCommunityArchived(ctx, communityID.Value())
+}
+
 // ValidateUserIsOwner verifies whether the provided author owns the community.
 func (s *ExternalCommunitiesService) ValidateUserIsOwner(ctx context.Context, communityID valueobjects.CommunityID, authorID valueobjects.AuthorID) (bool, error) {
 	return s.communitiesFacade.ValidateUserIsOwner(ctx, communityID.Value(), authorID.Value())
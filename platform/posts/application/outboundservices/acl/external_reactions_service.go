@@ -0,0 +1,25 @@
+package acl
+
+import (
+	"context"
+
+	"Gommunity/platform/posts/domain/model/valueobjects"
+	reactions_acl "Gommunity/platform/reactions/interfaces/acl"
+)
+
+// ExternalReactionsService provides access to the Reactions bounded context.
+type ExternalReactionsService struct {
+	reactionsFacade reactions_acl.ReactionsFacade
+}
+
+// NewExternalReactionsService builds a new ExternalReactionsService.
+func NewExternalReactionsService(reactionsFacade reactions_acl.ReactionsFacade) *ExternalReactionsService {
+	return &ExternalReactionsService{
+		reactionsFacade: reactionsFacade,
+	}
+}
+
+// DeleteReactionsByPost removes every reaction on the post.
+func (s *ExternalReactionsService) DeleteReactionsByPost(ctx context.Context, postID valueobjects.PostID) error {
+	return s.reactionsFacade.DeleteReactionsByPostIDs(ctx, []string{postID.Value()})
+}
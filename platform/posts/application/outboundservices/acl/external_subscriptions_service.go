@@ -7,6 +7,14 @@ import (
 	subscriptions_acl "Gommunity/platform/subscriptions/interfaces/acl"
 )
 
+// Community permissions checked by the Posts BC
+const (
+	PublishPostPermission         = subscriptions_acl.PublishPostPermission
+	PublishAnnouncementPermission = subscriptions_acl.PublishAnnouncementPermission
+	DeleteAnyPostPermission       = subscriptions_acl.DeleteAnyPostPermission
+	PinPostPermission             = subscriptions_acl.PinPostPermission
+)
+
 // ExternalSubscriptionsService provides read access to subscription data.
 type ExternalSubscriptionsService struct {
 	subscriptionsFacade subscriptions_acl.SubscriptionsFacade
@@ -19,26 +27,17 @@ func NewExternalSubscriptionsService(subscriptionsFacade subscriptions_acl.Subsc
 	}
 }
 
-// GetUserRole retrieves the user's community role (member/admin/owner).
-func (s *ExternalSubscriptionsService) GetUserRole(ctx context.Context, userID valueobjects.AuthorID, communityID valueobjects.CommunityID) (*valueobjects.CommunityRole, error) {
-	roleValue, err := s.subscriptionsFacade.GetUserRoleInCommunity(ctx, userID.Value(), communityID.Value())
-	if err != nil {
-		return nil, err
-	}
-
-	if roleValue == "" {
-		return nil, nil
-	}
-
-	role, err := valueobjects.NewCommunityRole(roleValue)
-	if err != nil {
-		return nil, err
-	}
-
-	return &role, nil
+// HasPermission checks whether the user holds a community permission.
+func (s *ExternalSubscriptionsService) HasPermission(ctx context.Context, userID valueobjects.AuthorID, communityID valueobjects.CommunityID, permission string) (bool, error) {
+	return s.subscriptionsFacade.HasPermission(ctx, userID.Value(), communityID.Value(), permission)
 }
 
 // IsUserSubscribed checks whether the user belongs to the community.
 func (s *ExternalSubscriptionsService) IsUserSubscribed(ctx context.Context, userID valueobjects.AuthorID, communityID valueobjects.CommunityID) (bool, error) {
 	return s.subscriptionsFacade.IsUserSubscribed(ctx, userID.Value(), communityID.Value())
 }
+
+// IsUserMuted checks whether the user is currently muted in the community.
+func (s *ExternalSubscriptionsService) IsUserMuted(ctx context.Context, userID valueobjects.AuthorID, communityID valueobjects.CommunityID) (bool, error) {
+	return s.subscriptionsFacade.IsUserMuted(ctx, userID.Value(), communityID.Value())
+}
@@ -28,3 +28,12 @@ func (s *ExternalUsersService) ValidateUserExists(ctx context.Context, userID va
 func (s *ExternalUsersService) GetProfileIDByUserID(ctx context.Context, userID valueobjects.AuthorID) (string, error) {
 	return s.usersFacade.GetProfileIDByUserID(ctx, userID.Value())
 }
+
+// FindUserIDByUsername resolves a username to the user's identifier.
+func (s *ExternalUsersService) FindUserIDByUsername(ctx context.Context, username string) (valueobjects.AuthorID, error) {
+	userID, err := s.usersFacade.FindUserIDByUsername(ctx, username)
+	if err != nil {
+		return valueobjects.AuthorID{}, err
+	}
+	return valueobjects.NewAuthorID(userID)
+}
@@ -2,30 +2,255 @@ package queryservices
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"Gommunity/platform/posts/application/outboundservices/acl"
 	"Gommunity/platform/posts/domain/model/entities"
 	"Gommunity/platform/posts/domain/model/queries"
+	"Gommunity/platform/posts/domain/model/valueobjects"
 	"Gommunity/platform/posts/domain/repositories"
 	"Gommunity/platform/posts/domain/services"
 )
 
 type postQueryServiceImpl struct {
-	postRepository repositories.PostRepository
+	postRepository               repositories.PostRepository
+	postRevisionRepository       repositories.PostRevisionRepository
+	pollVoteRepository           repositories.PollVoteRepository
+	externalUsersService         *acl.ExternalUsersService
+	externalCommunitiesService   *acl.ExternalCommunitiesService
+	externalSubscriptionsService *acl.ExternalSubscriptionsService
 }
 
 // NewPostQueryService creates a query service implementation.
-func NewPostQueryService(postRepository repositories.PostRepository) services.PostQueryService {
+func NewPostQueryService(
+	postRepository repositories.PostRepository,
+	postRevisionRepository repositories.PostRevisionRepository,
+	pollVoteRepository repositories.PollVoteRepository,
+	externalUsersService *acl.ExternalUsersService,
+	externalCommunitiesService *acl.ExternalCommunitiesService,
+	externalSubscriptionsService *acl.ExternalSubscriptionsService,
+) services.PostQueryService {
 	return &postQueryServiceImpl{
-		postRepository: postRepository,
+		postRepository:               postRepository,
+		postRevisionRepository:       postRevisionRepository,
+		pollVoteRepository:           pollVoteRepository,
+		externalUsersService:         externalUsersService,
+		externalCommunitiesService:   externalCommunitiesService,
+		externalSubscriptionsService: externalSubscriptionsService,
 	}
 }
 
 // HandleGetByID retrieves a post by identifier.
+// Drafts and scheduled posts are reported as missing unless the viewer is their author or a community moderator.
 func (s *postQueryServiceImpl) HandleGetByID(ctx context.Context, query queries.GetPostByIDQuery) (*entities.Post, error) {
-	return s.postRepository.FindByID(ctx, query.PostID())
+	post, err := s.postRepository.FindByID(ctx, query.PostID())
+	if err != nil || post == nil || post.IsPublished() {
+		return post, err
+	}
+
+	viewer := query.Viewer()
+	if viewer.IsZero() {
+		return nil, nil
+	}
+	if post.AuthorID().Equals(viewer) {
+		return post, nil
+	}
+
+	isModerator, err := s.isModerator(ctx, viewer, post.CommunityID())
+	if err != nil {
+		return nil, err
+	}
+	if !isModerator {
+		return nil, nil
+	}
+	return post, nil
+}
+
+// HandleGetByCommunity retrieves one page of published posts for a community and the cursor for the next one.
+// Pinned posts come first and are returned on top of the first page.
+func (s *postQueryServiceImpl) HandleGetByCommunity(ctx context.Context, query queries.GetPostsByCommunityQuery) ([]*entities.Post, *valueobjects.Cursor, error) {
+	if query.Offset() != nil && query.Cursor().IsZero() {
+		// Deprecated offset pagination pages through pinned and unpinned posts together
+		posts, err := s.postRepository.FindByCommunity(ctx, query.CommunityID(), query.Limit(), query.Offset())
+		return posts, nil, err
+	}
+
+	var pinned []*entities.Post
+	if query.Cursor().IsZero() {
+		var err error
+		pinned, err = s.postRepository.FindPinnedByCommunity(ctx, query.CommunityID())
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	posts, err := s.postRepository.FindUnpinnedByCommunity(ctx, query.CommunityID(), pageLimit(query.Limit()), query.Cursor())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	posts, next := nextPage(posts, query.Limit())
+	return append(pinned, posts...), next, nil
+}
+
+// HandleGetPinned retrieves the pinned posts of a community in pin order.
+func (s *postQueryServiceImpl) HandleGetPinned(ctx context.Context, query queries.GetPinnedPostsQuery) ([]*entities.Post, error) {
+	return s.postRepository.FindPinnedByCommunity(ctx, query.CommunityID())
+}
+
+// HandleGetByHashtag retrieves the published posts of a community tagged with a hashtag, newest first.
+func (s *postQueryServiceImpl) HandleGetByHashtag(ctx context.Context, query queries.GetPostsByHashtagQuery) ([]*entities.Post, *valueobjects.Cursor, error) {
+	posts, err := s.postRepository.FindByCommunityAndHashtag(ctx, query.CommunityID(), query.Hashtag(), pageLimit(query.Limit()), query.Offset(), query.Cursor())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	posts, next := nextPage(posts, query.Limit())
+	return posts, next, nil
+}
+
+// HandleGetMentioning retrieves the published posts that mention a user, newest first.
+func (s *postQueryServiceImpl) HandleGetMentioning(ctx context.Context, query queries.GetPostsMentioningUserQuery) ([]*entities.Post, *valueobjects.Cursor, error) {
+	posts, err := s.postRepository.FindMentioning(ctx, query.UserID(), pageLimit(query.Limit()), query.Offset(), query.Cursor())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	posts, next := nextPage(posts, query.Limit())
+	return posts, next, nil
+}
+
+// HandleGetUnpublished retrieves the drafts and scheduled posts of a community.
+// Members allowed to delete any post see every unpublished post; other users only see their own.
+func (s *postQueryServiceImpl) HandleGetUnpublished(ctx context.Context, query queries.GetUnpublishedPostsQuery) ([]*entities.Post, error) {
+	isModerator, err := s.isModerator(ctx, query.RequestedBy(), query.CommunityID())
+	if err != nil {
+		return nil, err
+	}
+	if isModerator {
+		return s.postRepository.FindUnpublishedByCommunity(ctx, query.CommunityID(), nil)
+	}
+
+	authorID := query.RequestedBy()
+	return s.postRepository.FindUnpublishedByCommunity(ctx, query.CommunityID(), &authorID)
+}
+
+// HandleGetPollResults retrieves the poll of a post as seen by the requesting user.
+// Results stay hidden until the user has voted or the poll has closed.
+func (s *postQueryServiceImpl) HandleGetPollResults(ctx context.Context, query queries.GetPollResultsQuery) (*services.PollResults, error) {
+	postQuery, err := queries.NewGetPostByIDQuery(query.PostID())
+	if err != nil {
+		return nil, err
+	}
+	post, err := s.HandleGetByID(ctx, postQuery.WithViewer(query.RequestedBy()))
+	if err != nil {
+		return nil, err
+	}
+	if post == nil {
+		return nil, errors.New("post not found")
+	}
+	if !post.HasPoll() {
+		return nil, errors.New("poll not found")
+	}
+
+	poll := post.Poll()
+	results := &services.PollResults{
+		Poll:   poll,
+		Closed: poll.IsClosed(time.Now()),
+	}
+
+	vote, err := s.pollVoteRepository.FindByPostAndVoter(ctx, query.PostID(), query.RequestedBy())
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve vote: %w", err)
+	}
+	if vote != nil {
+		results.HasVoted = true
+		results.UserOptionIDs = vote.OptionIDs()
+	}
+
+	results.ResultsVisible = results.HasVoted || results.Closed
+	if !results.ResultsVisible {
+		return results, nil
+	}
+
+	results.TotalVoters, err = s.pollVoteRepository.CountVoters(ctx, query.PostID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count voters: %w", err)
+	}
+
+	counts, err := s.pollVoteRepository.CountByOption(ctx, query.PostID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count votes: %w", err)
+	}
+	results.Counts = make(map[string]int, len(poll.Options()))
+	for _, option := range poll.Options() {
+		results.Counts[option.ID()] = counts[option.ID()]
+	}
+
+	if !poll.Anonymous() {
+		results.Voters, err = s.pollVoteRepository.FindVoterIDsByOption(ctx, query.PostID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve voters: %w", err)
+		}
+	}
+
+	return results, nil
+}
+
+// HandleGetRevisions retrieves the revision history of a post, newest first.
+// Earlier versions may hold content that was edited out, so only the author and
+// members holding the delete_any_post permission can read them.
+func (s *postQueryServiceImpl) HandleGetRevisions(ctx context.Context, query queries.GetPostRevisionsQuery) ([]*entities.PostRevision, error) {
+	post, err := s.postRepository.FindByID(ctx, query.PostID())
+	if err != nil {
+		return nil, err
+	}
+	if post == nil {
+		return nil, errors.New("post not found")
+	}
+
+	if !post.AuthorID().Equals(query.RequestedBy()) {
+		isModerator, err := s.isModerator(ctx, query.RequestedBy(), post.CommunityID())
+		if err != nil {
+			return nil, err
+		}
+		if !isModerator {
+			return nil, errors.New("only the author or community members allowed to delete any post can view revisions")
+		}
+	}
+
+	return s.postRevisionRepository.FindByPostID(ctx, query.PostID())
 }
 
-// HandleGetByCommunity retrieves posts for a community.
-func (s *postQueryServiceImpl) HandleGetByCommunity(ctx context.Context, query queries.GetPostsByCommunityQuery) ([]*entities.Post, error) {
-	return s.postRepository.FindByCommunity(ctx, query.CommunityID(), query.Limit(), query.Offset())
+// isModerator checks whether the user holds the delete_any_post permission in the community.
+func (s *postQueryServiceImpl) isModerator(ctx context.Context, userID valueobjects.AuthorID, communityID valueobjects.CommunityID) (bool, error) {
+	allowed, err := s.externalSubscriptionsService.HasPermission(ctx, userID, communityID, acl.DeleteAnyPostPermission)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify requester permissions: %w", err)
+	}
+	return allowed, nil
+}
+
+// pageLimit asks for one extra post so nextPage can tell whether another page exists.
+func pageLimit(limit *int) *int {
+	if limit == nil {
+		return nil
+	}
+	extra := *limit + 1
+	return &extra
+}
+
+// nextPage drops the extra post fetched through pageLimit and returns the cursor for the next page,
+// nil on the last page or when the listing is not limited.
+func nextPage(posts []*entities.Post, limit *int) ([]*entities.Post, *valueobjects.Cursor) {
+	if limit == nil || len(posts) <= *limit {
+		return posts, nil
+	}
+
+	posts = posts[:*limit]
+	last := posts[len(posts)-1]
+	next := valueobjects.NewCursor(last.CreatedAt(), last.PostID())
+	return posts, &next
 }
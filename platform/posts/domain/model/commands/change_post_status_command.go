@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"errors"
+	"time"
+
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// ChangePostStatusCommand represents the intent to move an unpublished post through its lifecycle.
+type ChangePostStatusCommand struct {
+	postID      valueobjects.PostID
+	requestedBy valueobjects.AuthorID
+	status      valueobjects.PostStatus
+	publishAt   time.Time
+}
+
+// NewChangePostStatusCommand builds a ChangePostStatusCommand.
+func NewChangePostStatusCommand(
+	postID valueobjects.PostID,
+	requestedBy valueobjects.AuthorID,
+	status valueobjects.PostStatus,
+	publishAt time.Time,
+) (ChangePostStatusCommand, error) {
+	if postID.IsZero() {
+		return ChangePostStatusCommand{}, errors.New("post ID is required")
+	}
+	if requestedBy.IsZero() {
+		return ChangePostStatusCommand{}, errors.New("requesting user ID is required")
+	}
+	if status.IsZero() {
+		return ChangePostStatusCommand{}, errors.New("post status is required")
+	}
+	if status.IsScheduled() && publishAt.IsZero() {
+		return ChangePostStatusCommand{}, errors.New("publish-at time is required for scheduled posts")
+	}
+
+	return ChangePostStatusCommand{
+		postID:      postID,
+		requestedBy: requestedBy,
+		status:      status,
+		publishAt:   publishAt,
+	}, nil
+}
+
+// PostID returns the post identifier.
+func (c ChangePostStatusCommand) PostID() valueobjects.PostID {
+	return c.postID
+}
+
+// RequestedBy returns the identifier of the user performing the action.
+func (c ChangePostStatusCommand) RequestedBy() valueobjects.AuthorID {
+	return c.requestedBy
+}
+
+// Status returns the target status.
+func (c ChangePostStatusCommand) Status() valueobjects.PostStatus {
+	return c.status
+}
+
+// PublishAt returns when the post should be published if it is being scheduled.
+func (c ChangePostStatusCommand) PublishAt() time.Time {
+	return c.publishAt
+}
@@ -2,25 +2,34 @@ package commands
 
 import (
 	"errors"
+	"time"
 
 	"Gommunity/platform/posts/domain/model/valueobjects"
 )
 
 // CreatePostCommand represents the intent to publish a new post.
-// Only community owners and admins can create posts.
+// Members allowed to publish can post messages; announcements also need the publish_announcement permission.
+// The post may also be kept as a draft or scheduled for later publication.
 type CreatePostCommand struct {
 	communityID valueobjects.CommunityID
 	authorID    valueobjects.AuthorID
+	postType    valueobjects.PostType
 	content     valueobjects.PostContent
 	images      valueobjects.PostImages
+	status      valueobjects.PostStatus
+	publishAt   time.Time
+	poll        valueobjects.Poll
 }
 
 // NewCreatePostCommand validates and builds a CreatePostCommand.
 func NewCreatePostCommand(
 	communityID valueobjects.CommunityID,
 	authorID valueobjects.AuthorID,
+	postType valueobjects.PostType,
 	content valueobjects.PostContent,
 	images valueobjects.PostImages,
+	status valueobjects.PostStatus,
+	publishAt time.Time,
 ) (CreatePostCommand, error) {
 	if communityID.IsZero() {
 		return CreatePostCommand{}, errors.New("community ID is required")
@@ -31,12 +40,24 @@ func NewCreatePostCommand(
 	if content.IsZero() {
 		return CreatePostCommand{}, errors.New("content is required")
 	}
+	if postType.IsZero() {
+		postType = valueobjects.DefaultMessageType()
+	}
+	if status.IsZero() {
+		status = valueobjects.PublishedStatus()
+	}
+	if status.IsScheduled() && publishAt.IsZero() {
+		return CreatePostCommand{}, errors.New("publish-at time is required for scheduled posts")
+	}
 
 	return CreatePostCommand{
 		communityID: communityID,
 		authorID:    authorID,
+		postType:    postType,
 		content:     content,
 		images:      images,
+		status:      status,
+		publishAt:   publishAt,
 	}, nil
 }
 
@@ -50,6 +71,11 @@ func (c CreatePostCommand) AuthorID() valueobjects.AuthorID {
 	return c.authorID
 }
 
+// PostType returns the requested post type.
+func (c CreatePostCommand) PostType() valueobjects.PostType {
+	return c.postType
+}
+
 // Content returns the post content.
 func (c CreatePostCommand) Content() valueobjects.PostContent {
 	return c.content
@@ -59,3 +85,24 @@ func (c CreatePostCommand) Content() valueobjects.PostContent {
 func (c CreatePostCommand) Images() valueobjects.PostImages {
 	return c.images
 }
+
+// Status returns the requested lifecycle status.
+func (c CreatePostCommand) Status() valueobjects.PostStatus {
+	return c.status
+}
+
+// PublishAt returns when a scheduled post should be published.
+func (c CreatePostCommand) PublishAt() time.Time {
+	return c.publishAt
+}
+
+// WithPoll attaches a poll to the post being created.
+func (c CreatePostCommand) WithPoll(poll valueobjects.Poll) CreatePostCommand {
+	c.poll = poll
+	return c
+}
+
+// Poll returns the poll to attach, which is zero when there is none.
+func (c CreatePostCommand) Poll() valueobjects.Poll {
+	return c.poll
+}
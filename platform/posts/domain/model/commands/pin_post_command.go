@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// PinPostCommand represents the intent to pin a post at the top of its community.
+type PinPostCommand struct {
+	postID      valueobjects.PostID
+	requestedBy valueobjects.AuthorID
+}
+
+// NewPinPostCommand builds a PinPostCommand.
+func NewPinPostCommand(
+	postID valueobjects.PostID,
+	requestedBy valueobjects.AuthorID,
+) (PinPostCommand, error) {
+	if postID.IsZero() {
+		return PinPostCommand{}, errors.New("post ID is required")
+	}
+	if requestedBy.IsZero() {
+		return PinPostCommand{}, errors.New("requesting user ID is required")
+	}
+
+	return PinPostCommand{
+		postID:      postID,
+		requestedBy: requestedBy,
+	}, nil
+}
+
+// PostID returns the post identifier.
+func (c PinPostCommand) PostID() valueobjects.PostID {
+	return c.postID
+}
+
+// RequestedBy returns the identifier of the user performing the action.
+func (c PinPostCommand) RequestedBy() valueobjects.AuthorID {
+	return c.requestedBy
+}
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// RestorePostRevisionCommand represents the intent to roll a post back to a previous revision.
+type RestorePostRevisionCommand struct {
+	postID      valueobjects.PostID
+	revisionID  valueobjects.RevisionID
+	requestedBy valueobjects.AuthorID
+}
+
+// NewRestorePostRevisionCommand validates and builds a RestorePostRevisionCommand.
+func NewRestorePostRevisionCommand(
+	postID valueobjects.PostID,
+	revisionID valueobjects.RevisionID,
+	requestedBy valueobjects.AuthorID,
+) (RestorePostRevisionCommand, error) {
+	if postID.IsZero() {
+		return RestorePostRevisionCommand{}, errors.New("post ID is required")
+	}
+	if revisionID.IsZero() {
+		return RestorePostRevisionCommand{}, errors.New("revision ID is required")
+	}
+	if requestedBy.IsZero() {
+		return RestorePostRevisionCommand{}, errors.New("requesting user ID is required")
+	}
+
+	return RestorePostRevisionCommand{
+		postID:      postID,
+		revisionID:  revisionID,
+		requestedBy: requestedBy,
+	}, nil
+}
+
+// PostID returns the post identifier.
+func (c RestorePostRevisionCommand) PostID() valueobjects.PostID {
+	return c.postID
+}
+
+// RevisionID returns the revision to restore.
+func (c RestorePostRevisionCommand) RevisionID() valueobjects.RevisionID {
+	return c.revisionID
+}
+
+// RequestedBy returns the identifier of the user performing the action.
+func (c RestorePostRevisionCommand) RequestedBy() valueobjects.AuthorID {
+	return c.requestedBy
+}
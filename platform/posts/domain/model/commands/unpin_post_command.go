@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// UnpinPostCommand represents the intent to remove a post from the community's pinned posts.
+type UnpinPostCommand struct {
+	postID      valueobjects.PostID
+	requestedBy valueobjects.AuthorID
+}
+
+// NewUnpinPostCommand builds a UnpinPostCommand.
+func NewUnpinPostCommand(
+	postID valueobjects.PostID,
+	requestedBy valueobjects.AuthorID,
+) (UnpinPostCommand, error) {
+	if postID.IsZero() {
+		return UnpinPostCommand{}, errors.New("post ID is required")
+	}
+	if requestedBy.IsZero() {
+		return UnpinPostCommand{}, errors.New("requesting user ID is required")
+	}
+
+	return UnpinPostCommand{
+		postID:      postID,
+		requestedBy: requestedBy,
+	}, nil
+}
+
+// PostID returns the post identifier.
+func (c UnpinPostCommand) PostID() valueobjects.PostID {
+	return c.postID
+}
+
+// RequestedBy returns the identifier of the user performing the action.
+func (c UnpinPostCommand) RequestedBy() valueobjects.AuthorID {
+	return c.requestedBy
+}
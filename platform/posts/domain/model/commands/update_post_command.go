@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// UpdatePostCommand represents the intent to edit an existing post.
+// The author or a member allowed to delete any post can edit it.
+type UpdatePostCommand struct {
+	postID      valueobjects.PostID
+	requestedBy valueobjects.AuthorID
+	content     valueobjects.PostContent
+	images      valueobjects.PostImages
+}
+
+// NewUpdatePostCommand validates and builds an UpdatePostCommand.
+func NewUpdatePostCommand(
+	postID valueobjects.PostID,
+	requestedBy valueobjects.AuthorID,
+	content valueobjects.PostContent,
+	images valueobjects.PostImages,
+) (UpdatePostCommand, error) {
+	if postID.IsZero() {
+		return UpdatePostCommand{}, errors.New("post ID is required")
+	}
+	if requestedBy.IsZero() {
+		return UpdatePostCommand{}, errors.New("requesting user ID is required")
+	}
+	if content.IsZero() {
+		return UpdatePostCommand{}, errors.New("content is required")
+	}
+
+	return UpdatePostCommand{
+		postID:      postID,
+		requestedBy: requestedBy,
+		content:     content,
+		images:      images,
+	}, nil
+}
+
+// PostID returns the post identifier.
+func (c UpdatePostCommand) PostID() valueobjects.PostID {
+	return c.postID
+}
+
+// RequestedBy returns the identifier of the user performing the edit.
+func (c UpdatePostCommand) RequestedBy() valueobjects.AuthorID {
+	return c.requestedBy
+}
+
+// Content returns the new post content.
+func (c UpdatePostCommand) Content() valueobjects.PostContent {
+	return c.content
+}
+
+// Images returns the new set of images.
+func (c UpdatePostCommand) Images() valueobjects.PostImages {
+	return c.images
+}
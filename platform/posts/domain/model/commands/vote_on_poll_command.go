@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"errors"
+	"slices"
+
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// VoteOnPollCommand represents the intent to vote in the poll of a post.
+type VoteOnPollCommand struct {
+	postID    valueobjects.PostID
+	voterID   valueobjects.AuthorID
+	optionIDs []string
+}
+
+// NewVoteOnPollCommand builds a VoteOnPollCommand.
+func NewVoteOnPollCommand(
+	postID valueobjects.PostID,
+	voterID valueobjects.AuthorID,
+	optionIDs []string,
+) (VoteOnPollCommand, error) {
+	if postID.IsZero() {
+		return VoteOnPollCommand{}, errors.New("post ID is required")
+	}
+	if voterID.IsZero() {
+		return VoteOnPollCommand{}, errors.New("voter ID is required")
+	}
+	if len(optionIDs) == 0 {
+		return VoteOnPollCommand{}, errors.New("at least one poll option is required")
+	}
+
+	return VoteOnPollCommand{
+		postID:    postID,
+		voterID:   voterID,
+		optionIDs: slices.Clone(optionIDs),
+	}, nil
+}
+
+// PostID returns the post identifier.
+func (c VoteOnPollCommand) PostID() valueobjects.PostID {
+	return c.postID
+}
+
+// VoterID returns the identifier of the user voting.
+func (c VoteOnPollCommand) VoterID() valueobjects.AuthorID {
+	return c.voterID
+}
+
+// OptionIDs returns the identifiers of the picked options.
+func (c VoteOnPollCommand) OptionIDs() []string {
+	return slices.Clone(c.optionIDs)
+}
@@ -0,0 +1,109 @@
+package entities
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// PollVote records the options a user picked in the poll of a post.
+// A user votes at most once per poll.
+type PollVote struct {
+	voteID      valueobjects.PollVoteID
+	postID      valueobjects.PostID
+	communityID valueobjects.CommunityID
+	voterID     valueobjects.AuthorID
+	optionIDs   []string
+	createdAt   time.Time
+}
+
+// NewPollVote validates the picked options against the poll of the post and creates the vote.
+func NewPollVote(post *Post, voterID valueobjects.AuthorID, optionIDs []string, now time.Time) (*PollVote, error) {
+	if post == nil {
+		return nil, errors.New("post is required")
+	}
+	if voterID.IsZero() {
+		return nil, errors.New("voter ID is required")
+	}
+
+	poll := post.Poll()
+	if poll.IsZero() {
+		return nil, errors.New("post has no poll")
+	}
+	if poll.IsClosed(now) {
+		return nil, errors.New("poll is closed")
+	}
+
+	picked := slices.Compact(slices.Sorted(slices.Values(optionIDs)))
+	if len(picked) == 0 {
+		return nil, errors.New("at least one poll option is required")
+	}
+	if len(picked) > 1 && !poll.MultipleChoice() {
+		return nil, errors.New("this poll accepts a single option")
+	}
+	for _, optionID := range picked {
+		if !poll.HasOption(optionID) {
+			return nil, errors.New("poll option not found")
+		}
+	}
+
+	return &PollVote{
+		voteID:      valueobjects.GeneratePollVoteID(),
+		postID:      post.PostID(),
+		communityID: post.CommunityID(),
+		voterID:     voterID,
+		optionIDs:   picked,
+		createdAt:   now,
+	}, nil
+}
+
+// ReconstructPollVote rebuilds a vote from persistence.
+func ReconstructPollVote(
+	voteID valueobjects.PollVoteID,
+	postID valueobjects.PostID,
+	communityID valueobjects.CommunityID,
+	voterID valueobjects.AuthorID,
+	optionIDs []string,
+	createdAt time.Time,
+) *PollVote {
+	return &PollVote{
+		voteID:      voteID,
+		postID:      postID,
+		communityID: communityID,
+		voterID:     voterID,
+		optionIDs:   slices.Clone(optionIDs),
+		createdAt:   createdAt,
+	}
+}
+
+// VoteID returns the vote identifier.
+func (v *PollVote) VoteID() valueobjects.PollVoteID {
+	return v.voteID
+}
+
+// PostID returns the identifier of the post holding the poll.
+func (v *PollVote) PostID() valueobjects.PostID {
+	return v.postID
+}
+
+// CommunityID returns the community of the post.
+func (v *PollVote) CommunityID() valueobjects.CommunityID {
+	return v.communityID
+}
+
+// VoterID returns the user who voted.
+func (v *PollVote) VoterID() valueobjects.AuthorID {
+	return v.voterID
+}
+
+// OptionIDs returns the identifiers of the picked options.
+func (v *PollVote) OptionIDs() []string {
+	return slices.Clone(v.optionIDs)
+}
+
+// CreatedAt returns when the vote was cast.
+func (v *PollVote) CreatedAt() time.Time {
+	return v.createdAt
+}
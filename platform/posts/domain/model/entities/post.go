@@ -2,31 +2,44 @@ package entities
 
 import (
 	"errors"
+	"slices"
 	"time"
 
 	"Gommunity/platform/posts/domain/model/valueobjects"
 )
 
-// Post represents a message publication made inside a community.
-// All posts are messages - announcements have been removed.
+// Post represents a publication made inside a community.
+// A post is either a regular message or an announcement, and it moves through
+// a draft -> scheduled -> published lifecycle before the community can see it.
 type Post struct {
 	id          string
 	postID      valueobjects.PostID
 	communityID valueobjects.CommunityID
 	authorID    valueobjects.AuthorID
+	postType    valueobjects.PostType
 	content     valueobjects.PostContent
 	images      valueobjects.PostImages
+	poll        valueobjects.Poll
+	hashtags    []valueobjects.Hashtag
+	mentions    []valueobjects.AuthorID
+	pinOrder    int
+	status      valueobjects.PostStatus
+	publishAt   time.Time
 	createdAt   time.Time
 	updatedAt   time.Time
 }
 
 // NewPost creates a new post aggregate.
-// Only community owners and admins can create posts.
+// An unset post type defaults to a message and an unset status publishes the post right away.
+// Scheduled posts require a publish-at time in the future.
 func NewPost(
 	communityID valueobjects.CommunityID,
 	authorID valueobjects.AuthorID,
+	postType valueobjects.PostType,
 	content valueobjects.PostContent,
 	images valueobjects.PostImages,
+	status valueobjects.PostStatus,
+	publishAt time.Time,
 ) (*Post, error) {
 	if communityID.IsZero() {
 		return nil, errors.New("community ID is required")
@@ -37,8 +50,21 @@ func NewPost(
 	if content.IsZero() {
 		return nil, errors.New("post content is required")
 	}
+	if postType.IsZero() {
+		postType = valueobjects.DefaultMessageType()
+	}
+	if status.IsZero() {
+		status = valueobjects.PublishedStatus()
+	}
 
 	now := time.Now()
+	if status.IsScheduled() {
+		if !publishAt.After(now) {
+			return nil, errors.New("scheduled posts need a publish-at time in the future")
+		}
+	} else if !publishAt.IsZero() {
+		return nil, errors.New("publish-at time requires the scheduled status")
+	}
 	postID := valueobjects.GeneratePostID()
 
 	return &Post{
@@ -46,8 +72,13 @@ func NewPost(
 		postID:      postID,
 		communityID: communityID,
 		authorID:    authorID,
+		postType:    postType,
 		content:     content,
 		images:      images,
+		hashtags:    content.Hashtags(),
+		mentions:    []valueobjects.AuthorID{},
+		status:      status,
+		publishAt:   publishAt,
 		createdAt:   now,
 		updatedAt:   now,
 	}, nil
@@ -59,8 +90,15 @@ func ReconstructPost(
 	postID valueobjects.PostID,
 	communityID valueobjects.CommunityID,
 	authorID valueobjects.AuthorID,
+	postType valueobjects.PostType,
 	content valueobjects.PostContent,
 	images valueobjects.PostImages,
+	poll valueobjects.Poll,
+	hashtags []valueobjects.Hashtag,
+	mentions []valueobjects.AuthorID,
+	pinOrder int,
+	status valueobjects.PostStatus,
+	publishAt time.Time,
 	createdAt time.Time,
 	updatedAt time.Time,
 ) *Post {
@@ -69,8 +107,15 @@ func ReconstructPost(
 		postID:      postID,
 		communityID: communityID,
 		authorID:    authorID,
+		postType:    postType,
 		content:     content,
 		images:      images,
+		poll:        poll,
+		hashtags:    hashtags,
+		mentions:    mentions,
+		pinOrder:    pinOrder,
+		status:      status,
+		publishAt:   publishAt,
 		createdAt:   createdAt,
 		updatedAt:   updatedAt,
 	}
@@ -96,6 +141,11 @@ func (p *Post) AuthorID() valueobjects.AuthorID {
 	return p.authorID
 }
 
+// PostType returns whether the post is a message or an announcement.
+func (p *Post) PostType() valueobjects.PostType {
+	return p.postType
+}
+
 // Content returns the post content.
 func (p *Post) Content() valueobjects.PostContent {
 	return p.content
@@ -106,6 +156,56 @@ func (p *Post) Images() valueobjects.PostImages {
 	return p.images
 }
 
+// Poll returns the poll attached to the post, which is zero when there is none.
+func (p *Post) Poll() valueobjects.Poll {
+	return p.poll
+}
+
+// HasPoll indicates whether a poll is attached to the post.
+func (p *Post) HasPoll() bool {
+	return !p.poll.IsZero()
+}
+
+// Hashtags returns the hashtags extracted from the content.
+func (p *Post) Hashtags() []valueobjects.Hashtag {
+	return slices.Clone(p.hashtags)
+}
+
+// Mentions returns the community members mentioned in the content.
+func (p *Post) Mentions() []valueobjects.AuthorID {
+	return slices.Clone(p.mentions)
+}
+
+// PinOrder returns the position among the community's pinned posts (1-based), or 0 when not pinned.
+func (p *Post) PinOrder() int {
+	return p.pinOrder
+}
+
+// IsPinned indicates whether the post is pinned at the top of its community.
+func (p *Post) IsPinned() bool {
+	return p.pinOrder > 0
+}
+
+// Status returns where the post is in its draft -> scheduled -> published lifecycle.
+func (p *Post) Status() valueobjects.PostStatus {
+	return p.status
+}
+
+// PublishAt returns when a scheduled post will be published, or the zero time otherwise.
+func (p *Post) PublishAt() time.Time {
+	return p.publishAt
+}
+
+// IsPublished indicates whether the post is visible to the community.
+func (p *Post) IsPublished() bool {
+	return p.status.IsPublished()
+}
+
+// IsDueForPublication indicates whether a scheduled post has reached its publish-at time.
+func (p *Post) IsDueForPublication(now time.Time) bool {
+	return p.status.IsScheduled() && !p.publishAt.After(now)
+}
+
 // CreatedAt returns the creation timestamp.
 func (p *Post) CreatedAt() time.Time {
 	return p.createdAt
@@ -115,3 +215,105 @@ func (p *Post) CreatedAt() time.Time {
 func (p *Post) UpdatedAt() time.Time {
 	return p.updatedAt
 }
+
+// Edit replaces the content and images of the post.
+func (p *Post) Edit(content valueobjects.PostContent, images valueobjects.PostImages) error {
+	if content.IsZero() {
+		return errors.New("post content is required")
+	}
+
+	p.content = content
+	p.images = images
+	p.hashtags = content.Hashtags()
+	p.updatedAt = time.Now()
+	return nil
+}
+
+// SetMentions replaces the users mentioned in the content.
+// Mentions are resolved outside the aggregate since they depend on users and memberships.
+func (p *Post) SetMentions(mentions []valueobjects.AuthorID) {
+	p.mentions = slices.Clone(mentions)
+	if p.mentions == nil {
+		p.mentions = []valueobjects.AuthorID{}
+	}
+}
+
+// AttachPoll adds a poll to a post that has none yet.
+func (p *Post) AttachPoll(poll valueobjects.Poll) error {
+	if poll.IsZero() {
+		return errors.New("poll is required")
+	}
+	if p.HasPoll() {
+		return errors.New("post already has a poll")
+	}
+	if !poll.ClosesAt().IsZero() && p.status.IsScheduled() && !poll.ClosesAt().After(p.publishAt) {
+		return errors.New("poll closing time has to be after the publish-at time")
+	}
+	p.poll = poll
+	return nil
+}
+
+// MoveToDraft takes a scheduled post back to draft.
+func (p *Post) MoveToDraft() error {
+	if p.IsPublished() {
+		return errors.New("published posts cannot be moved back to draft")
+	}
+	p.status = valueobjects.DraftStatus()
+	p.publishAt = time.Time{}
+	p.updatedAt = time.Now()
+	return nil
+}
+
+// Schedule sets the time at which an unpublished post will be published.
+func (p *Post) Schedule(publishAt time.Time) error {
+	if p.IsPublished() {
+		return errors.New("post is already published")
+	}
+	now := time.Now()
+	if !publishAt.After(now) {
+		return errors.New("scheduled posts need a publish-at time in the future")
+	}
+	if closesAt := p.poll.ClosesAt(); !closesAt.IsZero() && !closesAt.After(publishAt) {
+		return errors.New("poll closing time has to be after the publish-at time")
+	}
+	p.status = valueobjects.ScheduledStatus()
+	p.publishAt = publishAt
+	p.updatedAt = now
+	return nil
+}
+
+// Publish makes the post visible to the community.
+// The creation time is moved to the publication time so the post surfaces
+// at the top of feeds instead of where it was first drafted.
+func (p *Post) Publish() error {
+	if p.IsPublished() {
+		return errors.New("post is already published")
+	}
+	now := time.Now()
+	p.status = valueobjects.PublishedStatus()
+	p.publishAt = time.Time{}
+	p.createdAt = now
+	p.updatedAt = now
+	return nil
+}
+
+// Pin places the post at the given position among the community's pinned posts.
+func (p *Post) Pin(order int) error {
+	if p.IsPinned() {
+		return errors.New("post is already pinned")
+	}
+	if order < 1 {
+		return errors.New("pin order must be a positive number")
+	}
+	p.pinOrder = order
+	return nil
+}
+
+// Unpin removes the post from the community's pinned posts.
+func (p *Post) Unpin() error {
+	if !p.IsPinned() {
+		return errors.New("post is not pinned")
+	}
+	p.pinOrder = 0
+	return nil
+}
@@ -0,0 +1,95 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// PostRevision is an immutable snapshot of a post taken right before it was edited.
+type PostRevision struct {
+	revisionID  valueobjects.RevisionID
+	postID      valueobjects.PostID
+	communityID valueobjects.CommunityID
+	content     valueobjects.PostContent
+	images      valueobjects.PostImages
+	editedBy    valueobjects.AuthorID
+	createdAt   time.Time
+}
+
+// NewPostRevision captures the current state of a post before it is changed.
+func NewPostRevision(post *Post, editedBy valueobjects.AuthorID) (*PostRevision, error) {
+	if post == nil {
+		return nil, errors.New("post is required")
+	}
+	if editedBy.IsZero() {
+		return nil, errors.New("editor ID is required")
+	}
+
+	return &PostRevision{
+		revisionID:  valueobjects.GenerateRevisionID(),
+		postID:      post.PostID(),
+		communityID: post.CommunityID(),
+		content:     post.Content(),
+		images:      post.Images(),
+		editedBy:    editedBy,
+		createdAt:   time.Now(),
+	}, nil
+}
+
+// ReconstructPostRevision rebuilds a revision from persistence.
+func ReconstructPostRevision(
+	revisionID valueobjects.RevisionID,
+	postID valueobjects.PostID,
+	communityID valueobjects.CommunityID,
+	content valueobjects.PostContent,
+	images valueobjects.PostImages,
+	editedBy valueobjects.AuthorID,
+	createdAt time.Time,
+) *PostRevision {
+	return &PostRevision{
+		revisionID:  revisionID,
+		postID:      postID,
+		communityID: communityID,
+		content:     content,
+		images:      images,
+		editedBy:    editedBy,
+		createdAt:   createdAt,
+	}
+}
+
+// RevisionID returns the revision identifier.
+func (r *PostRevision) RevisionID() valueobjects.RevisionID {
+	return r.revisionID
+}
+
+// PostID returns the identifier of the revised post.
+func (r *PostRevision) PostID() valueobjects.PostID {
+	return r.postID
+}
+
+// CommunityID returns the community identifier.
+func (r *PostRevision) CommunityID() valueobjects.CommunityID {
+	return r.communityID
+}
+
+// Content returns the content the post had before the edit.
+func (r *PostRevision) Content() valueobjects.PostContent {
+	return r.content
+}
+
+// Images returns the images the post had before the edit.
+func (r *PostRevision) Images() valueobjects.PostImages {
+	return r.images
+}
+
+// EditedBy returns the user whose edit replaced this version.
+func (r *PostRevision) EditedBy() valueobjects.AuthorID {
+	return r.editedBy
+}
+
+// CreatedAt returns when the revision was recorded.
+func (r *PostRevision) CreatedAt() time.Time {
+	return r.createdAt
+}
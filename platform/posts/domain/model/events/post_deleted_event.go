@@ -8,20 +8,23 @@ import (
 
 // PostDeletedEvent represents the deletion of a post.
 type PostDeletedEvent struct {
-	postID     valueobjects.PostID
-	requester  valueobjects.AuthorID
-	occurredOn time.Time
+	postID      valueobjects.PostID
+	communityID valueobjects.CommunityID
+	requester   valueobjects.AuthorID
+	occurredOn  time.Time
 }
 
 // NewPostDeletedEvent creates a new PostDeletedEvent.
 func NewPostDeletedEvent(
 	postID valueobjects.PostID,
+	communityID valueobjects.CommunityID,
 	requester valueobjects.AuthorID,
 ) PostDeletedEvent {
 	return PostDeletedEvent{
-		postID:     postID,
-		requester:  requester,
-		occurredOn: time.Now(),
+		postID:      postID,
+		communityID: communityID,
+		requester:   requester,
+		occurredOn:  time.Now(),
 	}
 }
 
@@ -30,6 +33,11 @@ func (e PostDeletedEvent) PostID() valueobjects.PostID {
 	return e.postID
 }
 
+// CommunityID returns the community the post belonged to.
+func (e PostDeletedEvent) CommunityID() valueobjects.CommunityID {
+	return e.communityID
+}
+
 // Requester returns the user who requested the deletion.
 func (e PostDeletedEvent) Requester() valueobjects.AuthorID {
 	return e.requester
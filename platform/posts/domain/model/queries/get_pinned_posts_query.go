@@ -0,0 +1,25 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// GetPinnedPostsQuery retrieves the pinned posts of a community in pin order.
+type GetPinnedPostsQuery struct {
+	communityID valueobjects.CommunityID
+}
+
+// NewGetPinnedPostsQuery validates input and creates the query.
+func NewGetPinnedPostsQuery(communityID valueobjects.CommunityID) (GetPinnedPostsQuery, error) {
+	if communityID.IsZero() {
+		return GetPinnedPostsQuery{}, errors.New("community ID is required")
+	}
+	return GetPinnedPostsQuery{communityID: communityID}, nil
+}
+
+// CommunityID returns the community identifier.
+func (q GetPinnedPostsQuery) CommunityID() valueobjects.CommunityID {
+	return q.communityID
+}
@@ -0,0 +1,34 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// GetPollResultsQuery retrieves the poll of a post as seen by a user.
+type GetPollResultsQuery struct {
+	postID      valueobjects.PostID
+	requestedBy valueobjects.AuthorID
+}
+
+// NewGetPollResultsQuery validates input and creates the query.
+func NewGetPollResultsQuery(postID valueobjects.PostID, requestedBy valueobjects.AuthorID) (GetPollResultsQuery, error) {
+	if postID.IsZero() {
+		return GetPollResultsQuery{}, errors.New("post ID is required")
+	}
+	if requestedBy.IsZero() {
+		return GetPollResultsQuery{}, errors.New("requesting user ID is required")
+	}
+	return GetPollResultsQuery{postID: postID, requestedBy: requestedBy}, nil
+}
+
+// PostID returns the identifier of the post holding the poll.
+func (q GetPollResultsQuery) PostID() valueobjects.PostID {
+	return q.postID
+}
+
+// RequestedBy returns the identifier of the user performing the query.
+func (q GetPollResultsQuery) RequestedBy() valueobjects.AuthorID {
+	return q.requestedBy
+}
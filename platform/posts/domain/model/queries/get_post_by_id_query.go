@@ -7,8 +7,11 @@ import (
 )
 
 // GetPostByIDQuery retrieves a post by its identifier.
+// Drafts and scheduled posts are only returned to their author and community admins,
+// so the viewer should be set whenever it is known.
 type GetPostByIDQuery struct {
 	postID valueobjects.PostID
+	viewer valueobjects.AuthorID
 }
 
 // NewGetPostByIDQuery validates input and creates the query.
@@ -23,3 +26,14 @@ func NewGetPostByIDQuery(postID valueobjects.PostID) (GetPostByIDQuery, error) {
 func (q GetPostByIDQuery) PostID() valueobjects.PostID {
 	return q.postID
 }
+
+// WithViewer sets the user requesting the post.
+func (q GetPostByIDQuery) WithViewer(viewer valueobjects.AuthorID) GetPostByIDQuery {
+	q.viewer = viewer
+	return q
+}
+
+// Viewer returns the user requesting the post, which may be zero.
+func (q GetPostByIDQuery) Viewer() valueobjects.AuthorID {
+	return q.viewer
+}
@@ -0,0 +1,34 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// GetPostRevisionsQuery retrieves the revision history of a post as seen by a user.
+type GetPostRevisionsQuery struct {
+	postID      valueobjects.PostID
+	requestedBy valueobjects.AuthorID
+}
+
+// NewGetPostRevisionsQuery validates input and creates the query.
+func NewGetPostRevisionsQuery(postID valueobjects.PostID, requestedBy valueobjects.AuthorID) (GetPostRevisionsQuery, error) {
+	if postID.IsZero() {
+		return GetPostRevisionsQuery{}, errors.New("post ID is required")
+	}
+	if requestedBy.IsZero() {
+		return GetPostRevisionsQuery{}, errors.New("requesting user ID is required")
+	}
+	return GetPostRevisionsQuery{postID: postID, requestedBy: requestedBy}, nil
+}
+
+// PostID returns the identifier of the post.
+func (q GetPostRevisionsQuery) PostID() valueobjects.PostID {
+	return q.postID
+}
+
+// RequestedBy returns the identifier of the user performing the query.
+func (q GetPostRevisionsQuery) RequestedBy() valueobjects.AuthorID {
+	return q.requestedBy
+}
@@ -11,6 +11,7 @@ type GetPostsByCommunityQuery struct {
 	communityID valueobjects.CommunityID
 	limit       *int
 	offset      *int
+	cursor      valueobjects.Cursor
 }
 
 // NewGetPostsByCommunityQuery validates input and creates the query.
@@ -32,6 +33,12 @@ func (q GetPostsByCommunityQuery) WithPagination(limit, offset int) GetPostsByCo
 	return q
 }
 
+// WithCursor continues the listing right after the cursor. It takes precedence over the offset.
+func (q GetPostsByCommunityQuery) WithCursor(cursor valueobjects.Cursor) GetPostsByCommunityQuery {
+	q.cursor = cursor
+	return q
+}
+
 // CommunityID returns the community identifier.
 func (q GetPostsByCommunityQuery) CommunityID() valueobjects.CommunityID {
 	return q.communityID
@@ -42,7 +49,12 @@ func (q GetPostsByCommunityQuery) Limit() *int {
 	return q.limit
 }
 
-// Offset returns the optional offset.
+// Offset returns the optional offset (deprecated in favour of the cursor).
 func (q GetPostsByCommunityQuery) Offset() *int {
 	return q.offset
 }
+
+// Cursor returns the position to continue from, zero for the first page.
+func (q GetPostsByCommunityQuery) Cursor() valueobjects.Cursor {
+	return q.cursor
+}
@@ -0,0 +1,69 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// GetPostsByHashtagQuery retrieves the published posts of a community tagged with a hashtag.
+type GetPostsByHashtagQuery struct {
+	communityID valueobjects.CommunityID
+	hashtag     valueobjects.Hashtag
+	limit       *int
+	offset      *int
+	cursor      valueobjects.Cursor
+}
+
+// NewGetPostsByHashtagQuery validates input and creates the query.
+func NewGetPostsByHashtagQuery(communityID valueobjects.CommunityID, hashtag valueobjects.Hashtag) (GetPostsByHashtagQuery, error) {
+	if communityID.IsZero() {
+		return GetPostsByHashtagQuery{}, errors.New("community ID is required")
+	}
+	if hashtag.IsZero() {
+		return GetPostsByHashtagQuery{}, errors.New("hashtag is required")
+	}
+	return GetPostsByHashtagQuery{communityID: communityID, hashtag: hashtag}, nil
+}
+
+// WithPagination sets pagination options.
+func (q GetPostsByHashtagQuery) WithPagination(limit, offset int) GetPostsByHashtagQuery {
+	if limit > 0 {
+		q.limit = &limit
+	}
+	if offset >= 0 {
+		q.offset = &offset
+	}
+	return q
+}
+
+// WithCursor continues the listing right after the cursor. It takes precedence over the offset.
+func (q GetPostsByHashtagQuery) WithCursor(cursor valueobjects.Cursor) GetPostsByHashtagQuery {
+	q.cursor = cursor
+	return q
+}
+
+// CommunityID returns the community identifier.
+func (q GetPostsByHashtagQuery) CommunityID() valueobjects.CommunityID {
+	return q.communityID
+}
+
+// Hashtag returns the hashtag to look for.
+func (q GetPostsByHashtagQuery) Hashtag() valueobjects.Hashtag {
+	return q.hashtag
+}
+
+// Limit returns the optional limit.
+func (q GetPostsByHashtagQuery) Limit() *int {
+	return q.limit
+}
+
+// Offset returns the optional offset (deprecated in favour of the cursor).
+func (q GetPostsByHashtagQuery) Offset() *int {
+	return q.offset
+}
+
+// Cursor returns the position to continue from, zero for the first page.
+func (q GetPostsByHashtagQuery) Cursor() valueobjects.Cursor {
+	return q.cursor
+}
@@ -0,0 +1,60 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// GetPostsMentioningUserQuery retrieves the published posts that mention a user.
+type GetPostsMentioningUserQuery struct {
+	userID valueobjects.AuthorID
+	limit  *int
+	offset *int
+	cursor valueobjects.Cursor
+}
+
+// NewGetPostsMentioningUserQuery validates input and creates the query.
+func NewGetPostsMentioningUserQuery(userID valueobjects.AuthorID) (GetPostsMentioningUserQuery, error) {
+	if userID.IsZero() {
+		return GetPostsMentioningUserQuery{}, errors.New("user ID is required")
+	}
+	return GetPostsMentioningUserQuery{userID: userID}, nil
+}
+
+// WithPagination sets pagination options.
+func (q GetPostsMentioningUserQuery) WithPagination(limit, offset int) GetPostsMentioningUserQuery {
+	if limit > 0 {
+		q.limit = &limit
+	}
+	if offset >= 0 {
+		q.offset = &offset
+	}
+	return q
+}
+
+// WithCursor continues the listing right after the cursor. It takes precedence over the offset.
+func (q GetPostsMentioningUserQuery) WithCursor(cursor valueobjects.Cursor) GetPostsMentioningUserQuery {
+	q.cursor = cursor
+	return q
+}
+
+// UserID returns the identifier of the mentioned user.
+func (q GetPostsMentioningUserQuery) UserID() valueobjects.AuthorID {
+	return q.userID
+}
+
+// Limit returns the optional limit.
+func (q GetPostsMentioningUserQuery) Limit() *int {
+	return q.limit
+}
+
+// Offset returns the optional offset (deprecated in favour of the cursor).
+func (q GetPostsMentioningUserQuery) Offset() *int {
+	return q.offset
+}
+
+// Cursor returns the position to continue from, zero for the first page.
+func (q GetPostsMentioningUserQuery) Cursor() valueobjects.Cursor {
+	return q.cursor
+}
@@ -0,0 +1,38 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// GetUnpublishedPostsQuery retrieves the drafts and scheduled posts of a community.
+// Community admins see every unpublished post; other members only see their own.
+type GetUnpublishedPostsQuery struct {
+	communityID valueobjects.CommunityID
+	requestedBy valueobjects.AuthorID
+}
+
+// NewGetUnpublishedPostsQuery validates input and creates the query.
+func NewGetUnpublishedPostsQuery(
+	communityID valueobjects.CommunityID,
+	requestedBy valueobjects.AuthorID,
+) (GetUnpublishedPostsQuery, error) {
+	if communityID.IsZero() {
+		return GetUnpublishedPostsQuery{}, errors.New("community ID is required")
+	}
+	if requestedBy.IsZero() {
+		return GetUnpublishedPostsQuery{}, errors.New("requesting user ID is required")
+	}
+	return GetUnpublishedPostsQuery{communityID: communityID, requestedBy: requestedBy}, nil
+}
+
+// CommunityID returns the community identifier.
+func (q GetUnpublishedPostsQuery) CommunityID() valueobjects.CommunityID {
+	return q.communityID
+}
+
+// RequestedBy returns the identifier of the user performing the query.
+func (q GetUnpublishedPostsQuery) RequestedBy() valueobjects.AuthorID {
+	return q.requestedBy
+}
@@ -0,0 +1,53 @@
+package valueobjects
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// MaxHashtagLength is the maximum number of characters of a hashtag, without the leading '#'.
+const MaxHashtagLength = 50
+
+var hashtagRegex = regexp.MustCompile(`^[\p{L}\p{N}_]*[\p{L}_][\p{L}\p{N}_]*$`)
+
+// Hashtag represents a topic tag written as #tag inside post content.
+// Hashtags are case-insensitive and stored in lower case without the leading '#'.
+type Hashtag struct {
+	value string `bson:"hashtag"`
+}
+
+// NewHashtag validates and normalizes a hashtag; a leading '#' is accepted.
+func NewHashtag(value string) (Hashtag, error) {
+	normalized := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(value), "#"))
+	if normalized == "" {
+		return Hashtag{}, errors.New("hashtag cannot be empty")
+	}
+	if len([]rune(normalized)) > MaxHashtagLength {
+		return Hashtag{}, errors.New("hashtag exceeds maximum length of 50 characters")
+	}
+	if !hashtagRegex.MatchString(normalized) {
+		return Hashtag{}, errors.New("hashtag can contain letters, numbers and underscores, and needs at least one letter")
+	}
+	return Hashtag{value: normalized}, nil
+}
+
+// Value returns the normalized hashtag.
+func (h Hashtag) Value() string {
+	return h.value
+}
+
+// String returns the string representation of the hashtag.
+func (h Hashtag) String() string {
+	return h.value
+}
+
+// IsZero indicates if the hashtag is empty.
+func (h Hashtag) IsZero() bool {
+	return h.value == ""
+}
+
+// Equals compares two hashtags.
+func (h Hashtag) Equals(other Hashtag) bool {
+	return h.value == other.value
+}
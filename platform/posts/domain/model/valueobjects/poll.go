@@ -0,0 +1,106 @@
+package valueobjects
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Poll option limits.
+const (
+	MinPollOptions = 2
+	MaxPollOptions = 10
+)
+
+// Poll represents a poll attached to a post.
+// The post content acts as the question; options cannot change once the poll is created.
+type Poll struct {
+	options        []PollOption `bson:"options"`
+	multipleChoice bool         `bson:"multiple_choice"`
+	anonymous      bool         `bson:"anonymous"`
+	closesAt       time.Time    `bson:"closes_at"`
+}
+
+// NewPoll validates the options and creates a poll.
+// A zero closesAt leaves the poll open indefinitely.
+func NewPoll(optionTexts []string, multipleChoice, anonymous bool, closesAt time.Time) (Poll, error) {
+	if len(optionTexts) < MinPollOptions {
+		return Poll{}, errors.New("a poll needs at least 2 options")
+	}
+	if len(optionTexts) > MaxPollOptions {
+		return Poll{}, errors.New("a poll cannot have more than 10 options")
+	}
+	if !closesAt.IsZero() && !closesAt.After(time.Now()) {
+		return Poll{}, errors.New("poll closing time has to be in the future")
+	}
+
+	seen := make(map[string]struct{}, len(optionTexts))
+	options := make([]PollOption, 0, len(optionTexts))
+	for _, text := range optionTexts {
+		option, err := NewPollOption(text)
+		if err != nil {
+			return Poll{}, err
+		}
+		key := strings.ToLower(option.Text())
+		if _, exists := seen[key]; exists {
+			return Poll{}, errors.New("poll options cannot be repeated")
+		}
+		seen[key] = struct{}{}
+		options = append(options, option)
+	}
+
+	return Poll{
+		options:        options,
+		multipleChoice: multipleChoice,
+		anonymous:      anonymous,
+		closesAt:       closesAt,
+	}, nil
+}
+
+// ReconstructPoll rebuilds a poll from persistence.
+func ReconstructPoll(options []PollOption, multipleChoice, anonymous bool, closesAt time.Time) Poll {
+	return Poll{
+		options:        slices.Clone(options),
+		multipleChoice: multipleChoice,
+		anonymous:      anonymous,
+		closesAt:       closesAt,
+	}
+}
+
+// Options returns the poll options in display order.
+func (p Poll) Options() []PollOption {
+	return slices.Clone(p.options)
+}
+
+// MultipleChoice indicates whether a voter can pick more than one option.
+func (p Poll) MultipleChoice() bool {
+	return p.multipleChoice
+}
+
+// Anonymous indicates whether voters are hidden from the results.
+func (p Poll) Anonymous() bool {
+	return p.anonymous
+}
+
+// ClosesAt returns when the poll stops accepting votes, or the zero time if it never closes.
+func (p Poll) ClosesAt() time.Time {
+	return p.closesAt
+}
+
+// IsClosed indicates whether the poll no longer accepts votes at the given time.
+func (p Poll) IsClosed(now time.Time) bool {
+	return !p.closesAt.IsZero() && !now.Before(p.closesAt)
+}
+
+// HasOption indicates whether the option identifier belongs to the poll.
+func (p Poll) HasOption(optionID string) bool {
+	return slices.ContainsFunc(p.options, func(option PollOption) bool {
+		return option.ID() == optionID
+	})
+}
+
+// IsZero indicates whether there is no poll.
+func (p Poll) IsZero() bool {
+	return len(p.options) == 0
+}
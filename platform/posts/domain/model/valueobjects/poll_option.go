@@ -0,0 +1,45 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MaxPollOptionLength is the maximum number of characters of a poll option.
+const MaxPollOptionLength = 100
+
+// PollOption represents one of the answers of a poll.
+type PollOption struct {
+	id   string `bson:"option_id"`
+	text string `bson:"text"`
+}
+
+// NewPollOption validates the text and creates an option with a fresh identifier.
+func NewPollOption(text string) (PollOption, error) {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return PollOption{}, errors.New("poll option cannot be empty")
+	}
+	if utf8.RuneCountInString(trimmed) > MaxPollOptionLength {
+		return PollOption{}, errors.New("poll option exceeds maximum length of 100 characters")
+	}
+	return PollOption{id: primitive.NewObjectID().Hex(), text: trimmed}, nil
+}
+
+// ReconstructPollOption rebuilds an option from persistence.
+func ReconstructPollOption(id, text string) PollOption {
+	return PollOption{id: id, text: text}
+}
+
+// ID returns the option identifier.
+func (o PollOption) ID() string {
+	return o.id
+}
+
+// Text returns the option text.
+func (o PollOption) Text() string {
+	return o.text
+}
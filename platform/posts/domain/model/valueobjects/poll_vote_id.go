@@ -0,0 +1,48 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PollVoteID represents the identifier for a vote on a poll.
+type PollVoteID struct {
+	value string `bson:"vote_id"`
+}
+
+// NewPollVoteID creates a new PollVoteID from an existing value.
+func NewPollVoteID(value string) (PollVoteID, error) {
+	if value == "" {
+		return PollVoteID{}, errors.New("poll vote ID cannot be empty")
+	}
+	if !primitive.IsValidObjectID(value) {
+		return PollVoteID{}, errors.New("poll vote ID must be a valid ObjectID")
+	}
+	return PollVoteID{value: value}, nil
+}
+
+// GeneratePollVoteID generates a new PollVoteID.
+func GeneratePollVoteID() PollVoteID {
+	return PollVoteID{value: primitive.NewObjectID().Hex()}
+}
+
+// Value returns the string value of the identifier.
+func (r PollVoteID) Value() string {
+	return r.value
+}
+
+// String returns the string form of the identifier.
+func (r PollVoteID) String() string {
+	return r.value
+}
+
+// IsZero indicates if the identifier is empty.
+func (r PollVoteID) IsZero() bool {
+	return r.value == ""
+}
+
+// Equals compares two identifiers.
+func (r PollVoteID) Equals(other PollVoteID) bool {
+	return r.value == other.value
+}
@@ -2,9 +2,17 @@ package valueobjects
 
 import (
 	"errors"
+	"regexp"
 	"strings"
 )
 
+// Mentions and hashtags must not be glued to a preceding word, so e-mail addresses,
+// URL fragments and markdown headings ("# Title") are not picked up.
+var (
+	mentionRegex          = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@#/])@([A-Za-z0-9_]{3,30})\b`)
+	hashtagInContentRegex = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@#/&])#([\p{L}\p{N}_]+)`)
+)
+
 // PostContent represents the markdown content of a post.
 type PostContent struct {
 	value string `json:"value" bson:"content"`
@@ -30,3 +38,36 @@ func (c PostContent) Value() string {
 func (c PostContent) IsZero() bool {
 	return c.value == ""
 }
+
+// MentionedUsernames returns the distinct usernames mentioned as @username, in order of appearance.
+func (c PostContent) MentionedUsernames() []string {
+	seen := make(map[string]struct{})
+	usernames := []string{}
+	for _, match := range mentionRegex.FindAllStringSubmatch(c.value, -1) {
+		key := strings.ToLower(match[1])
+		if _, exists := seen[key]; exists {
+			continue
+		}
+		seen[key] = struct{}{}
+		usernames = append(usernames, match[1])
+	}
+	return usernames
+}
+
+// Hashtags returns the distinct valid hashtags written as #tag, in order of appearance.
+func (c PostContent) Hashtags() []Hashtag {
+	seen := make(map[string]struct{})
+	hashtags := []Hashtag{}
+	for _, match := range hashtagInContentRegex.FindAllStringSubmatch(c.value, -1) {
+		hashtag, err := NewHashtag(match[1])
+		if err != nil {
+			continue
+		}
+		if _, exists := seen[hashtag.Value()]; exists {
+			continue
+		}
+		seen[hashtag.Value()] = struct{}{}
+		hashtags = append(hashtags, hashtag)
+	}
+	return hashtags
+}
@@ -0,0 +1,81 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+// Post lifecycle statuses.
+const (
+	DraftPostStatus     = "draft"
+	ScheduledPostStatus = "scheduled"
+	PublishedPostStatus = "published"
+)
+
+var validPostStatuses = map[string]bool{
+	DraftPostStatus:     true,
+	ScheduledPostStatus: true,
+	PublishedPostStatus: true,
+}
+
+// PostStatus represents where a post is in its lifecycle (draft, scheduled or published).
+type PostStatus struct {
+	value string `bson:"status"`
+}
+
+// NewPostStatus validates and creates a PostStatus.
+func NewPostStatus(value string) (PostStatus, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	if normalized == "" {
+		return PostStatus{}, errors.New("post status cannot be empty")
+	}
+	if !validPostStatuses[normalized] {
+		return PostStatus{}, errors.New("post status must be draft, scheduled or published")
+	}
+	return PostStatus{value: normalized}, nil
+}
+
+// DraftStatus returns the status of a post still being written.
+func DraftStatus() PostStatus {
+	return PostStatus{value: DraftPostStatus}
+}
+
+// ScheduledStatus returns the status of a post waiting for its publish-at time.
+func ScheduledStatus() PostStatus {
+	return PostStatus{value: ScheduledPostStatus}
+}
+
+// PublishedStatus returns the status of a post visible to the community.
+func PublishedStatus() PostStatus {
+	return PostStatus{value: PublishedPostStatus}
+}
+
+// Value returns the string value of the status.
+func (s PostStatus) Value() string {
+	return s.value
+}
+
+// String returns the string representation of the status.
+func (s PostStatus) String() string {
+	return s.value
+}
+
+// IsZero indicates if the status is unset.
+func (s PostStatus) IsZero() bool {
+	return s.value == ""
+}
+
+// IsDraft indicates if the post is a draft.
+func (s PostStatus) IsDraft() bool {
+	return s.value == DraftPostStatus
+}
+
+// IsScheduled indicates if the post waits for its publish-at time.
+func (s PostStatus) IsScheduled() bool {
+	return s.value == ScheduledPostStatus
+}
+
+// IsPublished indicates if the post is visible to the community.
+func (s PostStatus) IsPublished() bool {
+	return s.value == PublishedPostStatus
+}
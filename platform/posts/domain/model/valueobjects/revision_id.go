@@ -0,0 +1,48 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RevisionID represents the identifier for a post revision.
+type RevisionID struct {
+	value string `bson:"revision_id"`
+}
+
+// NewRevisionID creates a new RevisionID from an existing value.
+func NewRevisionID(value string) (RevisionID, error) {
+	if value == "" {
+		return RevisionID{}, errors.New("revision ID cannot be empty")
+	}
+	if !primitive.IsValidObjectID(value) {
+		return RevisionID{}, errors.New("revision ID must be a valid ObjectID")
+	}
+	return RevisionID{value: value}, nil
+}
+
+// GenerateRevisionID generates a new RevisionID.
+func GenerateRevisionID() RevisionID {
+	return RevisionID{value: primitive.NewObjectID().Hex()}
+}
+
+// Value returns the string value of the identifier.
+func (r RevisionID) Value() string {
+	return r.value
+}
+
+// String returns the string form of the identifier.
+func (r RevisionID) String() string {
+	return r.value
+}
+
+// IsZero indicates if the identifier is empty.
+func (r RevisionID) IsZero() bool {
+	return r.value == ""
+}
+
+// Equals compares two identifiers.
+func (r RevisionID) Equals(other RevisionID) bool {
+	return r.value == other.value
+}
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"Gommunity/platform/posts/domain/model/events"
+)
+
+// OutboxRepository records post events to be published to other services.
+// Used inside a unit of work, an event is only recorded when the post change commits.
+type OutboxRepository interface {
+	SavePostPublished(ctx context.Context, event events.PostPublishedEvent) error
+	SavePostDeleted(ctx context.Context, event events.PostDeletedEvent) error
+}
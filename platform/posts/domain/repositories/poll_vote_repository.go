@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"context"
+
+	"Gommunity/platform/posts/domain/model/entities"
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// PollVoteRepository defines persistence operations for poll votes.
+type PollVoteRepository interface {
+	// Save stores a vote; a second vote from the same user on the same post is rejected
+	Save(ctx context.Context, vote *entities.PollVote) error
+	FindByPostAndVoter(ctx context.Context, postID valueobjects.PostID, voterID valueobjects.AuthorID) (*entities.PollVote, error)
+
+	// CountVoters returns how many users voted in the poll of a post
+	CountVoters(ctx context.Context, postID valueobjects.PostID) (int, error)
+
+	// CountByOption returns the number of votes per option ID for the poll of a post
+	CountByOption(ctx context.Context, postID valueobjects.PostID) (map[string]int, error)
+
+	// FindVoterIDsByOption returns the IDs of the users who chose each option, oldest vote first
+	FindVoterIDsByOption(ctx context.Context, postID valueobjects.PostID) (map[string][]string, error)
+
+	// DeleteByPostID removes the votes of a post's poll
+	DeleteByPostID(ctx context.Context, postID valueobjects.PostID) error
+
+	// DeleteByCommunity removes all poll votes for a community
+	DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error
+
+	// ReassignVoter moves every vote of a user to another user ID
+	ReassignVoter(ctx context.Context, from, to valueobjects.AuthorID) error
+
+	// DeleteByVoter removes every vote of a user
+	DeleteByVoter(ctx context.Context, voterID valueobjects.AuthorID) error
+}
@@ -2,22 +2,74 @@ package repositories
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"Gommunity/platform/posts/domain/model/entities"
 	"Gommunity/platform/posts/domain/model/valueobjects"
 )
 
+// ErrPinOrderTaken is returned by Pin when another post of the community holds the requested position
+var ErrPinOrderTaken = errors.New("pin order already taken")
+
 // PostRepository defines persistence operations for post aggregates.
 type PostRepository interface {
 	Save(ctx context.Context, post *entities.Post) error
+	Update(ctx context.Context, post *entities.Post) error
+
+	// UpdateStatus writes the status of the post only if the stored post still has fromStatus and fromPublishAt.
+	// It returns false when the post was changed in the meantime, leaving it untouched.
+	UpdateStatus(ctx context.Context, post *entities.Post, fromStatus valueobjects.PostStatus, fromPublishAt time.Time) (bool, error)
+
 	FindByID(ctx context.Context, postID valueobjects.PostID) (*entities.Post, error)
 	FindByCommunity(ctx context.Context, communityID valueobjects.CommunityID, limit, offset *int) ([]*entities.Post, error)
-	FindByCommunities(ctx context.Context, communityIDs []valueobjects.CommunityID, limit, offset *int) ([]*entities.Post, error)
+
+	// FindUnpinnedByCommunity returns the published, unpinned posts of a community, newest first, starting right after the cursor
+	FindUnpinnedByCommunity(ctx context.Context, communityID valueobjects.CommunityID, limit *int, cursor valueobjects.Cursor) ([]*entities.Post, error)
+
+	// FindByCommunities, FindByCommunitiesAndType, FindByCommunityAndHashtag and FindMentioning list posts newest first.
+	// They continue right after the cursor when it is set, otherwise they skip offset posts (deprecated).
+	FindByCommunities(ctx context.Context, communityIDs []valueobjects.CommunityID, limit, offset *int, cursor valueobjects.Cursor) ([]*entities.Post, error)
+	FindByCommunitiesAndType(ctx context.Context, communityIDs []valueobjects.CommunityID, postType valueobjects.PostType, limit, offset *int, cursor valueobjects.Cursor) ([]*entities.Post, error)
 	Delete(ctx context.Context, postID valueobjects.PostID) error
 
+	// FindByCommunityAndHashtag returns the published posts of a community tagged with the hashtag
+	FindByCommunityAndHashtag(ctx context.Context, communityID valueobjects.CommunityID, hashtag valueobjects.Hashtag, limit, offset *int, cursor valueobjects.Cursor) ([]*entities.Post, error)
+
+	// FindMentioning returns the published posts that mention the user, across communities
+	FindMentioning(ctx context.Context, userID valueobjects.AuthorID, limit, offset *int, cursor valueobjects.Cursor) ([]*entities.Post, error)
+
+	// Search runs a full-text search on published post content, most relevant first, within the given communities
+	Search(ctx context.Context, text string, communityIDs []valueobjects.CommunityID, limit, offset int) ([]*entities.Post, error)
+
+	// FindUnpublishedByCommunity returns the drafts and scheduled posts of a community, optionally for a single author
+	FindUnpublishedByCommunity(ctx context.Context, communityID valueobjects.CommunityID, authorID *valueobjects.AuthorID) ([]*entities.Post, error)
+
+	// FindDueScheduled returns scheduled posts whose publish-at time has been reached
+	FindDueScheduled(ctx context.Context, now time.Time, limit int) ([]*entities.Post, error)
+
+	// FindPinnedByCommunity returns the pinned posts of a community in pin order
+	FindPinnedByCommunity(ctx context.Context, communityID valueobjects.CommunityID) ([]*entities.Post, error)
+
+	// Pin pins a post at the given position. It fails with ErrPinOrderTaken when another post
+	// of the community holds that position, and with "post is already pinned" when the post is pinned.
+	Pin(ctx context.Context, postID valueobjects.PostID, order int) error
+
+	// Unpin removes a post from the pinned posts; it fails with "post is not pinned" when the post is not pinned
+	Unpin(ctx context.Context, postID valueobjects.PostID) error
+
+	// CompactPinOrder closes the gap left by an unpinned post by shifting later pins up
+	CompactPinOrder(ctx context.Context, communityID valueobjects.CommunityID, removedOrder int) error
+
 	// FindPostIDsByCommunity returns only post IDs for a community (for cascade deletion)
 	FindPostIDsByCommunity(ctx context.Context, communityID valueobjects.CommunityID) ([]valueobjects.PostID, error)
 
 	// DeleteByCommunity removes all posts for a community
 	DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error
+
+	// FindByAuthor returns every post written by the author, in any status and community
+	FindByAuthor(ctx context.Context, authorID valueobjects.AuthorID) ([]*entities.Post, error)
+
+	// ReassignAuthor moves every post of an author to another author ID
+	ReassignAuthor(ctx context.Context, from, to valueobjects.AuthorID) error
 }
@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+
+	"Gommunity/platform/posts/domain/model/entities"
+	"Gommunity/platform/posts/domain/model/valueobjects"
+)
+
+// PostRevisionRepository defines persistence operations for post revisions.
+type PostRevisionRepository interface {
+	Save(ctx context.Context, revision *entities.PostRevision) error
+	FindByID(ctx context.Context, revisionID valueobjects.RevisionID) (*entities.PostRevision, error)
+	FindByPostID(ctx context.Context, postID valueobjects.PostID) ([]*entities.PostRevision, error)
+
+	// DeleteByPostID removes the revision history of a post
+	DeleteByPostID(ctx context.Context, postID valueobjects.PostID) error
+
+	// DeleteByCommunity removes all revisions for a community
+	DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error
+
+	// ReassignEditor moves every revision edited by a user to another user ID
+	ReassignEditor(ctx context.Context, from, to valueobjects.AuthorID) error
+}
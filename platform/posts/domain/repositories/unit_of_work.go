@@ -0,0 +1,9 @@
+package repositories
+
+import "context"
+
+// UnitOfWork runs fn so that every repository call made with the context it receives
+// is committed together or not at all.
+type UnitOfWork interface {
+	Execute(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -10,5 +10,22 @@ import (
 // PostCommandService defines command handling behavior for posts.
 type PostCommandService interface {
 	HandlePublish(ctx context.Context, cmd commands.CreatePostCommand) (*valueobjects.PostID, error)
+	HandleUpdate(ctx context.Context, cmd commands.UpdatePostCommand) error
+	HandleRestoreRevision(ctx context.Context, cmd commands.RestorePostRevisionCommand) error
 	HandleDelete(ctx context.Context, cmd commands.DeletePostCommand) error
+	HandlePin(ctx context.Context, cmd commands.PinPostCommand) error
+	HandleUnpin(ctx context.Context, cmd commands.UnpinPostCommand) error
+	HandleChangeStatus(ctx context.Context, cmd commands.ChangePostStatusCommand) error
+	HandleVote(ctx context.Context, cmd commands.VoteOnPollCommand) error
+
+	// HandlePublishDue publishes every scheduled post whose publish-at time has been reached
+	// and returns how many posts were published.
+	HandlePublishDue(ctx context.Context) (int, error)
+
+	// HandleAnonymizeAuthor moves the posts, revisions and poll votes of a removed user to an anonymous author ID.
+	HandleAnonymizeAuthor(ctx context.Context, authorID, anonymousID valueobjects.AuthorID) error
+
+	// HandleDeleteByAuthor deletes every post of a removed user with its revisions, comments, reactions
+	// and poll votes, together with the user's votes in other polls.
+	HandleDeleteByAuthor(ctx context.Context, authorID valueobjects.AuthorID) error
 }
@@ -5,10 +5,32 @@ import (
 
 	"Gommunity/platform/posts/domain/model/entities"
 	"Gommunity/platform/posts/domain/model/queries"
+	"Gommunity/platform/posts/domain/model/valueobjects"
 )
 
+// PollResults represents the poll of a post as seen by a user.
+// Counts and voters are only filled once the user has voted or the poll has closed.
+type PollResults struct {
+	Poll           valueobjects.Poll
+	Closed         bool
+	HasVoted       bool
+	UserOptionIDs  []string
+	ResultsVisible bool
+	TotalVoters    int
+	Counts         map[string]int      // key: option ID, value: number of votes
+	Voters         map[string][]string // key: option ID, value: voter IDs (never set for anonymous polls)
+}
+
 // PostQueryService defines query handling behavior for posts.
 type PostQueryService interface {
 	HandleGetByID(ctx context.Context, query queries.GetPostByIDQuery) (*entities.Post, error)
-	HandleGetByCommunity(ctx context.Context, query queries.GetPostsByCommunityQuery) ([]*entities.Post, error)
+	// HandleGetByCommunity, HandleGetByHashtag and HandleGetMentioning return one page of posts and the cursor
+	// for the next page (nil on the last page).
+	HandleGetByCommunity(ctx context.Context, query queries.GetPostsByCommunityQuery) ([]*entities.Post, *valueobjects.Cursor, error)
+	HandleGetPinned(ctx context.Context, query queries.GetPinnedPostsQuery) ([]*entities.Post, error)
+	HandleGetByHashtag(ctx context.Context, query queries.GetPostsByHashtagQuery) ([]*entities.Post, *valueobjects.Cursor, error)
+	HandleGetMentioning(ctx context.Context, query queries.GetPostsMentioningUserQuery) ([]*entities.Post, *valueobjects.Cursor, error)
+	HandleGetUnpublished(ctx context.Context, query queries.GetUnpublishedPostsQuery) ([]*entities.Post, error)
+	HandleGetPollResults(ctx context.Context, query queries.GetPollResultsQuery) (*PollResults, error)
+	HandleGetRevisions(ctx context.Context, query queries.GetPostRevisionsQuery) ([]*entities.PostRevision, error)
 }
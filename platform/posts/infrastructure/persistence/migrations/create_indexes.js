@@ -0,0 +1,51 @@
+// MongoDB indexes for posts and poll_votes collections
+// Run this in MongoDB shell or using mongosh
+
+db.posts.createIndex(
+  { content: "text" },
+  { 
+    name: "idx_post_text",
+    background: true
+  }
+);
+
+db.posts.createIndex(
+  { community_id: 1, created_at: -1, post_id: -1 },
+  { 
+    name: "idx_community_posts_keyset",
+    background: true
+  }
+);
+
+db.posts.createIndex(
+  { community_id: 1, hashtags: 1, created_at: -1, post_id: -1 },
+  { 
+    name: "idx_community_hashtag_posts",
+    background: true
+  }
+);
+
+db.posts.createIndex(
+  { mentions: 1, created_at: -1, post_id: -1 },
+  { 
+    name: "idx_mentioned_user_posts",
+    background: true
+  }
+);
+
+db.poll_votes.createIndex(
+  { post_id: 1, voter_id: 1 },
+  { 
+    unique: true,
+    name: "idx_unique_post_voter_poll_vote",
+    background: true
+  }
+);
+
+db.poll_votes.createIndex(
+  { community_id: 1 },
+  { 
+    name: "idx_poll_votes_community",
+    background: true
+  }
+);
@@ -0,0 +1,77 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"Gommunity/platform/posts/domain/model/events"
+	domain_repositories "Gommunity/platform/posts/domain/repositories"
+	"Gommunity/shared/infrastructure/messaging/outbox"
+)
+
+// Kafka topics the post events are published to.
+const (
+	TopicPostPublished = "community.post.published"
+	TopicPostDeleted   = "community.post.deleted"
+)
+
+const postAggregateType = "post"
+
+type outboxRepositoryImpl struct {
+	store *outbox.Store
+}
+
+// NewOutboxRepository creates an OutboxRepository writing to the shared outbox.
+func NewOutboxRepository(store *outbox.Store) domain_repositories.OutboxRepository {
+	return &outboxRepositoryImpl{
+		store: store,
+	}
+}
+
+type postPublishedPayload struct {
+	PostID      string    `json:"post_id"`
+	CommunityID string    `json:"community_id"`
+	AuthorID    string    `json:"author_id"`
+	PostType    string    `json:"post_type"`
+	OccurredOn  time.Time `json:"occurred_on"`
+}
+
+type postDeletedPayload struct {
+	PostID      string    `json:"post_id"`
+	CommunityID string    `json:"community_id"`
+	DeletedBy   string    `json:"deleted_by"`
+	OccurredOn  time.Time `json:"occurred_on"`
+}
+
+// SavePostPublished records a PostPublished event keyed by post ID.
+func (r *outboxRepositoryImpl) SavePostPublished(ctx context.Context, event events.PostPublishedEvent) error {
+	payload := postPublishedPayload{
+		PostID:      event.PostID().Value(),
+		CommunityID: event.CommunityID().Value(),
+		AuthorID:    event.AuthorID().Value(),
+		PostType:    event.PostType().Value(),
+		OccurredOn:  event.OccurredOn().UTC(),
+	}
+
+	message, err := outbox.NewMessage(TopicPostPublished, "PostPublished", postAggregateType, payload.PostID, payload, event.OccurredOn())
+	if err != nil {
+		return err
+	}
+	return r.store.Append(ctx, message)
+}
+
+// SavePostDeleted records a PostDeleted event keyed by post ID.
+func (r *outboxRepositoryImpl) SavePostDeleted(ctx context.Context, event events.PostDeletedEvent) error {
+	payload := postDeletedPayload{
+		PostID:      event.PostID().Value(),
+		CommunityID: event.CommunityID().Value(),
+		DeletedBy:   event.Requester().Value(),
+		OccurredOn:  event.OccurredOn().UTC(),
+	}
+
+	message, err := outbox.NewMessage(TopicPostDeleted, "PostDeleted", postAggregateType, payload.PostID, payload, event.OccurredOn())
+	if err != nil {
+		return err
+	}
+	return r.store.Append(ctx, message)
+}
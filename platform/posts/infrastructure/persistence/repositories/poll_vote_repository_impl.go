@@ -0,0 +1,231 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"Gommunity/platform/posts/domain/model/entities"
+	"Gommunity/platform/posts/domain/model/valueobjects"
+	domain_repositories "Gommunity/platform/posts/domain/repositories"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type pollVoteRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+// NewPollVoteRepository creates a MongoDB-backed PollVoteRepository.
+// One vote per user and post relies on the unique (post_id, voter_id) index.
+func NewPollVoteRepository(collection *mongo.Collection) domain_repositories.PollVoteRepository {
+	return &pollVoteRepositoryImpl{
+		collection: collection,
+	}
+}
+
+type pollVoteDocument struct {
+	ID          string   `bson:"_id"`
+	VoteID      string   `bson:"vote_id"`
+	PostID      string   `bson:"post_id"`
+	CommunityID string   `bson:"community_id"`
+	VoterID     string   `bson:"voter_id"`
+	OptionIDs   []string `bson:"option_ids"`
+	CreatedAt   int64    `bson:"created_at"`
+}
+
+// Save inserts a new vote document.
+func (r *pollVoteRepositoryImpl) Save(ctx context.Context, vote *entities.PollVote) error {
+	doc := r.entityToDocument(vote)
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errors.New("user has already voted in this poll")
+		}
+		log.Printf("failed to insert poll vote: %v", err)
+		return err
+	}
+	return nil
+}
+
+// FindByPostAndVoter retrieves the vote of a user on the poll of a post.
+func (r *pollVoteRepositoryImpl) FindByPostAndVoter(ctx context.Context, postID valueobjects.PostID, voterID valueobjects.AuthorID) (*entities.PollVote, error) {
+	filter := bson.M{"post_id": postID.Value(), "voter_id": voterID.Value()}
+
+	var doc pollVoteDocument
+	if err := r.collection.FindOne(ctx, filter).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		log.Printf("failed to find poll vote: %v", err)
+		return nil, err
+	}
+	return r.documentToEntity(&doc)
+}
+
+// CountVoters returns how many users voted in the poll of a post.
+func (r *pollVoteRepositoryImpl) CountVoters(ctx context.Context, postID valueobjects.PostID) (int, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"post_id": postID.Value()})
+	if err != nil {
+		log.Printf("failed to count poll voters: %v", err)
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// CountByOption returns vote counts grouped by option for the poll of a post.
+func (r *pollVoteRepositoryImpl) CountByOption(ctx context.Context, postID valueobjects.PostID) (map[string]int, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "post_id", Value: postID.Value()}}}},
+		{{Key: "$unwind", Value: "$option_ids"}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$option_ids"},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		log.Printf("failed to count poll votes by option: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	counts := make(map[string]int)
+	for cursor.Next(ctx) {
+		var result struct {
+			ID    string `bson:"_id"`
+			Count int    `bson:"count"`
+		}
+		if err := cursor.Decode(&result); err != nil {
+			return nil, err
+		}
+		counts[result.ID] = result.Count
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// FindVoterIDsByOption returns the voter IDs grouped by option for the poll of a post, oldest vote first.
+func (r *pollVoteRepositoryImpl) FindVoterIDsByOption(ctx context.Context, postID valueobjects.PostID) (map[string][]string, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "post_id", Value: postID.Value()}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$unwind", Value: "$option_ids"}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$option_ids"},
+			{Key: "voter_ids", Value: bson.D{{Key: "$push", Value: "$voter_id"}}},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		log.Printf("failed to find poll voters by option: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	voters := make(map[string][]string)
+	for cursor.Next(ctx) {
+		var result struct {
+			ID       string   `bson:"_id"`
+			VoterIDs []string `bson:"voter_ids"`
+		}
+		if err := cursor.Decode(&result); err != nil {
+			return nil, err
+		}
+		voters[result.ID] = result.VoterIDs
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return voters, nil
+}
+
+// DeleteByPostID removes the votes of a post's poll
+func (r *pollVoteRepositoryImpl) DeleteByPostID(ctx context.Context, postID valueobjects.PostID) error {
+	filter := bson.M{"post_id": postID.Value()}
+	if _, err := r.collection.DeleteMany(ctx, filter); err != nil {
+		log.Printf("failed to delete poll votes: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteByCommunity removes all poll votes for a community
+func (r *pollVoteRepositoryImpl) DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error {
+	filter := bson.M{"community_id": communityID.Value()}
+	if _, err := r.collection.DeleteMany(ctx, filter); err != nil {
+		log.Printf("failed to delete poll votes by community: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ReassignVoter moves every vote of a user to another user ID
+func (r *pollVoteRepositoryImpl) ReassignVoter(ctx context.Context, from, to valueobjects.AuthorID) error {
+	filter := bson.M{"voter_id": from.Value()}
+	update := bson.M{"$set": bson.M{"voter_id": to.Value()}}
+	if _, err := r.collection.UpdateMany(ctx, filter, update); err != nil {
+		log.Printf("failed to reassign poll voter: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteByVoter removes every vote of a user
+func (r *pollVoteRepositoryImpl) DeleteByVoter(ctx context.Context, voterID valueobjects.AuthorID) error {
+	filter := bson.M{"voter_id": voterID.Value()}
+	if _, err := r.collection.DeleteMany(ctx, filter); err != nil {
+		log.Printf("failed to delete poll votes by voter: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *pollVoteRepositoryImpl) entityToDocument(vote *entities.PollVote) *pollVoteDocument {
+	return &pollVoteDocument{
+		ID:          vote.VoteID().Value(),
+		VoteID:      vote.VoteID().Value(),
+		PostID:      vote.PostID().Value(),
+		CommunityID: vote.CommunityID().Value(),
+		VoterID:     vote.VoterID().Value(),
+		OptionIDs:   vote.OptionIDs(),
+		CreatedAt:   vote.CreatedAt().Unix(),
+	}
+}
+
+func (r *pollVoteRepositoryImpl) documentToEntity(doc *pollVoteDocument) (*entities.PollVote, error) {
+	voteID, err := valueobjects.NewPollVoteID(doc.VoteID)
+	if err != nil {
+		return nil, err
+	}
+	postID, err := valueobjects.NewPostID(doc.PostID)
+	if err != nil {
+		return nil, err
+	}
+	communityID, err := valueobjects.NewCommunityID(doc.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	voterID, err := valueobjects.NewAuthorID(doc.VoterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.ReconstructPollVote(
+		voteID,
+		postID,
+		communityID,
+		voterID,
+		doc.OptionIDs,
+		time.Unix(doc.CreatedAt, 0),
+	), nil
+}
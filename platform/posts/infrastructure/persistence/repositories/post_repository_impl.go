@@ -27,14 +27,34 @@ func NewPostRepository(collection *mongo.Collection) domain_repositories.PostRep
 }
 
 type postDocument struct {
-	ID          string   `bson:"_id"`
-	PostID      string   `bson:"post_id"`
-	CommunityID string   `bson:"community_id"`
-	AuthorID    string   `bson:"author_id"`
-	Content     string   `bson:"content"`
-	Images      []string `bson:"images"`
-	CreatedAt   int64    `bson:"created_at"`
-	UpdatedAt   int64    `bson:"updated_at"`
+	ID          string        `bson:"_id"`
+	PostID      string        `bson:"post_id"`
+	CommunityID string        `bson:"community_id"`
+	AuthorID    string        `bson:"author_id"`
+	PostType    string        `bson:"post_type"`
+	Content     string        `bson:"content"`
+	Images      []string      `bson:"images"`
+	Poll        *pollDocument `bson:"poll,omitempty"`
+	Hashtags    []string      `bson:"hashtags"`
+	Mentions    []string      `bson:"mentions"`
+	IsPinned    bool          `bson:"is_pinned"`
+	PinOrder    int           `bson:"pin_order"`
+	Status      string        `bson:"status"`
+	PublishAt   int64         `bson:"publish_at"`
+	CreatedAt   int64         `bson:"created_at"`
+	UpdatedAt   int64         `bson:"updated_at"`
+}
+
+type pollDocument struct {
+	Options        []pollOptionDocument `bson:"options"`
+	MultipleChoice bool                 `bson:"multiple_choice"`
+	Anonymous      bool                 `bson:"anonymous"`
+	ClosesAt       int64                `bson:"closes_at"`
+}
+
+type pollOptionDocument struct {
+	OptionID string `bson:"option_id"`
+	Text     string `bson:"text"`
 }
 
 // Save inserts a new post document.
@@ -50,6 +70,58 @@ func (r *postRepositoryImpl) Save(ctx context.Context, post *entities.Post) erro
 	return nil
 }
 
+// Update persists the edited content of an existing post.
+// Pinning and status are left out: they are only changed through Pin, Unpin,
+// CompactPinOrder and UpdateStatus.
+func (r *postRepositoryImpl) Update(ctx context.Context, post *entities.Post) error {
+	filter := bson.M{"post_id": post.PostID().Value()}
+	update := bson.M{
+		"$set": bson.M{
+			"content":    post.Content().Value(),
+			"images":     post.Images().URLs(),
+			"hashtags":   hashtagsToStrings(post.Hashtags()),
+			"mentions":   authorIDsToStrings(post.Mentions()),
+			"updated_at": post.UpdatedAt().Unix(),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		log.Printf("failed to update post: %v", err)
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return errors.New("post not found")
+	}
+	return nil
+}
+
+// UpdateStatus persists the status of a post, but only while the stored post is still in the
+// given status and publish-at time. It reports whether the post matched, so a post published or
+// rescheduled by someone else in the meantime is left alone.
+func (r *postRepositoryImpl) UpdateStatus(ctx context.Context, post *entities.Post, fromStatus valueobjects.PostStatus, fromPublishAt time.Time) (bool, error) {
+	filter := bson.M{
+		"post_id":    post.PostID().Value(),
+		"status":     fromStatus.Value(),
+		"publish_at": optionalTimeToUnix(fromPublishAt),
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status":     post.Status().Value(),
+			"publish_at": optionalTimeToUnix(post.PublishAt()),
+			"created_at": post.CreatedAt().Unix(),
+			"updated_at": post.UpdatedAt().Unix(),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		log.Printf("failed to update post status: %v", err)
+		return false, err
+	}
+	return result.MatchedCount == 1, nil
+}
+
 // FindByID retrieves a post by its identifier.
 func (r *postRepositoryImpl) FindByID(ctx context.Context, postID valueobjects.PostID) (*entities.Post, error) {
 	filter := bson.M{"post_id": postID.Value()}
@@ -65,10 +137,14 @@ func (r *postRepositoryImpl) FindByID(ctx context.Context, postID valueobjects.P
 	return r.documentToEntity(&doc)
 }
 
-// FindByCommunity retrieves posts belonging to a community.
+// FindByCommunity retrieves published posts belonging to a community, pinned posts first.
 func (r *postRepositoryImpl) FindByCommunity(ctx context.Context, communityID valueobjects.CommunityID, limit, offset *int) ([]*entities.Post, error) {
-	filter := bson.M{"community_id": communityID.Value()}
-	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	filter := publishedFilter(bson.M{"community_id": communityID.Value()})
+	findOptions := options.Find().SetSort(bson.D{
+		{Key: "is_pinned", Value: -1},
+		{Key: "pin_order", Value: 1},
+		{Key: "created_at", Value: -1},
+	})
 	if limit != nil {
 		findOptions.SetLimit(int64(*limit))
 	}
@@ -103,28 +179,111 @@ func (r *postRepositoryImpl) FindByCommunity(ctx context.Context, communityID va
 	return posts, nil
 }
 
-// FindByCommunities retrieves posts from multiple communities
-func (r *postRepositoryImpl) FindByCommunities(ctx context.Context, communityIDs []valueobjects.CommunityID, limit, offset *int) ([]*entities.Post, error) {
-	// Convert community IDs to strings
-	communityIDStrings := make([]string, len(communityIDs))
-	for i, id := range communityIDs {
-		communityIDStrings[i] = id.Value()
+// FindUnpinnedByCommunity retrieves published, unpinned posts of a community using keyset pagination.
+func (r *postRepositoryImpl) FindUnpinnedByCommunity(ctx context.Context, communityID valueobjects.CommunityID, limit *int, cursor valueobjects.Cursor) ([]*entities.Post, error) {
+	filter := publishedFilter(bson.M{"community_id": communityID.Value(), "is_pinned": bson.M{"$ne": true}})
+	return r.findPaginated(ctx, filter, limit, nil, cursor)
+}
+
+// FindByCommunities retrieves published posts from multiple communities
+func (r *postRepositoryImpl) FindByCommunities(ctx context.Context, communityIDs []valueobjects.CommunityID, limit, offset *int, cursor valueobjects.Cursor) ([]*entities.Post, error) {
+	filter := publishedFilter(bson.M{"community_id": bson.M{"$in": communityIDsToStrings(communityIDs)}})
+	return r.findPaginated(ctx, filter, limit, offset, cursor)
+}
+
+// FindByCommunitiesAndType retrieves published posts of a single type from multiple communities
+func (r *postRepositoryImpl) FindByCommunitiesAndType(ctx context.Context, communityIDs []valueobjects.CommunityID, postType valueobjects.PostType, limit, offset *int, cursor valueobjects.Cursor) ([]*entities.Post, error) {
+	filter := publishedFilter(bson.M{"community_id": bson.M{"$in": communityIDsToStrings(communityIDs)}})
+	if postType.IsMessage() {
+		// Posts created before post types were persisted have no post_type and are messages
+		filter["post_type"] = bson.M{"$in": bson.A{postType.Value(), nil}}
+	} else {
+		filter["post_type"] = postType.Value()
+	}
+	return r.findPaginated(ctx, filter, limit, offset, cursor)
+}
+
+// FindByCommunityAndHashtag retrieves published posts of a community tagged with the hashtag
+func (r *postRepositoryImpl) FindByCommunityAndHashtag(ctx context.Context, communityID valueobjects.CommunityID, hashtag valueobjects.Hashtag, limit, offset *int, cursor valueobjects.Cursor) ([]*entities.Post, error) {
+	filter := publishedFilter(bson.M{"community_id": communityID.Value(), "hashtags": hashtag.Value()})
+	return r.findPaginated(ctx, filter, limit, offset, cursor)
+}
+
+// FindMentioning retrieves published posts that mention the user
+func (r *postRepositoryImpl) FindMentioning(ctx context.Context, userID valueobjects.AuthorID, limit, offset *int, cursor valueobjects.Cursor) ([]*entities.Post, error) {
+	filter := publishedFilter(bson.M{"mentions": userID.Value()})
+	return r.findPaginated(ctx, filter, limit, offset, cursor)
+}
+
+// Search runs a full-text search on published post content, most relevant first.
+func (r *postRepositoryImpl) Search(ctx context.Context, text string, communityIDs []valueobjects.CommunityID, limit, offset int) ([]*entities.Post, error) {
+	if len(communityIDs) == 0 {
+		return []*entities.Post{}, nil
 	}
 
-	// Build filter
-	filter := bson.M{"community_id": bson.M{"$in": communityIDStrings}}
+	filter := publishedFilter(bson.M{
+		"$text":        bson.M{"$search": text},
+		"community_id": bson.M{"$in": communityIDsToStrings(communityIDs)},
+	})
+	findOptions := options.Find().
+		SetProjection(bson.M{"score": bson.M{"$meta": "textScore"}}).
+		SetSort(bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}, {Key: "created_at", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
 
-	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
-	if limit != nil {
-		findOptions.SetLimit(int64(*limit))
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		log.Printf("failed to search posts: %v", err)
+		return nil, err
 	}
-	if offset != nil {
-		findOptions.SetSkip(int64(*offset))
+	defer cursor.Close(ctx)
+
+	var posts []*entities.Post
+	for cursor.Next(ctx) {
+		var doc postDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		entity, err := r.documentToEntity(&doc)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, entity)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
 	}
 
+	return posts, nil
+}
+
+// FindUnpublishedByCommunity retrieves the drafts and scheduled posts of a community,
+// optionally restricted to a single author.
+func (r *postRepositoryImpl) FindUnpublishedByCommunity(ctx context.Context, communityID valueobjects.CommunityID, authorID *valueobjects.AuthorID) ([]*entities.Post, error) {
+	filter := bson.M{
+		"community_id": communityID.Value(),
+		"status":       bson.M{"$in": bson.A{valueobjects.DraftPostStatus, valueobjects.ScheduledPostStatus}},
+	}
+	if authorID != nil {
+		filter["author_id"] = authorID.Value()
+	}
+	return r.findPaginated(ctx, filter, nil, nil, valueobjects.Cursor{})
+}
+
+// FindDueScheduled retrieves scheduled posts whose publish-at time is not after now, oldest first.
+func (r *postRepositoryImpl) FindDueScheduled(ctx context.Context, now time.Time, limit int) ([]*entities.Post, error) {
+	filter := bson.M{
+		"status":     valueobjects.ScheduledPostStatus,
+		"publish_at": bson.M{"$lte": now.Unix()},
+	}
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "publish_at", Value: 1}}).
+		SetLimit(int64(limit))
+
 	cursor, err := r.collection.Find(ctx, filter, findOptions)
 	if err != nil {
-		log.Printf("failed to find posts by communities: %v", err)
+		log.Printf("failed to find due scheduled posts: %v", err)
 		return nil, err
 	}
 	defer cursor.Close(ctx)
@@ -163,6 +322,108 @@ func (r *postRepositoryImpl) Delete(ctx context.Context, postID valueobjects.Pos
 	return nil
 }
 
+// FindPinnedByCommunity retrieves the pinned posts of a community in pin order.
+func (r *postRepositoryImpl) FindPinnedByCommunity(ctx context.Context, communityID valueobjects.CommunityID) ([]*entities.Post, error) {
+	filter := bson.M{"community_id": communityID.Value(), "is_pinned": true}
+	findOptions := options.Find().SetSort(bson.D{{Key: "pin_order", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		log.Printf("failed to find pinned posts: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var posts []*entities.Post
+	for cursor.Next(ctx) {
+		var doc postDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		entity, err := r.documentToEntity(&doc)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, entity)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+// Pin pins a post at the given position. The unique (community_id, pin_order) index over pinned posts
+// rejects a position already held by another post.
+func (r *postRepositoryImpl) Pin(ctx context.Context, postID valueobjects.PostID, order int) error {
+	filter := bson.M{"post_id": postID.Value(), "is_pinned": bson.M{"$ne": true}}
+	update := bson.M{"$set": bson.M{"is_pinned": true, "pin_order": order}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return domain_repositories.ErrPinOrderTaken
+		}
+		log.Printf("failed to pin post: %v", err)
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return errors.New("post is already pinned")
+	}
+	return nil
+}
+
+// Unpin removes a post from the pinned posts of its community.
+func (r *postRepositoryImpl) Unpin(ctx context.Context, postID valueobjects.PostID) error {
+	filter := bson.M{"post_id": postID.Value(), "is_pinned": true}
+	update := bson.M{"$set": bson.M{"is_pinned": false, "pin_order": 0}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		log.Printf("failed to unpin post: %v", err)
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return errors.New("post is not pinned")
+	}
+	return nil
+}
+
+// CompactPinOrder shifts the pins after removedOrder up by one position.
+// Pins are moved one at a time from the lowest position so that no two posts
+// ever share a position, which the unique pin order index would reject.
+func (r *postRepositoryImpl) CompactPinOrder(ctx context.Context, communityID valueobjects.CommunityID, removedOrder int) error {
+	filter := bson.M{
+		"community_id": communityID.Value(),
+		"is_pinned":    true,
+		"pin_order":    bson.M{"$gt": removedOrder},
+	}
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "pin_order", Value: 1}}).
+		SetProjection(bson.M{"post_id": 1, "pin_order": 1})
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		log.Printf("failed to find pins to compact: %v", err)
+		return err
+	}
+	var docs []postDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return err
+	}
+
+	for _, doc := range docs {
+		pinFilter := bson.M{"post_id": doc.PostID, "is_pinned": true, "pin_order": doc.PinOrder}
+		update := bson.M{"$inc": bson.M{"pin_order": -1}}
+		if _, err := r.collection.UpdateOne(ctx, pinFilter, update); err != nil {
+			log.Printf("failed to compact pin order: %v", err)
+			return err
+		}
+	}
+	return nil
+}
+
 // FindPostIDsByCommunity returns post IDs for a community (lightweight)
 func (r *postRepositoryImpl) FindPostIDsByCommunity(ctx context.Context, communityID valueobjects.CommunityID) ([]valueobjects.PostID, error) {
 	filter := bson.M{"community_id": communityID.Value()}
@@ -206,14 +467,177 @@ func (r *postRepositoryImpl) DeleteByCommunity(ctx context.Context, communityID
 	return nil
 }
 
+// FindByAuthor returns every post written by the author, in any status and community
+func (r *postRepositoryImpl) FindByAuthor(ctx context.Context, authorID valueobjects.AuthorID) ([]*entities.Post, error) {
+	filter := bson.M{"author_id": authorID.Value()}
+
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		log.Printf("failed to find posts by author: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var posts []*entities.Post
+	for cursor.Next(ctx) {
+		var doc postDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		entity, err := r.documentToEntity(&doc)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, entity)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+// ReassignAuthor moves every post of an author to another author ID
+func (r *postRepositoryImpl) ReassignAuthor(ctx context.Context, from, to valueobjects.AuthorID) error {
+	filter := bson.M{"author_id": from.Value()}
+	update := bson.M{"$set": bson.M{"author_id": to.Value()}}
+	if _, err := r.collection.UpdateMany(ctx, filter, update); err != nil {
+		log.Printf("failed to reassign post author: %v", err)
+		return err
+	}
+	return nil
+}
+
+// findPaginated lists posts newest first. A set cursor takes precedence over the deprecated offset.
+func (r *postRepositoryImpl) findPaginated(ctx context.Context, filter bson.M, limit, offset *int, cursor valueobjects.Cursor) ([]*entities.Post, error) {
+	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "post_id", Value: -1}})
+	if limit != nil {
+		findOptions.SetLimit(int64(*limit))
+	}
+	if !cursor.IsZero() {
+		createdAt := cursor.CreatedAt().Unix()
+		filter["$or"] = bson.A{
+			bson.M{"created_at": bson.M{"$lt": createdAt}},
+			bson.M{"created_at": createdAt, "post_id": bson.M{"$lt": cursor.PostID()}},
+		}
+	} else if offset != nil {
+		findOptions.SetSkip(int64(*offset))
+	}
+
+	cursorResult, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		log.Printf("failed to find posts by communities: %v", err)
+		return nil, err
+	}
+	defer cursorResult.Close(ctx)
+
+	var posts []*entities.Post
+	for cursorResult.Next(ctx) {
+		var doc postDocument
+		if err := cursorResult.Decode(&doc); err != nil {
+			return nil, err
+		}
+		entity, err := r.documentToEntity(&doc)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, entity)
+	}
+
+	if err := cursorResult.Err(); err != nil {
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+// publishedFilter restricts a filter to published posts.
+// Posts created before the status lifecycle existed have no status and are published.
+func publishedFilter(filter bson.M) bson.M {
+	filter["status"] = bson.M{"$in": bson.A{valueobjects.PublishedPostStatus, nil}}
+	return filter
+}
+
+// optionalTimeToUnix stores an unset time as 0 instead of the Unix value of the zero time.
+func optionalTimeToUnix(value time.Time) int64 {
+	if value.IsZero() {
+		return 0
+	}
+	return value.Unix()
+}
+
+func pollToDocument(poll valueobjects.Poll) *pollDocument {
+	if poll.IsZero() {
+		return nil
+	}
+	options := make([]pollOptionDocument, 0, len(poll.Options()))
+	for _, option := range poll.Options() {
+		options = append(options, pollOptionDocument{OptionID: option.ID(), Text: option.Text()})
+	}
+	return &pollDocument{
+		Options:        options,
+		MultipleChoice: poll.MultipleChoice(),
+		Anonymous:      poll.Anonymous(),
+		ClosesAt:       optionalTimeToUnix(poll.ClosesAt()),
+	}
+}
+
+func documentToPoll(doc *pollDocument) valueobjects.Poll {
+	if doc == nil {
+		return valueobjects.Poll{}
+	}
+	options := make([]valueobjects.PollOption, 0, len(doc.Options))
+	for _, option := range doc.Options {
+		options = append(options, valueobjects.ReconstructPollOption(option.OptionID, option.Text))
+	}
+	var closesAt time.Time
+	if doc.ClosesAt != 0 {
+		closesAt = time.Unix(doc.ClosesAt, 0)
+	}
+	return valueobjects.ReconstructPoll(options, doc.MultipleChoice, doc.Anonymous, closesAt)
+}
+
+func hashtagsToStrings(hashtags []valueobjects.Hashtag) []string {
+	values := make([]string, len(hashtags))
+	for i, hashtag := range hashtags {
+		values[i] = hashtag.Value()
+	}
+	return values
+}
+
+func authorIDsToStrings(authorIDs []valueobjects.AuthorID) []string {
+	values := make([]string, len(authorIDs))
+	for i, id := range authorIDs {
+		values[i] = id.Value()
+	}
+	return values
+}
+
+func communityIDsToStrings(communityIDs []valueobjects.CommunityID) []string {
+	values := make([]string, len(communityIDs))
+	for i, id := range communityIDs {
+		values[i] = id.Value()
+	}
+	return values
+}
+
 func (r *postRepositoryImpl) entityToDocument(post *entities.Post) *postDocument {
 	return &postDocument{
 		ID:          post.PostID().Value(),
 		PostID:      post.PostID().Value(),
 		CommunityID: post.CommunityID().Value(),
 		AuthorID:    post.AuthorID().Value(),
+		PostType:    post.PostType().Value(),
 		Content:     post.Content().Value(),
 		Images:      post.Images().URLs(),
+		Poll:        pollToDocument(post.Poll()),
+		Hashtags:    hashtagsToStrings(post.Hashtags()),
+		Mentions:    authorIDsToStrings(post.Mentions()),
+		IsPinned:    post.IsPinned(),
+		PinOrder:    post.PinOrder(),
+		Status:      post.Status().Value(),
+		PublishAt:   optionalTimeToUnix(post.PublishAt()),
 		CreatedAt:   post.CreatedAt().Unix(),
 		UpdatedAt:   post.UpdatedAt().Unix(),
 	}
@@ -232,6 +656,40 @@ func (r *postRepositoryImpl) documentToEntity(doc *postDocument) (*entities.Post
 	if err != nil {
 		return nil, err
 	}
+	postType := valueobjects.DefaultMessageType()
+	if doc.PostType != "" {
+		postType, err = valueobjects.NewPostType(doc.PostType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	status := valueobjects.PublishedStatus()
+	if doc.Status != "" {
+		status, err = valueobjects.NewPostStatus(doc.Status)
+		if err != nil {
+			return nil, err
+		}
+	}
+	hashtags := make([]valueobjects.Hashtag, 0, len(doc.Hashtags))
+	for _, value := range doc.Hashtags {
+		hashtag, err := valueobjects.NewHashtag(value)
+		if err != nil {
+			return nil, err
+		}
+		hashtags = append(hashtags, hashtag)
+	}
+	mentions := make([]valueobjects.AuthorID, 0, len(doc.Mentions))
+	for _, value := range doc.Mentions {
+		mention, err := valueobjects.NewAuthorID(value)
+		if err != nil {
+			return nil, err
+		}
+		mentions = append(mentions, mention)
+	}
+	var publishAt time.Time
+	if doc.PublishAt != 0 {
+		publishAt = time.Unix(doc.PublishAt, 0)
+	}
 	content, err := valueobjects.NewPostContent(doc.Content)
 	if err != nil {
 		return nil, err
@@ -246,8 +704,15 @@ func (r *postRepositoryImpl) documentToEntity(doc *postDocument) (*entities.Post
 		postID,
 		communityID,
 		authorID,
+		postType,
 		content,
 		images,
+		documentToPoll(doc.Poll),
+		hashtags,
+		mentions,
+		doc.PinOrder,
+		status,
+		publishAt,
 		time.Unix(doc.CreatedAt, 0),
 		time.Unix(doc.UpdatedAt, 0),
 	)
@@ -0,0 +1,179 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"Gommunity/platform/posts/domain/model/entities"
+	"Gommunity/platform/posts/domain/model/valueobjects"
+	domain_repositories "Gommunity/platform/posts/domain/repositories"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type postRevisionRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+// NewPostRevisionRepository creates a MongoDB-backed PostRevisionRepository.
+func NewPostRevisionRepository(collection *mongo.Collection) domain_repositories.PostRevisionRepository {
+	return &postRevisionRepositoryImpl{
+		collection: collection,
+	}
+}
+
+type postRevisionDocument struct {
+	ID          string   `bson:"_id"`
+	RevisionID  string   `bson:"revision_id"`
+	PostID      string   `bson:"post_id"`
+	CommunityID string   `bson:"community_id"`
+	Content     string   `bson:"content"`
+	Images      []string `bson:"images"`
+	EditedBy    string   `bson:"edited_by"`
+	CreatedAt   int64    `bson:"created_at"`
+}
+
+// Save inserts a new revision document.
+func (r *postRevisionRepositoryImpl) Save(ctx context.Context, revision *entities.PostRevision) error {
+	doc := r.entityToDocument(revision)
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errors.New("revision already exists")
+		}
+		log.Printf("failed to insert post revision: %v", err)
+		return err
+	}
+	return nil
+}
+
+// FindByID retrieves a revision by its identifier.
+func (r *postRevisionRepositoryImpl) FindByID(ctx context.Context, revisionID valueobjects.RevisionID) (*entities.PostRevision, error) {
+	filter := bson.M{"revision_id": revisionID.Value()}
+
+	var doc postRevisionDocument
+	if err := r.collection.FindOne(ctx, filter).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		log.Printf("failed to find post revision by id: %v", err)
+		return nil, err
+	}
+	return r.documentToEntity(&doc)
+}
+
+// FindByPostID retrieves the revisions of a post, newest first.
+func (r *postRevisionRepositoryImpl) FindByPostID(ctx context.Context, postID valueobjects.PostID) ([]*entities.PostRevision, error) {
+	filter := bson.M{"post_id": postID.Value()}
+	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		log.Printf("failed to find post revisions: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var revisions []*entities.PostRevision
+	for cursor.Next(ctx) {
+		var doc postRevisionDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		entity, err := r.documentToEntity(&doc)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, entity)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// DeleteByPostID removes the revision history of a post
+func (r *postRevisionRepositoryImpl) DeleteByPostID(ctx context.Context, postID valueobjects.PostID) error {
+	filter := bson.M{"post_id": postID.Value()}
+	if _, err := r.collection.DeleteMany(ctx, filter); err != nil {
+		log.Printf("failed to delete post revisions: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteByCommunity removes all revisions for a community
+func (r *postRevisionRepositoryImpl) DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error {
+	filter := bson.M{"community_id": communityID.Value()}
+	if _, err := r.collection.DeleteMany(ctx, filter); err != nil {
+		log.Printf("failed to delete post revisions by community: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ReassignEditor moves every revision edited by a user to another user ID
+func (r *postRevisionRepositoryImpl) ReassignEditor(ctx context.Context, from, to valueobjects.AuthorID) error {
+	filter := bson.M{"edited_by": from.Value()}
+	update := bson.M{"$set": bson.M{"edited_by": to.Value()}}
+	if _, err := r.collection.UpdateMany(ctx, filter, update); err != nil {
+		log.Printf("failed to reassign post revision editor: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *postRevisionRepositoryImpl) entityToDocument(revision *entities.PostRevision) *postRevisionDocument {
+	return &postRevisionDocument{
+		ID:          revision.RevisionID().Value(),
+		RevisionID:  revision.RevisionID().Value(),
+		PostID:      revision.PostID().Value(),
+		CommunityID: revision.CommunityID().Value(),
+		Content:     revision.Content().Value(),
+		Images:      revision.Images().URLs(),
+		EditedBy:    revision.EditedBy().Value(),
+		CreatedAt:   revision.CreatedAt().Unix(),
+	}
+}
+
+func (r *postRevisionRepositoryImpl) documentToEntity(doc *postRevisionDocument) (*entities.PostRevision, error) {
+	revisionID, err := valueobjects.NewRevisionID(doc.RevisionID)
+	if err != nil {
+		return nil, err
+	}
+	postID, err := valueobjects.NewPostID(doc.PostID)
+	if err != nil {
+		return nil, err
+	}
+	communityID, err := valueobjects.NewCommunityID(doc.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	content, err := valueobjects.NewPostContent(doc.Content)
+	if err != nil {
+		return nil, err
+	}
+	images, err := valueobjects.NewPostImages(doc.Images)
+	if err != nil {
+		return nil, err
+	}
+	editedBy, err := valueobjects.NewAuthorID(doc.EditedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.ReconstructPostRevision(
+		revisionID,
+		postID,
+		communityID,
+		content,
+		images,
+		editedBy,
+		time.Unix(doc.CreatedAt, 0),
+	), nil
+}
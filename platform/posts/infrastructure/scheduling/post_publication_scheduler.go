@@ -0,0 +1,66 @@
+package scheduling
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"Gommunity/platform/posts/domain/services"
+)
+
+// PostPublicationScheduler periodically publishes scheduled posts whose publish-at time has been reached.
+type PostPublicationScheduler struct {
+	commandService services.PostCommandService
+	interval       time.Duration
+	stop           chan struct{}
+	done           sync.WaitGroup
+}
+
+// NewPostPublicationScheduler creates a scheduler that checks for due posts every interval.
+func NewPostPublicationScheduler(commandService services.PostCommandService, interval time.Duration) *PostPublicationScheduler {
+	return &PostPublicationScheduler{
+		commandService: commandService,
+		interval:       interval,
+		stop:           make(chan struct{}),
+	}
+}
+
+// Start runs the scheduler in a background goroutine until Stop is called or ctx is cancelled.
+func (s *PostPublicationScheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	s.done.Add(1)
+	go func() {
+		defer s.done.Done()
+		defer ticker.Stop()
+
+		log.Printf("Post publication scheduler started (interval %s)", s.interval)
+		for {
+			select {
+			case <-ticker.C:
+				s.publishDue(ctx)
+			case <-s.stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop signals the scheduler to exit and waits for the current run to finish.
+func (s *PostPublicationScheduler) Stop() {
+	close(s.stop)
+	s.done.Wait()
+	log.Println("Post publication scheduler stopped")
+}
+
+func (s *PostPublicationScheduler) publishDue(ctx context.Context) {
+	published, err := s.commandService.HandlePublishDue(ctx)
+	if err != nil {
+		log.Printf("Scheduled post publication error: %v", err)
+	}
+	if published > 0 {
+		log.Printf("Published %d scheduled post(s)", published)
+	}
+}
@@ -11,7 +11,7 @@ type PostData struct {
 	CommunityID string
 	AuthorID    string
 	Content     string
-	MessageType string
+	MessageType string // post type: message or announcement
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
@@ -19,5 +19,26 @@ type PostData struct {
 // PostsFacade exposes posts operations to other bounded contexts.
 type PostsFacade interface {
 	PostExists(ctx context.Context, postID string) (bool, error)
-	GetAnnouncementsByCommunities(ctx context.Context, communityIDs []string, limit, offset *int) ([]*PostData, error)
+
+	// GetPostCommunityID returns the community a post belongs to.
+	// Returns empty string when the post does not exist.
+	GetPostCommunityID(ctx context.Context, postID string) (string, error)
+
+	// GetPostsByCommunities returns one page of posts of every type, newest first, and the opaque cursor
+	// of the next page (empty on the last page). A non-empty cursor takes precedence over the deprecated offset.
+	GetPostsByCommunities(ctx context.Context, communityIDs []string, limit, offset *int, cursor string) ([]*PostData, string, error)
+
+	// GetAnnouncementsByCommunities works like GetPostsByCommunities but only returns announcement posts.
+	GetAnnouncementsByCommunities(ctx context.Context, communityIDs []string, limit, offset *int, cursor string) ([]*PostData, string, error)
+
+	// SearchPosts runs a full-text search on published post content, most relevant first.
+	// Only posts from the given communities are searched.
+	SearchPosts(ctx context.Context, text string, communityIDs []string, limit, offset int) ([]*PostData, error)
+
+	// AnonymizeAuthor moves the posts, revisions and poll votes of a removed user to an anonymous user ID.
+	AnonymizeAuthor(ctx context.Context, authorID, anonymousID string) error
+
+	// DeletePostsByAuthor deletes the posts of a removed user with their revisions, comments, reactions
+	// and poll votes, together with the user's votes in other polls.
+	DeletePostsByAuthor(ctx context.Context, authorID string) error
 }
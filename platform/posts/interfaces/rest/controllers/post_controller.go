@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"Gommunity/platform/posts/domain/model/commands"
 	"Gommunity/platform/posts/domain/model/entities"
@@ -35,7 +36,7 @@ func NewPostController(
 
 // CreatePost godoc
 // @Summary Publish a new post
-// @Description Only community owners and admins can create posts.
+// @Description Only community owners and admins, or members whose custom role grants the publish_post permission, can publish posts. Announcements also require the publish_announcement permission. A poll can be attached, with the post content as its question. Posts can be kept as drafts or scheduled for later; those are only visible to their author and community moderators until published.
 // @Tags posts
 // @Accept json
 // @Produce json
@@ -88,12 +89,48 @@ func (c *PostController) CreatePost(ctx *gin.Context) {
 		return
 	}
 
-	cmd, err := commands.NewCreatePostCommand(communityID, authorID, content, images)
+	postType := valueobjects.DefaultMessageType()
+	if req.PostType != "" {
+		postType, err = valueobjects.NewPostType(req.PostType)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+			return
+		}
+	}
+
+	status := valueobjects.PublishedStatus()
+	if req.Status != "" {
+		status, err = valueobjects.NewPostStatus(req.Status)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+			return
+		}
+	}
+
+	var publishAt time.Time
+	if req.PublishAt != nil {
+		publishAt = *req.PublishAt
+	}
+
+	cmd, err := commands.NewCreatePostCommand(communityID, authorID, postType, content, images, status, publishAt)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
 		return
 	}
 
+	if req.Poll != nil {
+		var closesAt time.Time
+		if req.Poll.ClosesAt != nil {
+			closesAt = *req.Poll.ClosesAt
+		}
+		poll, err := valueobjects.NewPoll(req.Poll.Options, req.Poll.MultipleChoice, req.Poll.Anonymous, closesAt)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+			return
+		}
+		cmd = cmd.WithPoll(poll)
+	}
+
 	postID, err := c.commandService.HandlePublish(ctx.Request.Context(), cmd)
 	if err != nil {
 		ctx.JSON(mapErrorToStatus(err), resources.ErrorResponse{Error: err.Error()})
@@ -101,8 +138,8 @@ func (c *PostController) CreatePost(ctx *gin.Context) {
 	}
 
 	getQuery, _ := queries.NewGetPostByIDQuery(*postID)
-	post, err := c.queryService.HandleGetByID(ctx.Request.Context(), getQuery)
-	if err != nil {
+	post, err := c.queryService.HandleGetByID(ctx.Request.Context(), getQuery.WithViewer(authorID))
+	if err != nil || post == nil {
 		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{Error: "unable to retrieve created post"})
 		return
 	}
@@ -112,7 +149,7 @@ func (c *PostController) CreatePost(ctx *gin.Context) {
 
 // GetPostByID godoc
 // @Summary Get post by ID
-// @Description Retrieves a post using its identifier within a community.
+// @Description Retrieves a post using its identifier within a community. Drafts and scheduled posts are only returned to their author and community moderators.
 // @Tags posts
 // @Accept json
 // @Produce json
@@ -142,7 +179,7 @@ func (c *PostController) GetPostByID(ctx *gin.Context) {
 	}
 
 	query, _ := queries.NewGetPostByIDQuery(postID)
-	post, err := c.queryService.HandleGetByID(ctx.Request.Context(), query)
+	post, err := c.queryService.HandleGetByID(ctx.Request.Context(), query.WithViewer(c.viewerFromContext(ctx)))
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{Error: "failed to retrieve post"})
 		return
@@ -158,15 +195,16 @@ func (c *PostController) GetPostByID(ctx *gin.Context) {
 
 // GetPostsByCommunity godoc
 // @Summary List posts by community
-// @Description Retrieves posts published inside a community.
+// @Description Retrieves posts published inside a community, newest first, using cursor pagination. Pinned posts come first, in pin order, on top of the first page.
 // @Tags posts
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param community_id path string true "Community ID (UUID)"
 // @Param limit query int false "Limit results" minimum(1) maximum(100)
-// @Param offset query int false "Skip results" minimum(0)
-// @Success 200 {array} resources.PostResource
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param offset query int false "Skip results (deprecated, use cursor)" minimum(0)
+// @Success 200 {object} resources.PostPageResource
 // @Failure 400 {object} resources.ErrorResponse
 // @Failure 401 {object} resources.ErrorResponse
 // @Failure 500 {object} resources.ErrorResponse
@@ -182,52 +220,31 @@ func (c *PostController) GetPostsByCommunity(ctx *gin.Context) {
 
 	query, _ := queries.NewGetPostsByCommunityQuery(communityID)
 
-	limitParam := 0
-	offsetParam := -1
-
-	if limitStr := ctx.Query("limit"); limitStr != "" {
-		limit, err := strconv.Atoi(limitStr)
-		if err != nil || limit <= 0 {
-			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "limit must be a positive number"})
-			return
-		}
-		if limit > 100 {
-			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "limit cannot exceed 100"})
-			return
-		}
-		limitParam = limit
-	}
-
-	if offsetStr := ctx.Query("offset"); offsetStr != "" {
-		offset, err := strconv.Atoi(offsetStr)
-		if err != nil || offset < 0 {
-			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "offset must be zero or positive"})
-			return
-		}
-		offsetParam = offset
+	limitParam, offsetParam, ok := parsePagination(ctx)
+	if !ok {
+		return
 	}
-
 	if limitParam > 0 || offsetParam >= 0 {
 		query = query.WithPagination(limitParam, offsetParam)
 	}
 
-	posts, err := c.queryService.HandleGetByCommunity(ctx.Request.Context(), query)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{Error: "failed to list posts"})
+	cursor, ok := parseCursor(ctx)
+	if !ok {
 		return
 	}
 
-	response := make([]resources.PostResource, 0, len(posts))
-	for _, post := range posts {
-		response = append(response, c.toResource(post))
+	posts, next, err := c.queryService.HandleGetByCommunity(ctx.Request.Context(), query.WithCursor(cursor))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{Error: "failed to list posts"})
+		return
 	}
 
-	ctx.JSON(http.StatusOK, response)
+	ctx.JSON(http.StatusOK, c.toPageResource(posts, next))
 }
 
 // DeletePost godoc
 // @Summary Delete a post
-// @Description Only community admins or owners can delete posts.
+// @Description Only members holding the delete_any_post permission can delete posts.
 // @Tags posts
 // @Accept json
 // @Produce json
@@ -281,16 +298,828 @@ func (c *PostController) DeletePost(ctx *gin.Context) {
 	ctx.Status(http.StatusNoContent)
 }
 
-func (c *PostController) toResource(post *entities.Post) resources.PostResource {
-	return resources.PostResource{
-		PostID:      post.PostID().Value(),
-		CommunityID: post.CommunityID().Value(),
-		AuthorID:    post.AuthorID().Value(),
-		Content:     post.Content().Value(),
-		Images:      post.Images().URLs(),
-		CreatedAt:   post.CreatedAt(),
-		UpdatedAt:   post.UpdatedAt(),
+// UpdatePost godoc
+// @Summary Edit a post
+// @Description The author or a member holding the delete_any_post permission can edit the content and images of a post. The previous version is kept as a revision. Published posts can only be edited by members allowed to publish them, and not while muted.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Param post_id path string true "Post ID (ObjectID)"
+// @Param request body resources.UpdatePostResource true "Post payload"
+// @Success 200 {object} resources.PostResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/posts/{post_id} [put]
+func (c *PostController) UpdatePost(ctx *gin.Context) {
+	communityIDValue := ctx.Param("community_id")
+	postIDValue := ctx.Param("post_id")
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, resources.ErrorResponse{Error: "authentication required"})
+		return
+	}
+
+	var req resources.UpdatePostResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(communityIDValue)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid community id"})
+		return
+	}
+
+	postID, err := valueobjects.NewPostID(postIDValue)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid post id"})
+		return
+	}
+
+	requesterID, err := valueobjects.NewAuthorID(userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid requester id"})
+		return
+	}
+
+	content, err := valueobjects.NewPostContent(req.Content)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	images, err := valueobjects.NewPostImages(req.Images)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if !c.postBelongsToCommunity(ctx, postID, communityID) {
+		return
+	}
+
+	cmd, err := commands.NewUpdatePostCommand(postID, requesterID, content, images)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleUpdate(ctx.Request.Context(), cmd); err != nil {
+		ctx.JSON(mapErrorToStatus(err), resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.respondWithPost(ctx, postID)
+}
+
+// GetPostRevisions godoc
+// @Summary List post revisions
+// @Description Retrieves the previous versions of a post, newest first. Only the author or a member holding the delete_any_post permission can view them.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Param post_id path string true "Post ID (ObjectID)"
+// @Success 200 {array} resources.PostRevisionResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/posts/{post_id}/revisions [get]
+func (c *PostController) GetPostRevisions(ctx *gin.Context) {
+	postID, requesterID, ok := c.parsePostAction(ctx)
+	if !ok {
+		return
+	}
+
+	query, err := queries.NewGetPostRevisionsQuery(postID, requesterID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	revisions, err := c.queryService.HandleGetRevisions(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(mapErrorToStatus(err), resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	response := make([]resources.PostRevisionResource, 0, len(revisions))
+	for _, revision := range revisions {
+		response = append(response, c.toRevisionResource(revision))
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// RestorePostRevision godoc
+// @Summary Restore a post revision
+// @Description The author or a member holding the delete_any_post permission can roll a post back to a previous revision. The current version is kept as a new revision. Published posts can only be restored by members allowed to publish them, and not while muted.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Param post_id path string true "Post ID (ObjectID)"
+// @Param revision_id path string true "Revision ID (ObjectID)"
+// @Success 200 {object} resources.PostResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/posts/{post_id}/revisions/{revision_id}/restore [post]
+func (c *PostController) RestorePostRevision(ctx *gin.Context) {
+	communityIDValue := ctx.Param("community_id")
+	postIDValue := ctx.Param("post_id")
+	revisionIDValue := ctx.Param("revision_id")
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, resources.ErrorResponse{Error: "authentication required"})
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(communityIDValue)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid community id"})
+		return
+	}
+
+	postID, err := valueobjects.NewPostID(postIDValue)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid post id"})
+		return
+	}
+
+	revisionID, err := valueobjects.NewRevisionID(revisionIDValue)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid revision id"})
+		return
+	}
+
+	requesterID, err := valueobjects.NewAuthorID(userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid requester id"})
+		return
+	}
+
+	if !c.postBelongsToCommunity(ctx, postID, communityID) {
+		return
+	}
+
+	cmd, err := commands.NewRestorePostRevisionCommand(postID, revisionID, requesterID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleRestoreRevision(ctx.Request.Context(), cmd); err != nil {
+		ctx.JSON(mapErrorToStatus(err), resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.respondWithPost(ctx, postID)
+}
+
+// GetPinnedPosts godoc
+// @Summary List pinned posts
+// @Description Retrieves the pinned posts of a community in pin order.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Success 200 {array} resources.PostResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/posts/pinned [get]
+func (c *PostController) GetPinnedPosts(ctx *gin.Context) {
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid community id"})
+		return
+	}
+
+	query, _ := queries.NewGetPinnedPostsQuery(communityID)
+	posts, err := c.queryService.HandleGetPinned(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{Error: "failed to list pinned posts"})
+		return
+	}
+
+	response := make([]resources.PostResource, 0, len(posts))
+	for _, post := range posts {
+		response = append(response, c.toResource(post))
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// PinPost godoc
+// @Summary Pin a post
+// @Description Only members holding the pin_post permission can pin posts. The post is added after the already pinned ones.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Param post_id path string true "Post ID (ObjectID)"
+// @Success 200 {object} resources.PostResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 409 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/posts/{post_id}/pin [post]
+func (c *PostController) PinPost(ctx *gin.Context) {
+	postID, requesterID, ok := c.parsePostAction(ctx)
+	if !ok {
+		return
+	}
+
+	cmd, err := commands.NewPinPostCommand(postID, requesterID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandlePin(ctx.Request.Context(), cmd); err != nil {
+		ctx.JSON(mapErrorToStatus(err), resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.respondWithPost(ctx, postID)
+}
+
+// UnpinPost godoc
+// @Summary Unpin a post
+// @Description Only members holding the pin_post permission can unpin posts.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Param post_id path string true "Post ID (ObjectID)"
+// @Success 204 "Post unpinned"
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/posts/{post_id}/pin [delete]
+func (c *PostController) UnpinPost(ctx *gin.Context) {
+	postID, requesterID, ok := c.parsePostAction(ctx)
+	if !ok {
+		return
+	}
+
+	cmd, err := commands.NewUnpinPostCommand(postID, requesterID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleUnpin(ctx.Request.Context(), cmd); err != nil {
+		ctx.JSON(mapErrorToStatus(err), resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// GetPostsByHashtag godoc
+// @Summary List posts by hashtag
+// @Description Retrieves the published posts of a community tagged with a hashtag, newest first. The hashtag is case-insensitive and may omit the leading '#'.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Param hashtag path string true "Hashtag"
+// @Param limit query int false "Limit results" minimum(1) maximum(100)
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param offset query int false "Skip results (deprecated, use cursor)" minimum(0)
+// @Success 200 {object} resources.PostPageResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/posts/hashtags/{hashtag} [get]
+func (c *PostController) GetPostsByHashtag(ctx *gin.Context) {
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid community id"})
+		return
+	}
+
+	hashtag, err := valueobjects.NewHashtag(ctx.Param("hashtag"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	query, _ := queries.NewGetPostsByHashtagQuery(communityID, hashtag)
+
+	limitParam, offsetParam, ok := parsePagination(ctx)
+	if !ok {
+		return
+	}
+	if limitParam > 0 || offsetParam >= 0 {
+		query = query.WithPagination(limitParam, offsetParam)
+	}
+
+	cursor, ok := parseCursor(ctx)
+	if !ok {
+		return
+	}
+
+	posts, next, err := c.queryService.HandleGetByHashtag(ctx.Request.Context(), query.WithCursor(cursor))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{Error: "failed to list posts"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.toPageResource(posts, next))
+}
+
+// GetMyMentions godoc
+// @Summary List posts mentioning me
+// @Description Retrieves the published posts, across communities, that mention the authenticated user, newest first.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Limit results" minimum(1) maximum(100)
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param offset query int false "Skip results (deprecated, use cursor)" minimum(0)
+// @Success 200 {object} resources.PostPageResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/posts/mentions/me [get]
+func (c *PostController) GetMyMentions(ctx *gin.Context) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, resources.ErrorResponse{Error: "authentication required"})
+		return
+	}
+
+	mentionedID, err := valueobjects.NewAuthorID(userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid user id"})
+		return
+	}
+
+	query, _ := queries.NewGetPostsMentioningUserQuery(mentionedID)
+
+	limitParam, offsetParam, ok := parsePagination(ctx)
+	if !ok {
+		return
+	}
+	if limitParam > 0 || offsetParam >= 0 {
+		query = query.WithPagination(limitParam, offsetParam)
+	}
+
+	cursor, ok := parseCursor(ctx)
+	if !ok {
+		return
+	}
+
+	posts, next, err := c.queryService.HandleGetMentioning(ctx.Request.Context(), query.WithCursor(cursor))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{Error: "failed to list mentions"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.toPageResource(posts, next))
+}
+
+// GetUnpublishedPosts godoc
+// @Summary List draft and scheduled posts
+// @Description Members holding the delete_any_post permission see every unpublished post of the community; other users only see their own.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Success 200 {array} resources.PostResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/posts/drafts [get]
+func (c *PostController) GetUnpublishedPosts(ctx *gin.Context) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, resources.ErrorResponse{Error: "authentication required"})
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid community id"})
+		return
+	}
+
+	requesterID, err := valueobjects.NewAuthorID(userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid requester id"})
+		return
+	}
+
+	query, _ := queries.NewGetUnpublishedPostsQuery(communityID, requesterID)
+	posts, err := c.queryService.HandleGetUnpublished(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{Error: "failed to list unpublished posts"})
+		return
+	}
+
+	response := make([]resources.PostResource, 0, len(posts))
+	for _, post := range posts {
+		response = append(response, c.toResource(post))
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// ChangePostStatus godoc
+// @Summary Change the status of a post
+// @Description The author or a member holding the delete_any_post permission can move an unpublished post back to draft, schedule it or publish it right away. Published posts cannot be unpublished. Scheduling or publishing needs the author to be allowed to publish the post and not be muted; scheduled posts whose author is muted or loses that right by the publish-at time are moved back to draft.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Param post_id path string true "Post ID (ObjectID)"
+// @Param request body resources.ChangePostStatusResource true "Status payload"
+// @Success 200 {object} resources.PostResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 409 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/posts/{post_id}/status [patch]
+func (c *PostController) ChangePostStatus(ctx *gin.Context) {
+	var req resources.ChangePostStatusResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+
+	status, err := valueobjects.NewPostStatus(req.Status)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var publishAt time.Time
+	if req.PublishAt != nil {
+		publishAt = *req.PublishAt
+	}
+
+	postID, requesterID, ok := c.parsePostAction(ctx)
+	if !ok {
+		return
+	}
+
+	cmd, err := commands.NewChangePostStatusCommand(postID, requesterID, status, publishAt)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleChangeStatus(ctx.Request.Context(), cmd); err != nil {
+		ctx.JSON(mapErrorToStatus(err), resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.respondWithPost(ctx, postID)
+}
+
+// GetPollResults godoc
+// @Summary Get the poll of a post
+// @Description Retrieves the poll attached to a post. Vote counts are hidden until the requesting user votes or the poll closes; voters are never shown for anonymous polls.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Param post_id path string true "Post ID (ObjectID)"
+// @Success 200 {object} resources.PollResultsResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/posts/{post_id}/poll [get]
+func (c *PostController) GetPollResults(ctx *gin.Context) {
+	postID, requesterID, ok := c.parsePostAction(ctx)
+	if !ok {
+		return
+	}
+
+	c.respondWithPollResults(ctx, http.StatusOK, postID, requesterID)
+}
+
+// VoteOnPoll godoc
+// @Summary Vote in the poll of a post
+// @Description Community members can vote once per poll. Single choice polls accept exactly one option.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param community_id path string true "Community ID (UUID)"
+// @Param post_id path string true "Post ID (ObjectID)"
+// @Param request body resources.VotePollResource true "Vote payload"
+// @Success 201 {object} resources.PollResultsResource
+// @Failure 400 {object} resources.ErrorResponse
+// @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
+// @Failure 404 {object} resources.ErrorResponse
+// @Failure 409 {object} resources.ErrorResponse
+// @Failure 500 {object} resources.ErrorResponse
+// @Router /api/v1/communities/{community_id}/posts/{post_id}/poll/votes [post]
+func (c *PostController) VoteOnPoll(ctx *gin.Context) {
+	var req resources.VotePollResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+
+	postID, requesterID, ok := c.parsePostAction(ctx)
+	if !ok {
+		return
+	}
+
+	cmd, err := commands.NewVoteOnPollCommand(postID, requesterID, req.OptionIDs)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleVote(ctx.Request.Context(), cmd); err != nil {
+		ctx.JSON(mapErrorToStatus(err), resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.respondWithPollResults(ctx, http.StatusCreated, postID, requesterID)
+}
+
+// parsePostAction reads the requester and the post path parameters of a post action,
+// writing the error response itself and returning false on failure.
+func (c *PostController) parsePostAction(ctx *gin.Context) (valueobjects.PostID, valueobjects.AuthorID, bool) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, resources.ErrorResponse{Error: "authentication required"})
+		return valueobjects.PostID{}, valueobjects.AuthorID{}, false
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid community id"})
+		return valueobjects.PostID{}, valueobjects.AuthorID{}, false
+	}
+
+	postID, err := valueobjects.NewPostID(ctx.Param("post_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid post id"})
+		return valueobjects.PostID{}, valueobjects.AuthorID{}, false
+	}
+
+	requesterID, err := valueobjects.NewAuthorID(userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid requester id"})
+		return valueobjects.PostID{}, valueobjects.AuthorID{}, false
+	}
+
+	if !c.postBelongsToCommunity(ctx, postID, communityID) {
+		return valueobjects.PostID{}, valueobjects.AuthorID{}, false
+	}
+
+	return postID, requesterID, true
+}
+
+// postBelongsToCommunity writes a 404 response and returns false when the post is not part of the community.
+func (c *PostController) postBelongsToCommunity(ctx *gin.Context, postID valueobjects.PostID, communityID valueobjects.CommunityID) bool {
+	query, _ := queries.NewGetPostByIDQuery(postID)
+	post, err := c.queryService.HandleGetByID(ctx.Request.Context(), query.WithViewer(c.viewerFromContext(ctx)))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{Error: "failed to retrieve post"})
+		return false
+	}
+	if post == nil || post.CommunityID().Value() != communityID.Value() {
+		ctx.JSON(http.StatusNotFound, resources.ErrorResponse{Error: "post not found"})
+		return false
+	}
+	return true
+}
+
+// respondWithPost reloads the post and writes it as a 200 response.
+func (c *PostController) respondWithPost(ctx *gin.Context, postID valueobjects.PostID) {
+	query, _ := queries.NewGetPostByIDQuery(postID)
+	post, err := c.queryService.HandleGetByID(ctx.Request.Context(), query.WithViewer(c.viewerFromContext(ctx)))
+	if err != nil || post == nil {
+		ctx.JSON(http.StatusInternalServerError, resources.ErrorResponse{Error: "unable to retrieve updated post"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.toResource(post))
+}
+
+// respondWithPollResults loads the poll of a post as seen by the requester and writes it with the given status.
+func (c *PostController) respondWithPollResults(ctx *gin.Context, status int, postID valueobjects.PostID, requesterID valueobjects.AuthorID) {
+	query, err := queries.NewGetPollResultsQuery(postID, requesterID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	results, err := c.queryService.HandleGetPollResults(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(mapErrorToStatus(err), resources.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	myOptionIDs := results.UserOptionIDs
+	if myOptionIDs == nil {
+		myOptionIDs = []string{}
+	}
+
+	ctx.JSON(status, resources.PollResultsResource{
+		PostID:         postID.Value(),
+		HasVoted:       results.HasVoted,
+		MyOptionIDs:    myOptionIDs,
+		ResultsVisible: results.ResultsVisible,
+		TotalVoters:    results.TotalVoters,
+		PollResource:   c.toPollResource(results.Poll, results.Closed, results),
+	})
+}
+
+// toPollResource maps a poll to its response; counts and voters are only included when results are given.
+func (c *PostController) toPollResource(poll valueobjects.Poll, closed bool, results *services.PollResults) resources.PollResource {
+	options := make([]resources.PollOptionResource, 0, len(poll.Options()))
+	for _, option := range poll.Options() {
+		optionResource := resources.PollOptionResource{
+			OptionID: option.ID(),
+			Text:     option.Text(),
+		}
+		if results != nil && results.ResultsVisible {
+			votes := results.Counts[option.ID()]
+			optionResource.Votes = &votes
+			optionResource.Voters = results.Voters[option.ID()]
+		}
+		options = append(options, optionResource)
+	}
+
+	var closesAt *time.Time
+	if !poll.ClosesAt().IsZero() {
+		value := poll.ClosesAt()
+		closesAt = &value
+	}
+
+	return resources.PollResource{
+		Options:        options,
+		MultipleChoice: poll.MultipleChoice(),
+		Anonymous:      poll.Anonymous(),
+		ClosesAt:       closesAt,
+		Closed:         closed,
+	}
+}
+
+// viewerFromContext returns the authenticated user, or a zero AuthorID when there is none.
+func (c *PostController) viewerFromContext(ctx *gin.Context) valueobjects.AuthorID {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return valueobjects.AuthorID{}
+	}
+	viewer, err := valueobjects.NewAuthorID(userID)
+	if err != nil {
+		return valueobjects.AuthorID{}
+	}
+	return viewer
+}
+
+func (c *PostController) toResource(post *entities.Post) resources.PostResource {
+	var publishAt *time.Time
+	if !post.PublishAt().IsZero() {
+		value := post.PublishAt()
+		publishAt = &value
+	}
+
+	var poll *resources.PollResource
+	if post.HasPoll() {
+		pollResource := c.toPollResource(post.Poll(), post.Poll().IsClosed(time.Now()), nil)
+		poll = &pollResource
+	}
+
+	hashtags := make([]string, 0, len(post.Hashtags()))
+	for _, hashtag := range post.Hashtags() {
+		hashtags = append(hashtags, hashtag.Value())
+	}
+	mentions := make([]string, 0, len(post.Mentions()))
+	for _, mention := range post.Mentions() {
+		mentions = append(mentions, mention.Value())
+	}
+
+	return resources.PostResource{
+		PostID:      post.PostID().Value(),
+		CommunityID: post.CommunityID().Value(),
+		AuthorID:    post.AuthorID().Value(),
+		PostType:    post.PostType().Value(),
+		Content:     post.Content().Value(),
+		Images:      post.Images().URLs(),
+		Poll:        poll,
+		Hashtags:    hashtags,
+		Mentions:    mentions,
+		Pinned:      post.IsPinned(),
+		PinOrder:    post.PinOrder(),
+		Status:      post.Status().Value(),
+		PublishAt:   publishAt,
+		CreatedAt:   post.CreatedAt(),
+		UpdatedAt:   post.UpdatedAt(),
+	}
+}
+
+func (c *PostController) toPageResource(posts []*entities.Post, next *valueobjects.Cursor) resources.PostPageResource {
+	page := resources.PostPageResource{
+		Items: make([]resources.PostResource, 0, len(posts)),
+	}
+	for _, post := range posts {
+		page.Items = append(page.Items, c.toResource(post))
+	}
+	if next != nil {
+		page.NextCursor = next.Encode()
+	}
+	return page
+}
+
+func (c *PostController) toRevisionResource(revision *entities.PostRevision) resources.PostRevisionResource {
+	return resources.PostRevisionResource{
+		RevisionID:  revision.RevisionID().Value(),
+		PostID:      revision.PostID().Value(),
+		CommunityID: revision.CommunityID().Value(),
+		Content:     revision.Content().Value(),
+		Images:      revision.Images().URLs(),
+		EditedBy:    revision.EditedBy().Value(),
+		CreatedAt:   revision.CreatedAt(),
+	}
+}
+
+// parsePagination reads the optional limit and offset query parameters, writing the error response
+// itself and returning false on failure. Unset values are returned as 0 and -1.
+func parsePagination(ctx *gin.Context) (int, int, bool) {
+	limitParam := 0
+	offsetParam := -1
+
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "limit must be a positive number"})
+			return 0, 0, false
+		}
+		if limit > 100 {
+			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "limit cannot exceed 100"})
+			return 0, 0, false
+		}
+		limitParam = limit
+	}
+
+	if offsetStr := ctx.Query("offset"); offsetStr != "" {
+		offset, err := strconv.Atoi(offsetStr)
+		if err != nil || offset < 0 {
+			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "offset must be zero or positive"})
+			return 0, 0, false
+		}
+		offsetParam = offset
+	}
+
+	return limitParam, offsetParam, true
+}
+
+// parseCursor reads the optional cursor query parameter, writing the error response itself
+// and returning false on failure. An unset cursor is returned as the zero cursor.
+func parseCursor(ctx *gin.Context) (valueobjects.Cursor, bool) {
+	cursorStr := ctx.Query("cursor")
+	if cursorStr == "" {
+		return valueobjects.Cursor{}, true
+	}
+
+	cursor, err := valueobjects.ParseCursor(cursorStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
+		return valueobjects.Cursor{}, false
 	}
+	return cursor, true
 }
 
 func mapErrorToStatus(err error) int {
@@ -301,9 +1130,9 @@ func mapErrorToStatus(err error) int {
 	switch {
 	case strings.Contains(lower, "not found"):
 		return http.StatusNotFound
-	case strings.Contains(lower, "already"):
+	case strings.Contains(lower, "already") || strings.Contains(lower, "archived"):
 		return http.StatusConflict
-	case strings.Contains(lower, "only") || strings.Contains(lower, "not allowed") || strings.Contains(lower, "must"):
+	case strings.Contains(lower, "only") || strings.Contains(lower, "not allowed") || strings.Contains(lower, "must") || strings.Contains(lower, "muted"):
 		return http.StatusForbidden
 	default:
 		return http.StatusBadRequest
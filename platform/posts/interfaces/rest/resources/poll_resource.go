@@ -0,0 +1,47 @@
+package resources
+
+import "time"
+
+// CreatePollResource represents the poll attached to a new post.
+// The post content acts as the question.
+type CreatePollResource struct {
+	Options        []string   `json:"options" example:"Monday,Wednesday,Friday" binding:"required"`
+	MultipleChoice bool       `json:"multipleChoice" example:"false"`
+	Anonymous      bool       `json:"anonymous" example:"false"`
+	ClosesAt       *time.Time `json:"closesAt" example:"2025-01-20T23:59:00Z"`
+}
+
+// PollOptionResource represents a poll option in responses.
+// Votes and voters are only present once results are visible; voters are never shown for anonymous polls.
+type PollOptionResource struct {
+	OptionID string   `json:"optionId" example:"64c2f1e5b9d3a45f78909999"`
+	Text     string   `json:"text" example:"Wednesday"`
+	Votes    *int     `json:"votes,omitempty" example:"12"`
+	Voters   []string `json:"voters,omitempty" example:"550e8400-e29b-41d4-a716-446655440003"`
+}
+
+// PollResource represents the poll of a post in responses.
+type PollResource struct {
+	Options        []PollOptionResource `json:"options"`
+	MultipleChoice bool                 `json:"multipleChoice" example:"false"`
+	Anonymous      bool                 `json:"anonymous" example:"false"`
+	ClosesAt       *time.Time           `json:"closesAt,omitempty" example:"2025-01-20T23:59:00Z"`
+	Closed         bool                 `json:"closed" example:"false"`
+}
+
+// PollResultsResource represents the poll of a post as seen by the requesting user.
+// Results stay hidden until the user votes or the poll closes.
+type PollResultsResource struct {
+	PostID         string   `json:"postId" example:"64c2f1e5b9d3a45f78901234"`
+	HasVoted       bool     `json:"hasVoted" example:"true"`
+	MyOptionIDs    []string `json:"myOptionIds" example:"64c2f1e5b9d3a45f78909999"`
+	ResultsVisible bool     `json:"resultsVisible" example:"true"`
+	TotalVoters    int      `json:"totalVoters" example:"30"`
+	PollResource
+}
+
+// VotePollResource represents the payload to vote in a poll.
+// Single choice polls accept exactly one option.
+type VotePollResource struct {
+	OptionIDs []string `json:"optionIds" example:"64c2f1e5b9d3a45f78909999" binding:"required,min=1"`
+}
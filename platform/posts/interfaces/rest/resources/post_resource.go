@@ -3,25 +3,67 @@ package resources
 import "time"
 
 // PostResource represents a post in responses.
-// Note: Post type has been removed - all posts are messages.
-// Only community owners and admins can create posts.
 type PostResource struct {
-	PostID      string    `json:"postId" example:"64c2f1e5b9d3a45f78901234"`
-	CommunityID string    `json:"communityId" example:"550e8400-e29b-41d4-a716-446655440002"`
-	AuthorID    string    `json:"authorId" example:"550e8400-e29b-41d4-a716-446655440003"`
-	Content     string    `json:"content" example:"Hello students!\nRemember to submit your projects."`
-	Images      []string  `json:"images" example:"https://example.com/image.png"`
-	CreatedAt   time.Time `json:"createdAt" example:"2025-01-12T12:00:00Z"`
-	UpdatedAt   time.Time `json:"updatedAt" example:"2025-01-12T12:05:00Z"`
+	PostID      string        `json:"postId" example:"64c2f1e5b9d3a45f78901234"`
+	CommunityID string        `json:"communityId" example:"550e8400-e29b-41d4-a716-446655440002"`
+	AuthorID    string        `json:"authorId" example:"550e8400-e29b-41d4-a716-446655440003"`
+	PostType    string        `json:"postType" example:"announcement"`
+	Content     string        `json:"content" example:"Hello students!\nRemember to submit your projects."`
+	Images      []string      `json:"images" example:"https://example.com/image.png"`
+	Poll        *PollResource `json:"poll,omitempty"`
+	Hashtags    []string      `json:"hashtags" example:"homework"`
+	Mentions    []string      `json:"mentions" example:"550e8400-e29b-41d4-a716-446655440004"`
+	Pinned      bool          `json:"pinned" example:"true"`
+	PinOrder    int           `json:"pinOrder,omitempty" example:"1"`
+	Status      string        `json:"status" example:"published"`
+	PublishAt   *time.Time    `json:"publishAt,omitempty" example:"2025-01-13T08:00:00Z"`
+	CreatedAt   time.Time     `json:"createdAt" example:"2025-01-12T12:00:00Z"`
+	UpdatedAt   time.Time     `json:"updatedAt" example:"2025-01-12T12:05:00Z"`
+}
+
+// PostPageResource represents one page of posts.
+type PostPageResource struct {
+	Items      []PostResource `json:"items"`
+	NextCursor string         `json:"nextCursor,omitempty" example:"MTczNjY4MzIwMDo2NGMyZjFlNWI5ZDNhNDVmNzg5MDEyMzQ"`
 }
 
 // CreatePostResource represents the payload to create a post.
-// Only community owners and admins can create posts.
+// PostType defaults to message; announcements require the publish_announcement permission.
+// Status defaults to published; scheduled posts require PublishAt.
 type CreatePostResource struct {
-	Content string   `json:"content" example:"Hello community!" binding:"required"`
+	PostType  string              `json:"postType" example:"message" enums:"message,announcement"`
+	Content   string              `json:"content" example:"Hello community!" binding:"required"`
+	Images    []string            `json:"images" binding:"omitempty,dive,url"`
+	Status    string              `json:"status" example:"published" enums:"draft,scheduled,published"`
+	PublishAt *time.Time          `json:"publishAt" example:"2025-01-13T08:00:00Z"`
+	Poll      *CreatePollResource `json:"poll"`
+}
+
+// UpdatePostResource represents the payload to edit a post.
+// The author or a member holding the delete_any_post permission can edit a post.
+type UpdatePostResource struct {
+	Content string   `json:"content" example:"Hello community! (edited)" binding:"required"`
 	Images  []string `json:"images" binding:"omitempty,dive,url"`
 }
 
+// ChangePostStatusResource represents the payload to move a post through its lifecycle.
+// PublishAt is required when scheduling.
+type ChangePostStatusResource struct {
+	Status    string     `json:"status" example:"scheduled" binding:"required" enums:"draft,scheduled,published"`
+	PublishAt *time.Time `json:"publishAt" example:"2025-01-13T08:00:00Z"`
+}
+
+// PostRevisionResource represents a previous version of a post in responses.
+type PostRevisionResource struct {
+	RevisionID  string    `json:"revisionId" example:"64c2f1e5b9d3a45f78905678"`
+	PostID      string    `json:"postId" example:"64c2f1e5b9d3a45f78901234"`
+	CommunityID string    `json:"communityId" example:"550e8400-e29b-41d4-a716-446655440002"`
+	Content     string    `json:"content" example:"Hello community!"`
+	Images      []string  `json:"images" example:"https://example.com/image.png"`
+	EditedBy    string    `json:"editedBy" example:"550e8400-e29b-41d4-a716-446655440003"`
+	CreatedAt   time.Time `json:"createdAt" example:"2025-01-12T12:05:00Z"`
+}
+
 // ErrorResponse represents an error payload.
 type ErrorResponse struct {
 	Error string `json:"error" example:"Invalid request"`
@@ -0,0 +1,58 @@
+package acl
+
+import (
+	"context"
+
+	"Gommunity/platform/reactions/domain/model/valueobjects"
+	"Gommunity/platform/reactions/domain/repositories"
+	"Gommunity/platform/reactions/interfaces/acl"
+)
+
+type reactionsFacadeImpl struct {
+	reactionRepository repositories.ReactionRepository
+}
+
+// NewReactionsFacade constructs the reactions facade implementation.
+func NewReactionsFacade(reactionRepository repositories.ReactionRepository) acl.ReactionsFacade {
+	return &reactionsFacadeImpl{
+		reactionRepository: reactionRepository,
+	}
+}
+
+// AnonymizeUserReactions moves the reactions of a removed user to an anonymous user ID.
+func (f *reactionsFacadeImpl) AnonymizeUserReactions(ctx context.Context, userID, anonymousID string) error {
+	userIDVO, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return err
+	}
+	anonymousIDVO, err := valueobjects.NewUserID(anonymousID)
+	if err != nil {
+		return err
+	}
+
+	return f.reactionRepository.ReassignUser(ctx, userIDVO, anonymousIDVO)
+}
+
+// DeleteReactionsByUser removes every reaction of a user.
+func (f *reactionsFacadeImpl) DeleteReactionsByUser(ctx context.Context, userID string) error {
+	userIDVO, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	return f.reactionRepository.DeleteByUser(ctx, userIDVO)
+}
+
+// DeleteReactionsByPostIDs removes every reaction on the given posts.
+func (f *reactionsFacadeImpl) DeleteReactionsByPostIDs(ctx context.Context, postIDs []string) error {
+	postIDVOs := make([]valueobjects.PostID, 0, len(postIDs))
+	for _, id := range postIDs {
+		postIDVO, err := valueobjects.NewPostID(id)
+		if err != nil {
+			return err
+		}
+		postIDVOs = append(postIDVOs, postIDVO)
+	}
+
+	return f.reactionRepository.DeleteByPostIDs(ctx, postIDVOs)
+}
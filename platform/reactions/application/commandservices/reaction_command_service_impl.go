@@ -18,6 +18,8 @@ type reactionCommandServiceImpl struct {
 	reactionRepository repositories.ReactionRepository
 	externalPostsService *acl.ExternalPostsService
 	externalUsersService *acl.ExternalUsersService
+	externalSubscriptionsService *acl.ExternalSubscriptionsService
+	externalCommunitiesService *acl.ExternalCommunitiesService
 }
 
 // NewReactionCommandService constructs the reactions command service implementation.
@@ -25,11 +27,15 @@ func NewReactionCommandService(
 	reactionRepository repositories.ReactionRepository,
 	externalPostsService *acl.ExternalPostsService,
 	externalUsersService *acl.ExternalUsersService,
+	externalSubscriptionsService *acl.ExternalSubscriptionsService,
+	externalCommunitiesService *acl.ExternalCommunitiesService,
 ) services.ReactionCommandService {
 	return &reactionCommandServiceImpl{
 		reactionRepository:   reactionRepository,
 		externalPostsService: externalPostsService,
 		externalUsersService: externalUsersService,
+		externalSubscriptionsService: externalSubscriptionsService,
+		externalCommunitiesService: externalCommunitiesService,
 	}
 }
 
@@ -53,6 +59,27 @@ func (s *reactionCommandServiceImpl) HandleAdd(ctx context.Context, cmd commands
 		return nil, errors.New("user not found")
 	}
 
+	// Muted members cannot react, not even to change an existing reaction,
+	// and nobody reacts in an archived community
+	communityID, err := s.externalPostsService.GetPostCommunityID(ctx, cmd.PostID())
+	if err != nil {
+		return nil, err
+	}
+	archived, err := s.externalCommunitiesService.IsCommunityArchived(ctx, communityID)
+	if err != nil {
+		return nil, err
+	}
+	if archived {
+		return nil, errors.New("community is archived")
+	}
+	muted, err := s.externalSubscriptionsService.IsUserMuted(ctx, cmd.UserID(), communityID)
+	if err != nil {
+		return nil, err
+	}
+	if muted {
+		return nil, errors.New("muted members cannot react")
+	}
+
 	// Check if user already reacted to this post
 	existingReaction, err := s.reactionRepository.FindByPostAndUser(ctx, cmd.PostID(), cmd.UserID())
 	if err != nil {
@@ -90,7 +117,25 @@ func (s *reactionCommandServiceImpl) HandleAdd(ctx context.Context, cmd commands
 }
 
 // HandleRemove removes a user's reaction from a post.
+// Removing another user's reaction requires the moderate_reactions permission in the post's community.
 func (s *reactionCommandServiceImpl) HandleRemove(ctx context.Context, cmd commands.RemoveReactionCommand) error {
+	if cmd.IsModeration() {
+		communityID, err := s.externalPostsService.GetPostCommunityID(ctx, cmd.PostID())
+		if err != nil {
+			return err
+		}
+		if communityID == "" {
+			return errors.New("post not found")
+		}
+		canModerate, err := s.externalSubscriptionsService.HasPermission(ctx, cmd.RequestedBy(), communityID, acl.ModerateReactionsPermission)
+		if err != nil {
+			return err
+		}
+		if !canModerate {
+			return errors.New("not allowed to remove other members' reactions")
+		}
+	}
+
 	// Check if reaction exists
 	existingReaction, err := s.reactionRepository.FindByPostAndUser(ctx, cmd.PostID(), cmd.UserID())
 	if err != nil {
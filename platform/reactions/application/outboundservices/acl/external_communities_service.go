@@ -0,0 +1,29 @@
+package acl
+
+import (
+	"context"
+	"fmt"
+
+	communities_acl "Gommunity/platform/community/interfaces/acl"
+)
+
+// ExternalCommunitiesService reads community state from the communities bounded context.
+type ExternalCommunitiesService struct {
+	communitiesFacade communities_acl.CommunitiesFacade
+}
+
+// NewExternalCommunitiesService constructs the external communities service.
+func NewExternalCommunitiesService(communitiesFacade communities_acl.CommunitiesFacade) *ExternalCommunitiesService {
+	return &ExternalCommunitiesService{
+		communitiesFacade: communitiesFacade,
+	}
+}
+
+// IsCommunityArchived checks whether the community was archived after its owner was removed.
+func (s *ExternalCommunitiesService) IsCommunityArchived(ctx context.Context, communityID string) (bool, error) {
+	archived, err := s.communitiesFacade.IsCommunityArchived(ctx, communityID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check community status: %w", err)
+	}
+	return archived, nil
+}
@@ -29,3 +29,12 @@ func (s *ExternalPostsService) ValidatePostExists(ctx context.Context, postID va
 	}
 	return exists, nil
 }
+
+// GetPostCommunityID returns the community a post belongs to, or an empty string when the post does not exist.
+func (s *ExternalPostsService) GetPostCommunityID(ctx context.Context, postID valueobjects.PostID) (string, error) {
+	communityID, err := s.postsFacade.GetPostCommunityID(ctx, postID.Value())
+	if err != nil {
+		return "", fmt.Errorf("failed to get post community: %w", err)
+	}
+	return communityID, nil
+}
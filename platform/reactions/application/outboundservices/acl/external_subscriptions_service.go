@@ -0,0 +1,42 @@
+package acl
+
+import (
+	"context"
+	"fmt"
+
+	"Gommunity/platform/reactions/domain/model/valueobjects"
+	subscriptions_acl "Gommunity/platform/subscriptions/interfaces/acl"
+)
+
+// ModerateReactionsPermission lets its holders remove other members' reactions.
+const ModerateReactionsPermission = subscriptions_acl.ModerateReactionsPermission
+
+// ExternalSubscriptionsService reads membership restrictions and permissions from the subscriptions bounded context.
+type ExternalSubscriptionsService struct {
+	subscriptionsFacade subscriptions_acl.SubscriptionsFacade
+}
+
+// NewExternalSubscriptionsService constructs the external subscriptions service.
+func NewExternalSubscriptionsService(subscriptionsFacade subscriptions_acl.SubscriptionsFacade) *ExternalSubscriptionsService {
+	return &ExternalSubscriptionsService{
+		subscriptionsFacade: subscriptionsFacade,
+	}
+}
+
+// IsUserMuted checks whether the user is currently muted in the community.
+func (s *ExternalSubscriptionsService) IsUserMuted(ctx context.Context, userID valueobjects.UserID, communityID string) (bool, error) {
+	muted, err := s.subscriptionsFacade.IsUserMuted(ctx, userID.Value(), communityID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check mute status: %w", err)
+	}
+	return muted, nil
+}
+
+// HasPermission checks whether the user holds the given permission in the community.
+func (s *ExternalSubscriptionsService) HasPermission(ctx context.Context, userID valueobjects.UserID, communityID string, permission string) (bool, error) {
+	allowed, err := s.subscriptionsFacade.HasPermission(ctx, userID.Value(), communityID, permission)
+	if err != nil {
+		return false, fmt.Errorf("failed to check permission: %w", err)
+	}
+	return allowed, nil
+}
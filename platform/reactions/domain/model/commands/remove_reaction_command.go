@@ -7,15 +7,18 @@ import (
 )
 
 // RemoveReactionCommand represents the intent to remove a user's reaction from a post.
+// The requester is either the user who reacted or a moderator removing someone else's reaction.
 type RemoveReactionCommand struct {
-	postID valueobjects.PostID
-	userID valueobjects.UserID
+	postID      valueobjects.PostID
+	userID      valueobjects.UserID
+	requestedBy valueobjects.UserID
 }
 
 // NewRemoveReactionCommand validates and builds a RemoveReactionCommand.
 func NewRemoveReactionCommand(
 	postID valueobjects.PostID,
 	userID valueobjects.UserID,
+	requestedBy valueobjects.UserID,
 ) (RemoveReactionCommand, error) {
 	if postID.IsZero() {
 		return RemoveReactionCommand{}, errors.New("post ID is required")
@@ -23,10 +26,14 @@ func NewRemoveReactionCommand(
 	if userID.IsZero() {
 		return RemoveReactionCommand{}, errors.New("user ID is required")
 	}
+	if requestedBy.IsZero() {
+		return RemoveReactionCommand{}, errors.New("requester ID is required")
+	}
 
 	return RemoveReactionCommand{
-		postID: postID,
-		userID: userID,
+		postID:      postID,
+		userID:      userID,
+		requestedBy: requestedBy,
 	}, nil
 }
 
@@ -39,3 +46,13 @@ func (c RemoveReactionCommand) PostID() valueobjects.PostID {
 func (c RemoveReactionCommand) UserID() valueobjects.UserID {
 	return c.userID
 }
+
+// RequestedBy returns the identifier of the user removing the reaction.
+func (c RemoveReactionCommand) RequestedBy() valueobjects.UserID {
+	return c.requestedBy
+}
+
+// IsModeration reports whether the requester removes another user's reaction.
+func (c RemoveReactionCommand) IsModeration() bool {
+	return c.requestedBy.Value() != c.userID.Value()
+}
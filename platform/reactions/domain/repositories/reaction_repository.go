@@ -20,4 +20,10 @@ type ReactionRepository interface {
 
 	// DeleteByPostIDs removes reactions linked to the provided posts
 	DeleteByPostIDs(ctx context.Context, postIDs []valueobjects.PostID) error
+
+	// ReassignUser moves every reaction of a user to another user ID
+	ReassignUser(ctx context.Context, from, to valueobjects.UserID) error
+
+	// DeleteByUser removes every reaction of a user
+	DeleteByUser(ctx context.Context, userID valueobjects.UserID) error
 }
@@ -223,6 +223,33 @@ func (r *reactionRepositoryImpl) DeleteByPostIDs(ctx context.Context, postIDs []
 	return nil
 }
 
+// ReassignUser moves every reaction of a user to another user ID
+func (r *reactionRepositoryImpl) ReassignUser(ctx context.Context, from, to valueobjects.UserID) error {
+	filter := bson.M{"user_id": from.Value()}
+	update := bson.M{"$set": bson.M{"user_id": to.Value()}}
+
+	_, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		log.Printf("failed to reassign reactions: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteByUser removes every reaction of a user
+func (r *reactionRepositoryImpl) DeleteByUser(ctx context.Context, userID valueobjects.UserID) error {
+	filter := bson.M{"user_id": userID.Value()}
+
+	_, err := r.collection.DeleteMany(ctx, filter)
+	if err != nil {
+		log.Printf("failed to delete reactions by user: %v", err)
+		return err
+	}
+
+	return nil
+}
+
 func (r *reactionRepositoryImpl) entityToDocument(reaction *entities.Reaction) *reactionDocument {
 	return &reactionDocument{
 		ID:           reaction.ReactionID().Value(),
@@ -0,0 +1,15 @@
+package acl
+
+import "context"
+
+// ReactionsFacade exposes reactions operations to other bounded contexts.
+type ReactionsFacade interface {
+	// AnonymizeUserReactions moves the reactions of a removed user to an anonymous user ID.
+	AnonymizeUserReactions(ctx context.Context, userID, anonymousID string) error
+
+	// DeleteReactionsByUser removes every reaction of a user.
+	DeleteReactionsByUser(ctx context.Context, userID string) error
+
+	// DeleteReactionsByPostIDs removes every reaction on the given posts.
+	DeleteReactionsByPostIDs(ctx context.Context, postIDs []string) error
+}
@@ -44,6 +44,7 @@ func NewReactionController(
 // @Success 201 {object} resources.ReactionResource
 // @Failure 400 {object} resources.ErrorResponse
 // @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
 // @Failure 404 {object} resources.ErrorResponse
 // @Failure 500 {object} resources.ErrorResponse
 // @Router /api/v1/posts/{post_id}/reactions [post]
@@ -105,15 +106,17 @@ func (c *ReactionController) AddReaction(ctx *gin.Context) {
 
 // RemoveReaction godoc
 // @Summary Remove a reaction from a post
-// @Description Allows a user to remove their reaction from a post.
+// @Description Allows a user to remove their reaction from a post. Members holding the moderate_reactions permission can pass user_id to remove another member's reaction.
 // @Tags reactions
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param post_id path string true "Post ID (ObjectID)"
+// @Param user_id query string false "User whose reaction is removed (defaults to the requester)"
 // @Success 204 "Reaction removed"
 // @Failure 400 {object} resources.ErrorResponse
 // @Failure 401 {object} resources.ErrorResponse
+// @Failure 403 {object} resources.ErrorResponse
 // @Failure 404 {object} resources.ErrorResponse
 // @Failure 500 {object} resources.ErrorResponse
 // @Router /api/v1/posts/{post_id}/reactions [delete]
@@ -132,13 +135,22 @@ func (c *ReactionController) RemoveReaction(ctx *gin.Context) {
 		return
 	}
 
-	userIDVO, err := valueobjects.NewUserID(userID)
+	requesterID, err := valueobjects.NewUserID(userID)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid user id"})
 		return
 	}
 
-	cmd, err := commands.NewRemoveReactionCommand(postID, userIDVO)
+	reactorID := requesterID
+	if target := ctx.Query("user_id"); target != "" {
+		reactorID, err = valueobjects.NewUserID(target)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: "invalid user id"})
+			return
+		}
+	}
+
+	cmd, err := commands.NewRemoveReactionCommand(postID, reactorID, requesterID)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, resources.ErrorResponse{Error: err.Error()})
 		return
@@ -259,9 +271,9 @@ func mapErrorToStatus(err error) int {
 	switch {
 	case strings.Contains(lower, "not found"):
 		return http.StatusNotFound
-	case strings.Contains(lower, "already"):
+	case strings.Contains(lower, "already") || strings.Contains(lower, "archived"):
 		return http.StatusConflict
-	case strings.Contains(lower, "not allowed"):
+	case strings.Contains(lower, "not allowed"), strings.Contains(lower, "muted"):
 		return http.StatusForbidden
 	default:
 		return http.StatusBadRequest
@@ -0,0 +1,45 @@
+package acl
+
+import (
+	"Gommunity/platform/search/domain/model/entities"
+	"context"
+
+	community_acl "Gommunity/platform/community/interfaces/acl"
+)
+
+// ExternalCommunitiesService provides ACL access to community context
+type ExternalCommunitiesService struct {
+	communitiesFacade community_acl.CommunitiesFacade
+}
+
+func NewExternalCommunitiesService(communitiesFacade community_acl.CommunitiesFacade) *ExternalCommunitiesService {
+	return &ExternalCommunitiesService{
+		communitiesFacade: communitiesFacade,
+	}
+}
+
+// SearchCommunities runs a full-text search on communities
+func (s *ExternalCommunitiesService) SearchCommunities(ctx context.Context, text string, limit, offset int) ([]*entities.CommunityResult, error) {
+	communitiesData, err := s.communitiesFacade.SearchCommunities(ctx, text, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*entities.CommunityResult, len(communitiesData))
+	for i, communityData := range communitiesData {
+		results[i] = entities.NewCommunityResult(
+			communityData.CommunityID,
+			communityData.Name,
+			communityData.Description,
+			communityData.IconURL,
+			communityData.IsPrivate,
+		)
+	}
+
+	return results, nil
+}
+
+// GetPublicCommunityIDs retrieves the IDs of every public community
+func (s *ExternalCommunitiesService) GetPublicCommunityIDs(ctx context.Context) ([]string, error) {
+	return s.communitiesFacade.GetPublicCommunityIDs(ctx)
+}
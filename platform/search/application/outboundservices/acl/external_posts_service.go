@@ -0,0 +1,42 @@
+package acl
+
+import (
+	"Gommunity/platform/search/domain/model/entities"
+	"context"
+
+	posts_acl "Gommunity/platform/posts/interfaces/acl"
+)
+
+// ExternalPostsService provides ACL access to posts context
+type ExternalPostsService struct {
+	postsFacade posts_acl.PostsFacade
+}
+
+func NewExternalPostsService(postsFacade posts_acl.PostsFacade) *ExternalPostsService {
+	return &ExternalPostsService{
+		postsFacade: postsFacade,
+	}
+}
+
+// SearchPosts runs a full-text search on the posts of the given communities
+func (s *ExternalPostsService) SearchPosts(ctx context.Context, text string, communityIDs []string, limit, offset int) ([]*entities.PostResult, error) {
+	postsData, err := s.postsFacade.SearchPosts(ctx, text, communityIDs, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*entities.PostResult, len(postsData))
+	for i, postData := range postsData {
+		results[i] = entities.NewPostResult(
+			postData.PostID,
+			postData.CommunityID,
+			postData.AuthorID,
+			postData.Content,
+			postData.MessageType,
+			postData.CreatedAt,
+			postData.UpdatedAt,
+		)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,86 @@
+package queryservices
+
+import (
+	"context"
+	"log"
+
+	"Gommunity/platform/search/application/outboundservices/acl"
+	"Gommunity/platform/search/domain/model/queries"
+	"Gommunity/platform/search/domain/services"
+)
+
+type searchQueryServiceImpl struct {
+	communitiesService   *acl.ExternalCommunitiesService
+	postsService         *acl.ExternalPostsService
+	subscriptionsService *acl.ExternalSubscriptionsService
+}
+
+func NewSearchQueryService(
+	communitiesService *acl.ExternalCommunitiesService,
+	postsService *acl.ExternalPostsService,
+	subscriptionsService *acl.ExternalSubscriptionsService,
+) services.SearchQueryService {
+	return &searchQueryServiceImpl{
+		communitiesService:   communitiesService,
+		postsService:         postsService,
+		subscriptionsService: subscriptionsService,
+	}
+}
+
+func (s *searchQueryServiceImpl) Handle(ctx context.Context, query queries.SearchQuery) (*services.SearchResults, error) {
+	log.Printf("Searching %q for user: %s", query.Text().Value(), query.UserID().Value())
+
+	results := &services.SearchResults{}
+
+	if query.Scope().IncludesCommunities() {
+		communities, err := s.communitiesService.SearchCommunities(ctx, query.Text().Value(), query.Limit(), query.Offset())
+		if err != nil {
+			log.Printf("Error searching communities: %v", err)
+			return nil, err
+		}
+		results.Communities = communities
+	}
+
+	if query.Scope().IncludesPosts() {
+		// Posts from private communities are only visible to their members
+		visibleCommunityIDs, err := s.visibleCommunityIDs(ctx, query.UserID().Value())
+		if err != nil {
+			return nil, err
+		}
+
+		posts, err := s.postsService.SearchPosts(ctx, query.Text().Value(), visibleCommunityIDs, query.Limit(), query.Offset())
+		if err != nil {
+			log.Printf("Error searching posts: %v", err)
+			return nil, err
+		}
+		results.Posts = posts
+	}
+
+	log.Printf("Found %d communities and %d posts", len(results.Communities), len(results.Posts))
+	return results, nil
+}
+
+// visibleCommunityIDs returns the public communities along with the communities the user belongs to
+func (s *searchQueryServiceImpl) visibleCommunityIDs(ctx context.Context, userID string) ([]string, error) {
+	publicIDs, err := s.communitiesService.GetPublicCommunityIDs(ctx)
+	if err != nil {
+		log.Printf("Error getting public communities: %v", err)
+		return nil, err
+	}
+
+	memberIDs, err := s.subscriptionsService.GetUserCommunities(ctx, userID)
+	if err != nil {
+		log.Printf("Error getting user communities: %v", err)
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(publicIDs)+len(memberIDs))
+	visible := make([]string, 0, len(publicIDs)+len(memberIDs))
+	for _, id := range append(publicIDs, memberIDs...) {
+		if !seen[id] {
+			seen[id] = true
+			visible = append(visible, id)
+		}
+	}
+	return visible, nil
+}
@@ -0,0 +1,48 @@
+package entities
+
+// CommunityResult represents a community matching a search
+type CommunityResult struct {
+	communityID string
+	name        string
+	description string
+	iconURL     *string
+	isPrivate   bool
+}
+
+// NewCommunityResult creates a new CommunityResult
+func NewCommunityResult(
+	communityID string,
+	name string,
+	description string,
+	iconURL *string,
+	isPrivate bool,
+) *CommunityResult {
+	return &CommunityResult{
+		communityID: communityID,
+		name:        name,
+		description: description,
+		iconURL:     iconURL,
+		isPrivate:   isPrivate,
+	}
+}
+
+// Getters
+func (c *CommunityResult) CommunityID() string {
+	return c.communityID
+}
+
+func (c *CommunityResult) Name() string {
+	return c.name
+}
+
+func (c *CommunityResult) Description() string {
+	return c.description
+}
+
+func (c *CommunityResult) IconURL() *string {
+	return c.iconURL
+}
+
+func (c *CommunityResult) IsPrivate() bool {
+	return c.isPrivate
+}
@@ -0,0 +1,64 @@
+package entities
+
+import "time"
+
+// PostResult represents a post matching a search
+type PostResult struct {
+	postID      string
+	communityID string
+	authorID    string
+	content     string
+	messageType string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewPostResult creates a new PostResult
+func NewPostResult(
+	postID string,
+	communityID string,
+	authorID string,
+	content string,
+	messageType string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *PostResult {
+	return &PostResult{
+		postID:      postID,
+		communityID: communityID,
+		authorID:    authorID,
+		content:     content,
+		messageType: messageType,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// Getters
+func (p *PostResult) PostID() string {
+	return p.postID
+}
+
+func (p *PostResult) CommunityID() string {
+	return p.communityID
+}
+
+func (p *PostResult) AuthorID() string {
+	return p.authorID
+}
+
+func (p *PostResult) Content() string {
+	return p.content
+}
+
+func (p *PostResult) MessageType() string {
+	return p.messageType
+}
+
+func (p *PostResult) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *PostResult) UpdatedAt() time.Time {
+	return p.updatedAt
+}
@@ -0,0 +1,56 @@
+package queries
+
+import (
+	"Gommunity/platform/search/domain/model/valueobjects"
+	"errors"
+)
+
+type SearchQuery struct {
+	userID valueobjects.UserID
+	text   valueobjects.SearchText
+	scope  valueobjects.SearchScope
+	limit  int
+	offset int
+}
+
+func NewSearchQuery(userID valueobjects.UserID, text valueobjects.SearchText) (SearchQuery, error) {
+	if userID.IsEmpty() {
+		return SearchQuery{}, errors.New("user ID cannot be empty")
+	}
+	if text.IsEmpty() {
+		return SearchQuery{}, errors.New("search text cannot be empty")
+	}
+	return SearchQuery{userID: userID, text: text, limit: 20}, nil
+}
+
+// WithScope restricts the search to communities or posts
+func (q SearchQuery) WithScope(scope valueobjects.SearchScope) SearchQuery {
+	q.scope = scope
+	return q
+}
+
+func (q SearchQuery) WithPagination(limit, offset int) SearchQuery {
+	q.limit = limit
+	q.offset = offset
+	return q
+}
+
+func (q SearchQuery) UserID() valueobjects.UserID {
+	return q.userID
+}
+
+func (q SearchQuery) Text() valueobjects.SearchText {
+	return q.text
+}
+
+func (q SearchQuery) Scope() valueobjects.SearchScope {
+	return q.scope
+}
+
+func (q SearchQuery) Limit() int {
+	return q.limit
+}
+
+func (q SearchQuery) Offset() int {
+	return q.offset
+}
@@ -0,0 +1,43 @@
+package valueobjects
+
+import "errors"
+
+const (
+	AllSearchScope         = "all"
+	CommunitiesSearchScope = "communities"
+	PostsSearchScope       = "posts"
+)
+
+// SearchScope selects which kinds of results a search returns
+type SearchScope struct {
+	value string
+}
+
+func NewSearchScope(value string) (SearchScope, error) {
+	switch value {
+	case AllSearchScope, CommunitiesSearchScope, PostsSearchScope:
+		return SearchScope{value: value}, nil
+	default:
+		return SearchScope{}, errors.New("type must be one of all, communities or posts")
+	}
+}
+
+func (s SearchScope) Value() string {
+	return s.value
+}
+
+func (s SearchScope) String() string {
+	return s.value
+}
+
+// IncludesCommunities reports whether communities are part of the results.
+// The zero scope behaves like all.
+func (s SearchScope) IncludesCommunities() bool {
+	return s.value != PostsSearchScope
+}
+
+// IncludesPosts reports whether posts are part of the results.
+// The zero scope behaves like all.
+func (s SearchScope) IncludesPosts() bool {
+	return s.value != CommunitiesSearchScope
+}
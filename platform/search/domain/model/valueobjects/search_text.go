@@ -0,0 +1,44 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	minSearchTextLength = 2
+	maxSearchTextLength = 100
+)
+
+// SearchText is the user supplied text a search runs against
+type SearchText struct {
+	value string
+}
+
+func NewSearchText(value string) (SearchText, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return SearchText{}, errors.New("search text cannot be empty")
+	}
+	length := utf8.RuneCountInString(trimmed)
+	if length < minSearchTextLength {
+		return SearchText{}, errors.New("search text is too short, at least 2 characters are required")
+	}
+	if length > maxSearchTextLength {
+		return SearchText{}, errors.New("search text cannot exceed 100 characters")
+	}
+	return SearchText{value: trimmed}, nil
+}
+
+func (s SearchText) Value() string {
+	return s.value
+}
+
+func (s SearchText) String() string {
+	return s.value
+}
+
+func (s SearchText) IsEmpty() bool {
+	return s.value == ""
+}
@@ -0,0 +1,17 @@
+package services
+
+import (
+	"Gommunity/platform/search/domain/model/entities"
+	"Gommunity/platform/search/domain/model/queries"
+	"context"
+)
+
+// SearchResults groups the matches of a search by kind, each most relevant first
+type SearchResults struct {
+	Communities []*entities.CommunityResult
+	Posts       []*entities.PostResult
+}
+
+type SearchQueryService interface {
+	Handle(ctx context.Context, query queries.SearchQuery) (*SearchResults, error)
+}
@@ -0,0 +1,139 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"Gommunity/platform/search/domain/model/queries"
+	"Gommunity/platform/search/domain/model/valueobjects"
+	"Gommunity/platform/search/domain/services"
+	"Gommunity/platform/search/interfaces/rest/resources"
+)
+
+const maxSearchLimit = 100
+
+type SearchController struct {
+	queryService services.SearchQueryService
+}
+
+func NewSearchController(queryService services.SearchQueryService) *SearchController {
+	return &SearchController{
+		queryService: queryService,
+	}
+}
+
+// Search runs a full-text search across communities and posts
+// @Summary Search communities and posts
+// @Description Full-text search on community name and description and on post content, most relevant first. Posts from private communities are only returned to their members. Pagination applies to each result kind.
+// @Tags search
+// @Accept json
+// @Produce json
+// @Param q query string true "Search text"
+// @Param type query string false "Result kinds" Enums(all, communities, posts) default(all)
+// @Param limit query int false "Number of items per page (max 100)" default(20)
+// @Param offset query int false "Page offset" default(0)
+// @Success 200 {object} resources.SearchResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/search [get]
+func (c *SearchController) Search(ctx *gin.Context) {
+	// Get user ID from JWT token
+	userID, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "invalid user ID format"})
+		return
+	}
+
+	// Parse pagination parameters
+	limit := 20
+	offset := 0
+
+	if limitParam := ctx.Query("limit"); limitParam != "" {
+		if parsedLimit, err := strconv.Atoi(limitParam); err == nil && parsedLimit > 0 {
+			limit = parsedLimit
+		}
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	if offsetParam := ctx.Query("offset"); offsetParam != "" {
+		if parsedOffset, err := strconv.Atoi(offsetParam); err == nil && parsedOffset >= 0 {
+			offset = parsedOffset
+		}
+	}
+
+	userIDVO, err := valueobjects.NewUserID(userIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	text, err := valueobjects.NewSearchText(ctx.Query("q"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	scope, err := valueobjects.NewSearchScope(ctx.DefaultQuery("type", valueobjects.AllSearchScope))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Create and execute query
+	query, err := queries.NewSearchQuery(userIDVO, text)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	query = query.WithScope(scope).WithPagination(limit, offset)
+
+	results, err := c.queryService.Handle(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to run search"})
+		return
+	}
+
+	// Transform to resources
+	communities := make([]resources.CommunityResultResource, len(results.Communities))
+	for i, community := range results.Communities {
+		communities[i] = resources.CommunityResultResource{
+			CommunityID: community.CommunityID(),
+			Name:        community.Name(),
+			Description: community.Description(),
+			IconURL:     community.IconURL(),
+			IsPrivate:   community.IsPrivate(),
+		}
+	}
+
+	posts := make([]resources.PostResultResource, len(results.Posts))
+	for i, post := range results.Posts {
+		posts[i] = resources.PostResultResource{
+			PostID:      post.PostID(),
+			CommunityID: post.CommunityID(),
+			AuthorID:    post.AuthorID(),
+			Content:     post.Content(),
+			MessageType: post.MessageType(),
+			CreatedAt:   post.CreatedAt(),
+			UpdatedAt:   post.UpdatedAt(),
+		}
+	}
+
+	ctx.JSON(http.StatusOK, resources.SearchResponse{
+		Query:       text.Value(),
+		Communities: communities,
+		Posts:       posts,
+	})
+}
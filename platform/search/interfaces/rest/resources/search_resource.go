@@ -0,0 +1,30 @@
+package resources
+
+import "time"
+
+// CommunityResultResource represents a community search match in the REST API
+type CommunityResultResource struct {
+	CommunityID string  `json:"communityId" example:"507f1f77bcf86cd799439012"`
+	Name        string  `json:"name" example:"Go Developers"`
+	Description string  `json:"description" example:"A community for Go enthusiasts"`
+	IconURL     *string `json:"iconUrl,omitempty" example:"https://example.com/icon.png"`
+	IsPrivate   bool    `json:"isPrivate" example:"false"`
+}
+
+// PostResultResource represents a post search match in the REST API
+type PostResultResource struct {
+	PostID      string    `json:"postId" example:"507f1f77bcf86cd799439011"`
+	CommunityID string    `json:"communityId" example:"507f1f77bcf86cd799439012"`
+	AuthorID    string    `json:"authorId" example:"user123"`
+	Content     string    `json:"content" example:"Generics in Go are finally here"`
+	MessageType string    `json:"messageType" example:"message"`
+	CreatedAt   time.Time `json:"createdAt" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt   time.Time `json:"updatedAt" example:"2023-01-01T00:00:00Z"`
+}
+
+// SearchResponse represents the search response, with matches grouped by kind
+type SearchResponse struct {
+	Query       string                    `json:"query" example:"golang"`
+	Communities []CommunityResultResource `json:"communities"`
+	Posts       []PostResultResource      `json:"posts"`
+}
@@ -2,22 +2,30 @@ package acl
 
 import (
 	"context"
+	"time"
 
 	"Gommunity/platform/subscriptions/domain/model/valueobjects"
 	"Gommunity/platform/subscriptions/domain/repositories"
+	"Gommunity/platform/subscriptions/domain/services"
 	"Gommunity/platform/subscriptions/interfaces/acl"
 )
 
 type subscriptionsFacadeImpl struct {
 	subscriptionRepository repositories.SubscriptionRepository
+	sanctionRepository     repositories.SanctionRepository
+	permissionService      services.PermissionService
 }
 
 // NewSubscriptionsFacade creates a new SubscriptionsFacade implementation.
 func NewSubscriptionsFacade(
 	subscriptionRepository repositories.SubscriptionRepository,
+	sanctionRepository repositories.SanctionRepository,
+	permissionService services.PermissionService,
 ) acl.SubscriptionsFacade {
 	return &subscriptionsFacadeImpl{
 		subscriptionRepository: subscriptionRepository,
+		sanctionRepository:     sanctionRepository,
+		permissionService:      permissionService,
 	}
 }
 
@@ -45,6 +53,26 @@ func (f *subscriptionsFacadeImpl) GetUserRoleInCommunity(ctx context.Context, us
 	return subscription.Role().Value(), nil
 }
 
+// HasPermission checks whether a user holds a permission within a community.
+func (f *subscriptionsFacadeImpl) HasPermission(ctx context.Context, userID string, communityID string, permission string) (bool, error) {
+	userIDVO, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return false, err
+	}
+
+	communityIDVO, err := valueobjects.NewCommunityID(communityID)
+	if err != nil {
+		return false, err
+	}
+
+	permissionVO, err := valueobjects.NewPermission(permission)
+	if err != nil {
+		return false, err
+	}
+
+	return f.permissionService.HasPermission(ctx, userIDVO, communityIDVO, permissionVO)
+}
+
 // IsUserSubscribed checks whether a user belongs to a community.
 func (f *subscriptionsFacadeImpl) IsUserSubscribed(ctx context.Context, userID string, communityID string) (bool, error) {
 	userIDVO, err := valueobjects.NewUserID(userID)
@@ -60,6 +88,26 @@ func (f *subscriptionsFacadeImpl) IsUserSubscribed(ctx context.Context, userID s
 	return f.subscriptionRepository.ExistsByUserAndCommunity(ctx, userIDVO, communityIDVO)
 }
 
+// IsUserMuted checks whether a mute still applies to the user in a community.
+func (f *subscriptionsFacadeImpl) IsUserMuted(ctx context.Context, userID string, communityID string) (bool, error) {
+	userIDVO, err := valueobjects.NewUserID(userID)
+	if err != nil {
+		return false, err
+	}
+
+	communityIDVO, err := valueobjects.NewCommunityID(communityID)
+	if err != nil {
+		return false, err
+	}
+
+	mute, err := f.sanctionRepository.FindActive(ctx, userIDVO, communityIDVO, valueobjects.MuteSanction, time.Now())
+	if err != nil {
+		return false, err
+	}
+
+	return mute != nil, nil
+}
+
 // GetUserCommunityIDs retrieves all community IDs that a user is subscribed to
 func (f *subscriptionsFacadeImpl) GetUserCommunityIDs(ctx context.Context, userID string) ([]string, error) {
 	userIDVO, err := valueobjects.NewUserID(userID)
@@ -79,3 +127,42 @@ func (f *subscriptionsFacadeImpl) GetUserCommunityIDs(ctx context.Context, userI
 
 	return communityIDs, nil
 }
+
+// CountMembersByCommunities counts the members of several communities at once
+func (f *subscriptionsFacadeImpl) CountMembersByCommunities(ctx context.Context, communityIDs []string) (map[string]int64, error) {
+	communityIDVOs := make([]valueobjects.CommunityID, 0, len(communityIDs))
+	for _, id := range communityIDs {
+		communityIDVO, err := valueobjects.NewCommunityID(id)
+		if err != nil {
+			return nil, err
+		}
+		communityIDVOs = append(communityIDVOs, communityIDVO)
+	}
+
+	return f.subscriptionRepository.CountByCommunityIDs(ctx, communityIDVOs)
+}
+
+// GetLongestStandingAdminID returns the admin of a community who joined first, other than the excluded user
+func (f *subscriptionsFacadeImpl) GetLongestStandingAdminID(ctx context.Context, communityID string, excludedUserID string) (string, error) {
+	communityIDVO, err := valueobjects.NewCommunityID(communityID)
+	if err != nil {
+		return "", err
+	}
+
+	adminRole := valueobjects.AdminRole
+	filter := repositories.SubscriptionFilter{Role: &adminRole, OldestFirst: true}
+	admins, err := f.subscriptionRepository.FindAllByCommunityID(ctx, communityIDVO, filter, nil, nil, valueobjects.Cursor{})
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	for _, admin := range admins {
+		if admin.UserID().Value() == excludedUserID || admin.IsExpired(now) {
+			continue
+		}
+		return admin.UserID().Value(), nil
+	}
+
+	return "", nil
+}
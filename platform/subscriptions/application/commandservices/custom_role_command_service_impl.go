@@ -0,0 +1,135 @@
+package commandservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Gommunity/platform/subscriptions/application/outboundservices/acl"
+	"Gommunity/platform/subscriptions/domain/model/commands"
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	"Gommunity/platform/subscriptions/domain/repositories"
+	"Gommunity/platform/subscriptions/domain/services"
+)
+
+type customRoleCommandServiceImpl struct {
+	customRoleRepo             repositories.CustomRoleRepository
+	subscriptionRepo           repositories.SubscriptionRepository
+	permissionService          services.PermissionService
+	externalCommunitiesService *acl.ExternalCommunitiesService
+}
+
+// NewCustomRoleCommandService creates a new CustomRoleCommandService implementation
+func NewCustomRoleCommandService(
+	customRoleRepo repositories.CustomRoleRepository,
+	subscriptionRepo repositories.SubscriptionRepository,
+	permissionService services.PermissionService,
+	externalCommunitiesService *acl.ExternalCommunitiesService,
+) services.CustomRoleCommandService {
+	return &customRoleCommandServiceImpl{
+		customRoleRepo:             customRoleRepo,
+		subscriptionRepo:           subscriptionRepo,
+		permissionService:          permissionService,
+		externalCommunitiesService: externalCommunitiesService,
+	}
+}
+
+// HandleCreate processes a CreateCustomRoleCommand to define a new role in a community
+func (s *customRoleCommandServiceImpl) HandleCreate(ctx context.Context, cmd commands.CreateCustomRoleCommand) (*entities.CustomRole, error) {
+	if err := s.ensureOwner(ctx, cmd.CommunityID(), cmd.RequestedBy()); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.customRoleRepo.ExistsByCommunityAndName(ctx, cmd.CommunityID(), cmd.Name())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing roles: %w", err)
+	}
+	if exists {
+		return nil, errors.New("role already exists in this community")
+	}
+
+	role, err := entities.NewCustomRole(cmd.CommunityID(), cmd.Name(), cmd.Permissions(), cmd.RequestedBy())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.customRoleRepo.Save(ctx, role); err != nil {
+		return nil, fmt.Errorf("failed to save role: %w", err)
+	}
+
+	return role, nil
+}
+
+// HandleUpdate processes an UpdateCustomRoleCommand to replace the permissions granted by a role
+func (s *customRoleCommandServiceImpl) HandleUpdate(ctx context.Context, cmd commands.UpdateCustomRoleCommand) (*entities.CustomRole, error) {
+	if err := s.ensureOwner(ctx, cmd.CommunityID(), cmd.RequestedBy()); err != nil {
+		return nil, err
+	}
+
+	role, err := s.customRoleRepo.FindByCommunityAndName(ctx, cmd.CommunityID(), cmd.Name())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find role: %w", err)
+	}
+	if role == nil {
+		return nil, errors.New("role not found")
+	}
+
+	role.UpdatePermissions(cmd.Permissions())
+
+	if err := s.customRoleRepo.Update(ctx, role); err != nil {
+		return nil, fmt.Errorf("failed to update role: %w", err)
+	}
+
+	return role, nil
+}
+
+// HandleDelete processes a DeleteCustomRoleCommand. Members holding the role have to be given another role first.
+func (s *customRoleCommandServiceImpl) HandleDelete(ctx context.Context, cmd commands.DeleteCustomRoleCommand) error {
+	if err := s.ensureOwner(ctx, cmd.CommunityID(), cmd.RequestedBy()); err != nil {
+		return err
+	}
+
+	exists, err := s.customRoleRepo.ExistsByCommunityAndName(ctx, cmd.CommunityID(), cmd.Name())
+	if err != nil {
+		return fmt.Errorf("failed to find role: %w", err)
+	}
+	if !exists {
+		return errors.New("role not found")
+	}
+
+	assigned, err := s.subscriptionRepo.ExistsByCommunityAndRole(ctx, cmd.CommunityID(), cmd.Name())
+	if err != nil {
+		return fmt.Errorf("failed to check role holders: %w", err)
+	}
+	if assigned {
+		return errors.New("role is still assigned to members of this community")
+	}
+
+	if err := s.customRoleRepo.Delete(ctx, cmd.CommunityID(), cmd.Name()); err != nil {
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+
+	return nil
+}
+
+// ensureOwner validates that the community exists and that the requester owns it
+func (s *customRoleCommandServiceImpl) ensureOwner(ctx context.Context, communityID valueobjects.CommunityID, userID valueobjects.UserID) error {
+	communityExists, err := s.externalCommunitiesService.ValidateCommunityExists(ctx, communityID)
+	if err != nil {
+		return fmt.Errorf("failed to validate community existence: %w", err)
+	}
+	if !communityExists {
+		return errors.New("community not found")
+	}
+
+	isOwner, err := s.permissionService.IsOwner(ctx, userID, communityID)
+	if err != nil {
+		return fmt.Errorf("failed to validate owner status: %w", err)
+	}
+	if !isOwner {
+		return errors.New("only the community owner can manage custom roles")
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+package commandservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"Gommunity/platform/subscriptions/application/outboundservices/acl"
+	"Gommunity/platform/subscriptions/domain/model/commands"
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	"Gommunity/platform/subscriptions/domain/repositories"
+	"Gommunity/platform/subscriptions/domain/services"
+)
+
+type invitationCommandServiceImpl struct {
+	invitationRepo             repositories.InvitationRepository
+	subscriptionRepo           repositories.SubscriptionRepository
+	sanctionRepo               repositories.SanctionRepository
+	customRoleRepo             repositories.CustomRoleRepository
+	permissionService          services.PermissionService
+	tokenService               services.InvitationTokenService
+	externalUsersService       *acl.ExternalUsersService
+	externalCommunitiesService *acl.ExternalCommunitiesService
+	defaultLifetime            time.Duration
+}
+
+// NewInvitationCommandService creates a new InvitationCommandService implementation.
+// defaultLifetime is used for invitations created without an explicit expiry.
+func NewInvitationCommandService(
+	invitationRepo repositories.InvitationRepository,
+	subscriptionRepo repositories.SubscriptionRepository,
+	sanctionRepo repositories.SanctionRepository,
+	customRoleRepo repositories.CustomRoleRepository,
+	permissionService services.PermissionService,
+	tokenService services.InvitationTokenService,
+	externalUsersService *acl.ExternalUsersService,
+	externalCommunitiesService *acl.ExternalCommunitiesService,
+	defaultLifetime time.Duration,
+) services.InvitationCommandService {
+	return &invitationCommandServiceImpl{
+		invitationRepo:             invitationRepo,
+		subscriptionRepo:           subscriptionRepo,
+		sanctionRepo:               sanctionRepo,
+		customRoleRepo:             customRoleRepo,
+		permissionService:          permissionService,
+		tokenService:               tokenService,
+		externalUsersService:       externalUsersService,
+		externalCommunitiesService: externalCommunitiesService,
+		defaultLifetime:            defaultLifetime,
+	}
+}
+
+// HandleCreate processes a CreateInvitationCommand. Holders of the manage_members permission can invite
+// with any role but admin; admins are invited by the owner, or by admins when the owner allows it.
+func (s *invitationCommandServiceImpl) HandleCreate(ctx context.Context, cmd commands.CreateInvitationCommand) (*valueobjects.InvitationID, error) {
+	// Step 1: Validate that the community exists
+	communityExists, err := s.externalCommunitiesService.ValidateCommunityExists(ctx, cmd.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate community existence: %w", err)
+	}
+	if !communityExists {
+		return nil, errors.New("community not found")
+	}
+	if err := ensureNotArchived(ctx, s.externalCommunitiesService, cmd.CommunityID()); err != nil {
+		return nil, err
+	}
+
+	// Step 2: Gate the granted role by the inviter's permissions
+	isOwner, err := s.permissionService.IsOwner(ctx, cmd.CreatedBy(), cmd.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate owner status: %w", err)
+	}
+	if !isOwner {
+		canManage, err := s.permissionService.HasPermission(ctx, cmd.CreatedBy(), cmd.CommunityID(), valueobjects.ManageMembersPermission)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check inviter permissions: %w", err)
+		}
+		if !canManage {
+			return nil, errors.New("only community owner or admins can manage invitations")
+		}
+		// Admin invitations follow the same rule as promoting a member to admin
+		if cmd.Role().IsAdmin() {
+			canManageAdmins, err := s.permissionService.CanManageAdmins(ctx, cmd.CreatedBy(), cmd.CommunityID())
+			if err != nil {
+				return nil, fmt.Errorf("failed to check inviter permissions: %w", err)
+			}
+			if !canManageAdmins {
+				return nil, services.ErrCannotManageAdmins
+			}
+		}
+	}
+
+	if cmd.Role().IsCustom() {
+		roleExists, err := s.customRoleRepo.ExistsByCommunityAndName(ctx, cmd.CommunityID(), cmd.Role())
+		if err != nil {
+			return nil, fmt.Errorf("failed to validate role existence: %w", err)
+		}
+		if !roleExists {
+			return nil, services.ErrRoleNotFound
+		}
+	}
+
+	// Step 3: Create and persist the invitation
+	expiresAt := time.Now().Add(s.defaultLifetime)
+	if cmd.ExpiresAt() != nil {
+		expiresAt = *cmd.ExpiresAt()
+	}
+
+	invitation, err := entities.NewInvitation(cmd.CommunityID(), cmd.CreatedBy(), cmd.Role(), cmd.MaxUses(), expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.invitationRepo.Save(ctx, invitation); err != nil {
+		return nil, fmt.Errorf("failed to save invitation: %w", err)
+	}
+
+	invitationID := invitation.InvitationID()
+	return &invitationID, nil
+}
+
+// HandleAccept processes an AcceptInvitationCommand and subscribes the user with the invitation role
+func (s *invitationCommandServiceImpl) HandleAccept(ctx context.Context, cmd commands.AcceptInvitationCommand) (*entities.Subscription, error) {
+	// Step 1: Check the token signature and load the invitation
+	invitationID, err := s.tokenService.Verify(cmd.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.invitationRepo.FindByID(ctx, invitationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find invitation: %w", err)
+	}
+	if invitation == nil {
+		return nil, errors.New("invitation not found")
+	}
+
+	now := time.Now()
+	if err := invitation.EnsureUsable(now); err != nil {
+		return nil, err
+	}
+	if err := ensureNotArchived(ctx, s.externalCommunitiesService, invitation.CommunityID()); err != nil {
+		return nil, err
+	}
+
+	// Step 2: Validate that the user exists, is not banned and is not a member yet
+	userExists, err := s.externalUsersService.ValidateUserExists(ctx, cmd.UserID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate user existence: %w", err)
+	}
+	if !userExists {
+		return nil, errors.New("user not found")
+	}
+
+	ban, err := s.sanctionRepo.FindActive(ctx, cmd.UserID(), invitation.CommunityID(), valueobjects.BanSanction, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bans: %w", err)
+	}
+	if ban != nil {
+		return nil, errors.New("user is banned from this community")
+	}
+
+	alreadySubscribed, err := s.subscriptionRepo.ExistsByUserAndCommunity(ctx, cmd.UserID(), invitation.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing subscription: %w", err)
+	}
+	if alreadySubscribed {
+		return nil, errors.New("user is already subscribed to this community")
+	}
+
+	// The custom role granted by the invitation may have been deleted since it was created
+	if invitation.Role().IsCustom() {
+		roleExists, err := s.customRoleRepo.ExistsByCommunityAndName(ctx, invitation.CommunityID(), invitation.Role())
+		if err != nil {
+			return nil, fmt.Errorf("failed to validate role existence: %w", err)
+		}
+		if !roleExists {
+			return nil, services.ErrRoleNotFound
+		}
+	}
+
+	// Step 3: Count the use before subscribing so concurrent accepts cannot exceed the limit
+	reserved, err := s.invitationRepo.ReserveUse(ctx, invitationID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to use invitation: %w", err)
+	}
+	if !reserved {
+		return nil, errors.New("invitation is no longer valid")
+	}
+
+	// Step 4: Subscribe the user. Invitations bypass the private community check.
+	subscription, err := entities.NewSubscription(cmd.UserID(), invitation.CommunityID(), invitation.Role())
+	if err == nil {
+		err = s.subscriptionRepo.Save(ctx, subscription)
+	}
+	if err != nil {
+		if releaseErr := s.invitationRepo.ReleaseUse(ctx, invitationID); releaseErr != nil {
+			return nil, fmt.Errorf("failed to save subscription: %w (releasing invitation use: %v)", err, releaseErr)
+		}
+		if errors.Is(err, repositories.ErrSubscriptionExists) {
+			return nil, errors.New("user is already subscribed to this community")
+		}
+		return nil, fmt.Errorf("failed to save subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// HandleRevoke processes a RevokeInvitationCommand so the invitation can no longer be accepted
+func (s *invitationCommandServiceImpl) HandleRevoke(ctx context.Context, cmd commands.RevokeInvitationCommand) error {
+	invitation, err := s.invitationRepo.FindByID(ctx, cmd.InvitationID())
+	if err != nil {
+		return fmt.Errorf("failed to find invitation: %w", err)
+	}
+	if invitation == nil {
+		return errors.New("invitation not found")
+	}
+
+	canManage, err := s.permissionService.HasPermission(ctx, cmd.RequestedBy(), invitation.CommunityID(), valueobjects.ManageMembersPermission)
+	if err != nil {
+		return fmt.Errorf("failed to check requester permissions: %w", err)
+	}
+	if !canManage {
+		return errors.New("only community owner or admins can manage invitations")
+	}
+
+	if err := invitation.Revoke(cmd.RequestedBy()); err != nil {
+		return err
+	}
+
+	if err := s.invitationRepo.Update(ctx, invitation); err != nil {
+		return fmt.Errorf("failed to update invitation: %w", err)
+	}
+
+	return nil
+}
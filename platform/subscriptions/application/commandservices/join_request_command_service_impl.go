@@ -0,0 +1,223 @@
+package commandservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"Gommunity/platform/subscriptions/application/outboundservices/acl"
+	"Gommunity/platform/subscriptions/domain/model/commands"
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	"Gommunity/platform/subscriptions/domain/repositories"
+	"Gommunity/platform/subscriptions/domain/services"
+)
+
+type joinRequestCommandServiceImpl struct {
+	joinRequestRepo            repositories.JoinRequestRepository
+	subscriptionRepo           repositories.SubscriptionRepository
+	sanctionRepo               repositories.SanctionRepository
+	permissionService          services.PermissionService
+	externalUsersService       *acl.ExternalUsersService
+	externalCommunitiesService *acl.ExternalCommunitiesService
+}
+
+// NewJoinRequestCommandService creates a new JoinRequestCommandService implementation
+func NewJoinRequestCommandService(
+	joinRequestRepo repositories.JoinRequestRepository,
+	subscriptionRepo repositories.SubscriptionRepository,
+	sanctionRepo repositories.SanctionRepository,
+	permissionService services.PermissionService,
+	externalUsersService *acl.ExternalUsersService,
+	externalCommunitiesService *acl.ExternalCommunitiesService,
+) services.JoinRequestCommandService {
+	return &joinRequestCommandServiceImpl{
+		joinRequestRepo:            joinRequestRepo,
+		subscriptionRepo:           subscriptionRepo,
+		sanctionRepo:               sanctionRepo,
+		permissionService:          permissionService,
+		externalUsersService:       externalUsersService,
+		externalCommunitiesService: externalCommunitiesService,
+	}
+}
+
+// HandleRequest processes a RequestToJoinCommand and opens a pending join request for a private community
+func (s *joinRequestCommandServiceImpl) HandleRequest(ctx context.Context, cmd commands.RequestToJoinCommand) (*valueobjects.JoinRequestID, error) {
+	// Step 1: Validate that the community exists
+	communityExists, err := s.externalCommunitiesService.ValidateCommunityExists(ctx, cmd.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate community existence: %w", err)
+	}
+	if !communityExists {
+		return nil, errors.New("community not found")
+	}
+	if err := ensureNotArchived(ctx, s.externalCommunitiesService, cmd.CommunityID()); err != nil {
+		return nil, err
+	}
+
+	// Step 2: Validate that the requesting user exists
+	userExists, err := s.externalUsersService.ValidateUserExists(ctx, cmd.UserID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate user existence: %w", err)
+	}
+	if !userExists {
+		return nil, errors.New("user not found")
+	}
+
+	// Step 3: Join requests are only needed for private communities
+	isPrivate, err := s.externalCommunitiesService.IsCommunityPrivate(ctx, cmd.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check community privacy: %w", err)
+	}
+	if !isPrivate {
+		return nil, errors.New("public communities can be joined directly")
+	}
+
+	// Step 4: The user must not be banned nor be a member already
+	banned, err := s.isBanned(ctx, cmd.UserID(), cmd.CommunityID())
+	if err != nil {
+		return nil, err
+	}
+	if banned {
+		return nil, errors.New("user is banned from this community")
+	}
+
+	alreadySubscribed, err := s.subscriptionRepo.ExistsByUserAndCommunity(ctx, cmd.UserID(), cmd.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing subscription: %w", err)
+	}
+	if alreadySubscribed {
+		return nil, errors.New("user is already subscribed to this community")
+	}
+
+	// Step 5: Only one pending request per user and community
+	pending, err := s.joinRequestRepo.FindPendingByUserAndCommunity(ctx, cmd.UserID(), cmd.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pending join requests: %w", err)
+	}
+	if pending != nil {
+		return nil, errors.New("join request already pending for this community")
+	}
+
+	// Step 6: Create and persist the join request
+	joinRequest, err := entities.NewJoinRequest(cmd.UserID(), cmd.CommunityID(), cmd.Message())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create join request entity: %w", err)
+	}
+
+	if err := s.joinRequestRepo.Save(ctx, joinRequest); err != nil {
+		if err.Error() == "join request already pending for this community" {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to save join request: %w", err)
+	}
+
+	joinRequestID := joinRequest.JoinRequestID()
+	return &joinRequestID, nil
+}
+
+// HandleReview processes a ReviewJoinRequestCommand. Approving a request subscribes the user as a member.
+func (s *joinRequestCommandServiceImpl) HandleReview(ctx context.Context, cmd commands.ReviewJoinRequestCommand) error {
+	// Step 1: Load the join request
+	joinRequest, err := s.joinRequestRepo.FindByID(ctx, cmd.JoinRequestID())
+	if err != nil {
+		return fmt.Errorf("failed to find join request: %w", err)
+	}
+	if joinRequest == nil {
+		return errors.New("join request not found")
+	}
+
+	// Step 2: Verify the reviewer is the community owner or an admin
+	canReview, err := s.permissionService.HasPermission(ctx, cmd.ReviewedBy(), joinRequest.CommunityID(), valueobjects.ManageMembersPermission)
+	if err != nil {
+		return fmt.Errorf("failed to check reviewer permissions: %w", err)
+	}
+	if !canReview {
+		return errors.New("only community owner or admins can review join requests")
+	}
+
+	// Step 3: Reject is a plain state change
+	if !cmd.Approve() {
+		if err := joinRequest.Reject(cmd.ReviewedBy()); err != nil {
+			return err
+		}
+		if err := s.joinRequestRepo.Update(ctx, joinRequest); err != nil {
+			return fmt.Errorf("failed to update join request: %w", err)
+		}
+		return nil
+	}
+
+	// Step 4: Approve the request and subscribe the user as a member.
+	// A user added by an admin in the meantime keeps their existing subscription.
+	if err := ensureNotArchived(ctx, s.externalCommunitiesService, joinRequest.CommunityID()); err != nil {
+		return err
+	}
+
+	banned, err := s.isBanned(ctx, joinRequest.UserID(), joinRequest.CommunityID())
+	if err != nil {
+		return err
+	}
+	if banned {
+		return errors.New("user is banned from this community")
+	}
+
+	if err := joinRequest.Approve(cmd.ReviewedBy()); err != nil {
+		return err
+	}
+
+	alreadySubscribed, err := s.subscriptionRepo.ExistsByUserAndCommunity(ctx, joinRequest.UserID(), joinRequest.CommunityID())
+	if err != nil {
+		return fmt.Errorf("failed to check existing subscription: %w", err)
+	}
+
+	if !alreadySubscribed {
+		subscription, err := entities.NewSubscription(joinRequest.UserID(), joinRequest.CommunityID(), valueobjects.MemberRole)
+		if err != nil {
+			return fmt.Errorf("failed to create subscription entity: %w", err)
+		}
+		if err := s.subscriptionRepo.Save(ctx, subscription); err != nil {
+			return fmt.Errorf("failed to save subscription: %w", err)
+		}
+	}
+
+	if err := s.joinRequestRepo.Update(ctx, joinRequest); err != nil {
+		return fmt.Errorf("failed to update join request: %w", err)
+	}
+
+	return nil
+}
+
+// HandleWithdraw processes a WithdrawJoinRequestCommand to cancel the user's own pending request
+func (s *joinRequestCommandServiceImpl) HandleWithdraw(ctx context.Context, cmd commands.WithdrawJoinRequestCommand) error {
+	joinRequest, err := s.joinRequestRepo.FindByID(ctx, cmd.JoinRequestID())
+	if err != nil {
+		return fmt.Errorf("failed to find join request: %w", err)
+	}
+	if joinRequest == nil {
+		return errors.New("join request not found")
+	}
+
+	if !joinRequest.UserID().Equals(cmd.RequestedBy()) {
+		return errors.New("users can only withdraw their own join requests")
+	}
+
+	if err := joinRequest.Withdraw(); err != nil {
+		return err
+	}
+
+	if err := s.joinRequestRepo.Update(ctx, joinRequest); err != nil {
+		return fmt.Errorf("failed to update join request: %w", err)
+	}
+
+	return nil
+}
+
+// isBanned checks whether the user is under a ban that still applies in the community
+func (s *joinRequestCommandServiceImpl) isBanned(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID) (bool, error) {
+	ban, err := s.sanctionRepo.FindActive(ctx, userID, communityID, valueobjects.BanSanction, time.Now())
+	if err != nil {
+		return false, fmt.Errorf("failed to check bans: %w", err)
+	}
+	return ban != nil, nil
+}
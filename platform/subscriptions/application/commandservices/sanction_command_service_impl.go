@@ -0,0 +1,189 @@
+package commandservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"Gommunity/platform/subscriptions/application/outboundservices/acl"
+	"Gommunity/platform/subscriptions/domain/model/commands"
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	"Gommunity/platform/subscriptions/domain/repositories"
+	"Gommunity/platform/subscriptions/domain/services"
+)
+
+type sanctionCommandServiceImpl struct {
+	sanctionRepo               repositories.SanctionRepository
+	subscriptionRepo           repositories.SubscriptionRepository
+	joinRequestRepo            repositories.JoinRequestRepository
+	permissionService          services.PermissionService
+	externalUsersService       *acl.ExternalUsersService
+	externalCommunitiesService *acl.ExternalCommunitiesService
+}
+
+// NewSanctionCommandService creates a new SanctionCommandService implementation
+func NewSanctionCommandService(
+	sanctionRepo repositories.SanctionRepository,
+	subscriptionRepo repositories.SubscriptionRepository,
+	joinRequestRepo repositories.JoinRequestRepository,
+	permissionService services.PermissionService,
+	externalUsersService *acl.ExternalUsersService,
+	externalCommunitiesService *acl.ExternalCommunitiesService,
+) services.SanctionCommandService {
+	return &sanctionCommandServiceImpl{
+		sanctionRepo:               sanctionRepo,
+		subscriptionRepo:           subscriptionRepo,
+		joinRequestRepo:            joinRequestRepo,
+		permissionService:          permissionService,
+		externalUsersService:       externalUsersService,
+		externalCommunitiesService: externalCommunitiesService,
+	}
+}
+
+// HandleSanction processes a SanctionMemberCommand to ban or mute a user.
+// The owner can sanction anyone but themselves. Holders of the manage_members permission can sanction members;
+// admins can also sanction other admins when the owner allows it.
+func (s *sanctionCommandServiceImpl) HandleSanction(ctx context.Context, cmd commands.SanctionMemberCommand) (*entities.Sanction, error) {
+	// Step 1: Validate that the community and the target user exist
+	communityExists, err := s.externalCommunitiesService.ValidateCommunityExists(ctx, cmd.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate community existence: %w", err)
+	}
+	if !communityExists {
+		return nil, errors.New("community not found")
+	}
+
+	userExists, err := s.externalUsersService.ValidateUserExists(ctx, cmd.UserID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate user existence: %w", err)
+	}
+	if !userExists {
+		return nil, errors.New("user not found")
+	}
+
+	// Step 2: Nobody can sanction themselves, and the owner cannot be sanctioned at all
+	if cmd.IsSelfSanction() {
+		return nil, errors.New("users cannot ban or mute themselves")
+	}
+
+	targetIsOwner, err := s.permissionService.IsOwner(ctx, cmd.UserID(), cmd.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate owner status: %w", err)
+	}
+
+	subscription, err := s.subscriptionRepo.FindByUserAndCommunity(ctx, cmd.UserID(), cmd.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find subscription: %w", err)
+	}
+	if targetIsOwner || (subscription != nil && subscription.Role().IsOwner()) {
+		return nil, errors.New("the community owner cannot be banned or muted")
+	}
+
+	// Step 3: Check the issuer's permission
+	issuerIsOwner, err := s.permissionService.IsOwner(ctx, cmd.IssuedBy(), cmd.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate owner status: %w", err)
+	}
+
+	if !issuerIsOwner {
+		canManage, err := s.permissionService.HasPermission(ctx, cmd.IssuedBy(), cmd.CommunityID(), valueobjects.ManageMembersPermission)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check issuer permissions: %w", err)
+		}
+		if !canManage {
+			return nil, errors.New("only community owner or admins can ban or mute members")
+		}
+
+		if subscription != nil && subscription.Role().IsAdmin() {
+			canManageAdmins, err := s.permissionService.CanManageAdmins(ctx, cmd.IssuedBy(), cmd.CommunityID())
+			if err != nil {
+				return nil, fmt.Errorf("failed to check issuer permissions: %w", err)
+			}
+			if !canManageAdmins {
+				return nil, services.ErrCannotManageAdmins
+			}
+		}
+	}
+
+	// Step 4: Build the sanction. A mute keeps the user in the community, so they must be a member.
+	var sanction *entities.Sanction
+	if cmd.SanctionType().IsMute() {
+		if subscription == nil {
+			return nil, errors.New("only community members can be muted")
+		}
+		sanction, err = entities.NewMute(cmd.UserID(), cmd.CommunityID(), cmd.IssuedBy(), cmd.Reason(), *cmd.ExpiresAt())
+	} else {
+		sanction, err = entities.NewBan(cmd.UserID(), cmd.CommunityID(), cmd.IssuedBy(), cmd.Reason(), cmd.ExpiresAt())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sanctionRepo.Save(ctx, sanction); err != nil {
+		return nil, fmt.Errorf("failed to save sanction: %w", err)
+	}
+
+	if !sanction.Type().IsBan() {
+		return sanction, nil
+	}
+
+	// Step 5: A banned user leaves the community and their pending join request is rejected
+	if subscription != nil {
+		if err := s.subscriptionRepo.DeleteByUserAndCommunity(ctx, cmd.UserID(), cmd.CommunityID()); err != nil {
+			return nil, fmt.Errorf("failed to delete subscription: %w", err)
+		}
+	}
+
+	pending, err := s.joinRequestRepo.FindPendingByUserAndCommunity(ctx, cmd.UserID(), cmd.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pending join requests: %w", err)
+	}
+	if pending != nil {
+		if err := pending.Reject(cmd.IssuedBy()); err != nil {
+			return nil, err
+		}
+		if err := s.joinRequestRepo.Update(ctx, pending); err != nil {
+			return nil, fmt.Errorf("failed to update join request: %w", err)
+		}
+	}
+
+	return sanction, nil
+}
+
+// HandleLift processes a LiftSanctionCommand to end a ban or a mute before it expires
+func (s *sanctionCommandServiceImpl) HandleLift(ctx context.Context, cmd commands.LiftSanctionCommand) error {
+	communityExists, err := s.externalCommunitiesService.ValidateCommunityExists(ctx, cmd.CommunityID())
+	if err != nil {
+		return fmt.Errorf("failed to validate community existence: %w", err)
+	}
+	if !communityExists {
+		return errors.New("community not found")
+	}
+
+	canModerate, err := s.permissionService.HasPermission(ctx, cmd.RequestedBy(), cmd.CommunityID(), valueobjects.ManageMembersPermission)
+	if err != nil {
+		return fmt.Errorf("failed to check requester permissions: %w", err)
+	}
+	if !canModerate {
+		return errors.New("only community owner or admins can ban or mute members")
+	}
+
+	sanction, err := s.sanctionRepo.FindActive(ctx, cmd.UserID(), cmd.CommunityID(), cmd.SanctionType(), time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to find sanction: %w", err)
+	}
+	if sanction == nil {
+		if cmd.SanctionType().IsMute() {
+			return errors.New("user is not muted in this community")
+		}
+		return errors.New("user is not banned from this community")
+	}
+
+	if err := s.sanctionRepo.Delete(ctx, cmd.UserID(), cmd.CommunityID(), cmd.SanctionType()); err != nil {
+		return fmt.Errorf("failed to delete sanction: %w", err)
+	}
+
+	return nil
+}
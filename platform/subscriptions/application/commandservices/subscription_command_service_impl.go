@@ -4,17 +4,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
+	"time"
 
 	"Gommunity/platform/subscriptions/application/outboundservices/acl"
 	"Gommunity/platform/subscriptions/domain/model/commands"
 	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/events"
 	"Gommunity/platform/subscriptions/domain/model/valueobjects"
 	"Gommunity/platform/subscriptions/domain/repositories"
 	"Gommunity/platform/subscriptions/domain/services"
 )
 
+// expireDueBatchSize bounds how many expired subscriptions are loaded at once by the expiry sweeper.
+const expireDueBatchSize = 100
+
 type subscriptionCommandServiceImpl struct {
 	subscriptionRepo           repositories.SubscriptionRepository
+	joinRequestRepo            repositories.JoinRequestRepository
+	invitationRepo             repositories.InvitationRepository
+	sanctionRepo               repositories.SanctionRepository
+	customRoleRepo             repositories.CustomRoleRepository
+	permissionService          services.PermissionService
 	externalUsersService       *acl.ExternalUsersService
 	externalCommunitiesService *acl.ExternalCommunitiesService
 }
@@ -22,11 +33,21 @@ type subscriptionCommandServiceImpl struct {
 // NewSubscriptionCommandService creates a new SubscriptionCommandService implementation
 func NewSubscriptionCommandService(
 	subscriptionRepo repositories.SubscriptionRepository,
+	joinRequestRepo repositories.JoinRequestRepository,
+	invitationRepo repositories.InvitationRepository,
+	sanctionRepo repositories.SanctionRepository,
+	customRoleRepo repositories.CustomRoleRepository,
+	permissionService services.PermissionService,
 	externalUsersService *acl.ExternalUsersService,
 	externalCommunitiesService *acl.ExternalCommunitiesService,
 ) services.SubscriptionCommandService {
 	return &subscriptionCommandServiceImpl{
 		subscriptionRepo:           subscriptionRepo,
+		joinRequestRepo:            joinRequestRepo,
+		invitationRepo:             invitationRepo,
+		sanctionRepo:               sanctionRepo,
+		customRoleRepo:             customRoleRepo,
+		permissionService:          permissionService,
 		externalUsersService:       externalUsersService,
 		externalCommunitiesService: externalCommunitiesService,
 	}
@@ -42,6 +63,9 @@ func (s *subscriptionCommandServiceImpl) Handle(ctx context.Context, cmd command
 	if !communityExists {
 		return nil, errors.New("community not found")
 	}
+	if err := ensureNotArchived(ctx, s.externalCommunitiesService, cmd.CommunityID()); err != nil {
+		return nil, err
+	}
 
 	// Step 2: Validate that the user to be subscribed exists
 	userExists, err := s.externalUsersService.ValidateUserExists(ctx, cmd.UserID())
@@ -94,29 +118,30 @@ func (s *subscriptionCommandServiceImpl) Handle(ctx context.Context, cmd command
 
 	// Step 7: Apply business rules based on community privacy
 	if isPrivate {
-		// In private communities, only owner/admin can add users
-		if !cmd.IsSelfSubscription() {
-			// Verify the requester has permission to add users
-			requesterSubscription, err := s.subscriptionRepo.FindByUserAndCommunity(ctx, cmd.RequestedBy(), cmd.CommunityID())
-			if err != nil {
-				return nil, fmt.Errorf("failed to check requester permissions: %w", err)
-			}
-
-			// Check if requester is the community owner (supports owner stored as userID or profileID)
-			isOwner, err := s.isOwner(ctx, cmd.CommunityID(), cmd.RequestedBy())
+		if cmd.IsSelfSubscription() {
+			// Only the community owner may subscribe themselves (done on community creation).
+			// Everyone else has to go through a join request.
+			isOwner, err := s.permissionService.IsOwner(ctx, cmd.UserID(), cmd.CommunityID())
 			if err != nil {
 				return nil, fmt.Errorf("failed to validate owner status: %w", err)
 			}
-
-			// Requester must be owner OR have admin role in the community
-			hasPermission := isOwner
-			if requesterSubscription != nil && requesterSubscription.HasAdminOrOwnerRole() {
-				hasPermission = true
+			if !isOwner {
+				return nil, errors.New("private communities require an approved join request")
+			}
+		} else {
+			// In private communities, only users allowed to manage members can add users
+			hasPermission, err := s.permissionService.HasPermission(ctx, cmd.RequestedBy(), cmd.CommunityID(), valueobjects.ManageMembersPermission)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check requester permissions: %w", err)
 			}
 
 			if !hasPermission {
 				return nil, errors.New("only community owner or admins can add users to private communities")
 			}
+
+			if err := s.ensureCanGrantRole(ctx, cmd.CommunityID(), cmd.RequestedBy(), actualRole); err != nil {
+				return nil, err
+			}
 		}
 	} else {
 		// In public communities, users can only subscribe themselves
@@ -125,7 +150,28 @@ func (s *subscriptionCommandServiceImpl) Handle(ctx context.Context, cmd command
 		}
 	}
 
-	// Step 8: Check if the user is already subscribed to this community
+	// Step 8: Only users adding someone else can give the membership an expiry
+	if cmd.ExpiresAt() != nil {
+		if cmd.IsSelfSubscription() {
+			return nil, errors.New("users cannot set an expiry on their own membership")
+		}
+		if cmd.AlumniRole() != nil {
+			if err := s.ensureRoleDefined(ctx, cmd.CommunityID(), *cmd.AlumniRole()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Step 9: Banned users cannot come back until the ban expires or is lifted
+	ban, err := s.sanctionRepo.FindActive(ctx, cmd.UserID(), cmd.CommunityID(), valueobjects.BanSanction, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bans: %w", err)
+	}
+	if ban != nil {
+		return nil, errors.New("user is banned from this community")
+	}
+
+	// Step 10: Check if the user is already subscribed to this community
 	alreadySubscribed, err := s.subscriptionRepo.ExistsByUserAndCommunity(ctx, cmd.UserID(), cmd.CommunityID())
 	if err != nil {
 		return nil, fmt.Errorf("failed to check existing subscription: %w", err)
@@ -134,7 +180,7 @@ func (s *subscriptionCommandServiceImpl) Handle(ctx context.Context, cmd command
 		return nil, errors.New("user is already subscribed to this community")
 	}
 
-	// Step 9: Create the subscription entity with the actual role (not the requested role)
+	// Step 11: Create the subscription entity with the actual role (not the requested role)
 	subscription, err := entities.NewSubscription(
 		cmd.UserID(),
 		cmd.CommunityID(),
@@ -143,8 +189,13 @@ func (s *subscriptionCommandServiceImpl) Handle(ctx context.Context, cmd command
 	if err != nil {
 		return nil, fmt.Errorf("failed to create subscription entity: %w", err)
 	}
+	if cmd.ExpiresAt() != nil {
+		if err := subscription.SetExpiry(*cmd.ExpiresAt(), cmd.AlumniRole()); err != nil {
+			return nil, err
+		}
+	}
 
-	// Step 10: Persist the subscription
+	// Step 12: Persist the subscription
 	if err := s.subscriptionRepo.Save(ctx, subscription); err != nil {
 		return nil, fmt.Errorf("failed to save subscription: %w", err)
 	}
@@ -153,6 +204,156 @@ func (s *subscriptionCommandServiceImpl) Handle(ctx context.Context, cmd command
 	return &subscriptionID, nil
 }
 
+// HandleImport processes an ImportMembersCommand to subscribe many users at once.
+// Only users allowed to manage members can import, and only into private communities since users join public
+// communities themselves; each row is then checked like a single add and reported on its own.
+func (s *subscriptionCommandServiceImpl) HandleImport(ctx context.Context, cmd commands.ImportMembersCommand) ([]services.MemberImportResult, error) {
+	communityExists, err := s.externalCommunitiesService.ValidateCommunityExists(ctx, cmd.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate community existence: %w", err)
+	}
+	if !communityExists {
+		return nil, errors.New("community not found")
+	}
+	if err := ensureNotArchived(ctx, s.externalCommunitiesService, cmd.CommunityID()); err != nil {
+		return nil, err
+	}
+
+	hasPermission, err := s.permissionService.HasPermission(ctx, cmd.RequestedBy(), cmd.CommunityID(), valueobjects.ManageMembersPermission)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check requester permissions: %w", err)
+	}
+	if !hasPermission {
+		return nil, errors.New("only community owner or admins can import members")
+	}
+
+	// Same rule as a single add: nobody is added to a public community but by themselves
+	isPrivate, err := s.externalCommunitiesService.IsCommunityPrivate(ctx, cmd.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check community privacy: %w", err)
+	}
+	if !isPrivate {
+		return nil, errors.New("members can only be imported into private communities")
+	}
+
+	results := make([]services.MemberImportResult, 0, len(cmd.Rows()))
+	for _, row := range cmd.Rows() {
+		result, err := s.importRow(ctx, cmd.CommunityID(), cmd.RequestedBy(), row)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// importRow subscribes the user of one import row. Problems with the row are reported in the result;
+// only infrastructure failures are returned as errors.
+func (s *subscriptionCommandServiceImpl) importRow(ctx context.Context, communityID valueobjects.CommunityID, requestedBy valueobjects.UserID, row commands.MemberImportRow) (services.MemberImportResult, error) {
+	result := services.MemberImportResult{
+		Line:     row.Line(),
+		UserID:   row.UserID(),
+		Username: row.Username(),
+		Role:     row.Role(),
+	}
+
+	// Resolve the user, by ID when given and by username otherwise
+	var userID valueobjects.UserID
+	if row.UserID() != "" {
+		id, err := valueobjects.NewUserID(row.UserID())
+		if err != nil {
+			result.Status = services.MemberImportUserNotFound
+			return result, nil
+		}
+		exists, err := s.externalUsersService.ValidateUserExists(ctx, id)
+		if err != nil {
+			return result, fmt.Errorf("failed to validate user existence: %w", err)
+		}
+		if !exists {
+			result.Status = services.MemberImportUserNotFound
+			return result, nil
+		}
+		userID = id
+	} else {
+		if row.Username() == "" {
+			result.Status = services.MemberImportUserNotFound
+			return result, nil
+		}
+		id, err := s.externalUsersService.FetchUserIDByUsername(ctx, row.Username())
+		if err != nil {
+			return result, fmt.Errorf("failed to find user by username: %w", err)
+		}
+		if id == nil {
+			result.Status = services.MemberImportUserNotFound
+			return result, nil
+		}
+		userID = *id
+		result.UserID = userID.Value()
+	}
+
+	// Rows without a role join as members
+	role := valueobjects.MemberRole
+	if row.Role() != "" {
+		parsed, err := valueobjects.NewCommunityRole(row.Role())
+		if err != nil {
+			result.Status = services.MemberImportInvalidRole
+			result.Reason = err.Error()
+			return result, nil
+		}
+		role = parsed
+	}
+	result.Role = role.Value()
+
+	if err := s.ensureCanGrantRole(ctx, communityID, requestedBy, role); err != nil {
+		switch {
+		case errors.Is(err, services.ErrRoleNotGrantable), errors.Is(err, services.ErrRoleNotFound):
+			result.Status = services.MemberImportInvalidRole
+		case errors.Is(err, services.ErrCannotManageAdmins):
+			result.Status = services.MemberImportRejected
+		default:
+			return result, err
+		}
+		result.Reason = err.Error()
+		return result, nil
+	}
+
+	alreadySubscribed, err := s.subscriptionRepo.ExistsByUserAndCommunity(ctx, userID, communityID)
+	if err != nil {
+		return result, fmt.Errorf("failed to check existing subscription: %w", err)
+	}
+	if alreadySubscribed {
+		result.Status = services.MemberImportAlreadySubscribed
+		return result, nil
+	}
+
+	ban, err := s.sanctionRepo.FindActive(ctx, userID, communityID, valueobjects.BanSanction, time.Now())
+	if err != nil {
+		return result, fmt.Errorf("failed to check bans: %w", err)
+	}
+	if ban != nil {
+		result.Status = services.MemberImportRejected
+		result.Reason = "user is banned from this community"
+		return result, nil
+	}
+
+	subscription, err := entities.NewSubscription(userID, communityID, role)
+	if err != nil {
+		return result, fmt.Errorf("failed to create subscription entity: %w", err)
+	}
+	if err := s.subscriptionRepo.Save(ctx, subscription); err != nil {
+		// A concurrent add of the same user loses the race on the unique index
+		if errors.Is(err, repositories.ErrSubscriptionExists) {
+			result.Status = services.MemberImportAlreadySubscribed
+			return result, nil
+		}
+		return result, fmt.Errorf("failed to save subscription: %w", err)
+	}
+
+	result.Status = services.MemberImportCreated
+	return result, nil
+}
+
 // HandleUnsubscribe processes an UnsubscribeUserCommand to remove a user from a community
 func (s *subscriptionCommandServiceImpl) HandleUnsubscribe(ctx context.Context, cmd commands.UnsubscribeUserCommand) error {
 	// Step 1: Validate that the community exists
@@ -176,30 +377,18 @@ func (s *subscriptionCommandServiceImpl) HandleUnsubscribe(ctx context.Context,
 	// Step 3: Check if the requester has permission to remove this subscription
 	if !cmd.IsSelfUnsubscription() {
 		// If it's not self-unsubscription, verify the requester has permission
-		requesterSubscription, err := s.subscriptionRepo.FindByUserAndCommunity(ctx, cmd.RequestedBy(), cmd.CommunityID())
+		hasPermission, err := s.permissionService.HasPermission(ctx, cmd.RequestedBy(), cmd.CommunityID(), valueobjects.ManageMembersPermission)
 		if err != nil {
 			return fmt.Errorf("failed to check requester permissions: %w", err)
 		}
 
-		// Check if requester is the community owner (supports owner stored as userID or profileID)
-		isOwner, err := s.isOwner(ctx, cmd.CommunityID(), cmd.RequestedBy())
-		if err != nil {
-			return fmt.Errorf("failed to validate owner status: %w", err)
-		}
-
-		// Requester must be owner OR have admin role in the community
-		hasPermission := isOwner
-		if requesterSubscription != nil && requesterSubscription.HasAdminOrOwnerRole() {
-			hasPermission = true
-		}
-
 		if !hasPermission {
 			return errors.New("only community owner, admins, or the user themselves can remove subscriptions")
 		}
 	}
 
 	// Step 4: Prevent owner from unsubscribing (owner should always be subscribed)
-	isOwner, err := s.isOwner(ctx, cmd.CommunityID(), cmd.UserID())
+	isOwner, err := s.permissionService.IsOwner(ctx, cmd.UserID(), cmd.CommunityID())
 	if err != nil {
 		return fmt.Errorf("failed to validate owner status: %w", err)
 	}
@@ -215,27 +404,344 @@ func (s *subscriptionCommandServiceImpl) HandleUnsubscribe(ctx context.Context,
 	return nil
 }
 
-// HandleDeleteByCommunity removes all subscriptions for a given community
-func (s *subscriptionCommandServiceImpl) HandleDeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error {
-	return s.subscriptionRepo.DeleteByCommunity(ctx, communityID)
+// HandleChangeRole processes a ChangeMemberRoleCommand to promote or demote a subscribed user.
+// The owner manages every role. Holders of the manage_members permission manage members and custom roles;
+// admins can also manage other admins when the owner allows it.
+func (s *subscriptionCommandServiceImpl) HandleChangeRole(ctx context.Context, cmd commands.ChangeMemberRoleCommand) error {
+	// Step 1: Validate that the community exists
+	communityExists, err := s.externalCommunitiesService.ValidateCommunityExists(ctx, cmd.CommunityID())
+	if err != nil {
+		return fmt.Errorf("failed to validate community existence: %w", err)
+	}
+	if !communityExists {
+		return errors.New("community not found")
+	}
+
+	// Step 2: Ownership is never granted or taken away through a role change
+	if cmd.NewRole().IsOwner() {
+		return errors.New("the owner role cannot be assigned through a role change")
+	}
+
+	if err := s.ensureRoleDefined(ctx, cmd.CommunityID(), cmd.NewRole()); err != nil {
+		return err
+	}
+
+	// Step 3: Validate that the target subscription exists
+	subscription, err := s.subscriptionRepo.FindByUserAndCommunity(ctx, cmd.UserID(), cmd.CommunityID())
+	if err != nil {
+		return fmt.Errorf("failed to find subscription: %w", err)
+	}
+	if subscription == nil {
+		return errors.New("subscription not found")
+	}
+
+	targetIsOwner, err := s.permissionService.IsOwner(ctx, cmd.UserID(), cmd.CommunityID())
+	if err != nil {
+		return fmt.Errorf("failed to validate owner status: %w", err)
+	}
+	if targetIsOwner || subscription.Role().IsOwner() {
+		return errors.New("the community owner's role cannot be changed")
+	}
+
+	// Step 4: Check the requester's permission
+	requesterIsOwner, err := s.permissionService.IsOwner(ctx, cmd.RequestedBy(), cmd.CommunityID())
+	if err != nil {
+		return fmt.Errorf("failed to validate owner status: %w", err)
+	}
+
+	if !requesterIsOwner {
+		hasPermission, err := s.permissionService.HasPermission(ctx, cmd.RequestedBy(), cmd.CommunityID(), valueobjects.ManageMembersPermission)
+		if err != nil {
+			return fmt.Errorf("failed to check requester permissions: %w", err)
+		}
+		if !hasPermission {
+			return errors.New("only community owner or admins can change member roles")
+		}
+
+		// Anyone may step down to member; taking another role would let users raise their own permissions.
+		// Granting or removing admin rights for someone else is reserved to the owner unless the owner delegated it.
+		if cmd.IsSelfChange() {
+			if !cmd.NewRole().IsMember() {
+				return errors.New("users can only change their own role to member")
+			}
+		} else if subscription.Role().IsAdmin() || cmd.NewRole().IsAdmin() {
+			canManageAdmins, err := s.permissionService.CanManageAdmins(ctx, cmd.RequestedBy(), cmd.CommunityID())
+			if err != nil {
+				return fmt.Errorf("failed to check requester permissions: %w", err)
+			}
+			if !canManageAdmins {
+				return services.ErrCannotManageAdmins
+			}
+		}
+	}
+
+	// Step 5: Apply the new role
+	if subscription.Role().Equals(cmd.NewRole()) {
+		return nil
+	}
+
+	if err := subscription.UpdateRole(cmd.NewRole()); err != nil {
+		return err
+	}
+
+	if err := s.subscriptionRepo.Update(ctx, subscription); err != nil {
+		return fmt.Errorf("failed to update subscription: %w", err)
+	}
+
+	return nil
 }
 
-// isOwner checks ownership using userID and, as fallback, the profileID to support both storage strategies.
-func (s *subscriptionCommandServiceImpl) isOwner(ctx context.Context, communityID valueobjects.CommunityID, userID valueobjects.UserID) (bool, error) {
-	isOwner, err := s.externalCommunitiesService.ValidateUserIsOwner(ctx, communityID, userID.Value())
+// HandleExtend processes an ExtendMembershipsCommand to move the expiry of several memberships to a new date.
+// Only the community owner can extend memberships. Members whose membership does not expire are left untouched.
+func (s *subscriptionCommandServiceImpl) HandleExtend(ctx context.Context, cmd commands.ExtendMembershipsCommand) ([]services.MembershipExtensionResult, error) {
+	communityExists, err := s.externalCommunitiesService.ValidateCommunityExists(ctx, cmd.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate community existence: %w", err)
+	}
+	if !communityExists {
+		return nil, errors.New("community not found")
+	}
+
+	isOwner, err := s.permissionService.IsOwner(ctx, cmd.RequestedBy(), cmd.CommunityID())
 	if err != nil {
+		return nil, fmt.Errorf("failed to validate owner status: %w", err)
+	}
+	if !isOwner {
+		return nil, errors.New("only the community owner can extend memberships")
+	}
+
+	results := make([]services.MembershipExtensionResult, 0, len(cmd.UserIDs()))
+	for _, userID := range cmd.UserIDs() {
+		result := services.MembershipExtensionResult{UserID: userID.Value()}
+
+		subscription, err := s.subscriptionRepo.FindByUserAndCommunity(ctx, userID, cmd.CommunityID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to find subscription: %w", err)
+		}
+		if subscription == nil {
+			result.Status = services.MembershipNotSubscribed
+			results = append(results, result)
+			continue
+		}
+		if subscription.ExpiresAt() == nil {
+			result.Status = services.MembershipWithoutExpiry
+			results = append(results, result)
+			continue
+		}
+
+		if err := subscription.ExtendExpiry(cmd.ExpiresAt()); err != nil {
+			return nil, err
+		}
+		if err := s.subscriptionRepo.Update(ctx, subscription); err != nil {
+			return nil, fmt.Errorf("failed to update subscription: %w", err)
+		}
+
+		result.Status = services.MembershipExtended
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// HandleExpireDue removes every membership whose expiry has been reached, or moves it to its alumni role.
+// A subscription that fails to expire is logged and retried on the next run; one extended or removed
+// since it was fetched is left as it is.
+func (s *subscriptionCommandServiceImpl) HandleExpireDue(ctx context.Context) (int, error) {
+	expired := 0
+	for {
+		now := time.Now()
+		due, err := s.subscriptionRepo.FindExpired(ctx, now, expireDueBatchSize)
+		if err != nil {
+			return expired, fmt.Errorf("failed to retrieve expired subscriptions: %w", err)
+		}
+
+		// A subscription skipped because it changed is no longer due, so it counts as handled
+		batchHandled := 0
+		for _, subscription := range due {
+			applied, err := s.expire(ctx, subscription, now)
+			if err != nil {
+				log.Printf("failed to expire subscription %s: %v", subscription.SubscriptionID().Value(), err)
+				continue
+			}
+			batchHandled++
+			if !applied {
+				log.Printf("subscription %s changed before it could expire, skipping", subscription.SubscriptionID().Value())
+				continue
+			}
+			expired++
+		}
+
+		// Stop when the batch was the last one, or when nothing in it could be handled
+		// so the same failing subscriptions are not fetched over and over.
+		if len(due) < expireDueBatchSize || batchHandled == 0 {
+			return expired, nil
+		}
+	}
+}
+
+// expire ends one expired membership. A custom alumni role deleted since the expiry was set
+// can no longer be applied, so the subscription is removed instead. The write only applies while the
+// stored expiry is still not after now, and the event is recorded only when it did.
+func (s *subscriptionCommandServiceImpl) expire(ctx context.Context, subscription *entities.Subscription, now time.Time) (bool, error) {
+	expiredAt := *subscription.ExpiresAt()
+	formerRole := subscription.Role()
+
+	alumniRole := subscription.AlumniRole()
+	if alumniRole != nil {
+		if err := s.ensureRoleDefined(ctx, subscription.CommunityID(), *alumniRole); err != nil {
+			if !errors.Is(err, services.ErrRoleNotFound) {
+				return false, err
+			}
+			alumniRole = nil
+		}
+	}
+
+	var applied bool
+	var err error
+	if alumniRole != nil {
+		if err := subscription.MoveToAlumniRole(); err != nil {
+			return false, err
+		}
+		applied, err = s.subscriptionRepo.UpdateExpired(ctx, subscription, now)
+	} else {
+		applied, err = s.subscriptionRepo.DeleteExpired(ctx, subscription.SubscriptionID(), now)
+	}
+	if err != nil || !applied {
 		return false, err
 	}
 
-	if isOwner {
-		return true, nil
+	event := events.NewMembershipExpiredEvent(
+		subscription.SubscriptionID(),
+		subscription.UserID(),
+		subscription.CommunityID(),
+		formerRole,
+		alumniRole,
+		expiredAt,
+	)
+
+	// TODO: Publish event to message broker
+	if event.IsRemoval() {
+		log.Printf("Event: MembershipExpired - CommunityID: %s, UserID: %s, Outcome: removed",
+			event.CommunityID().Value(), event.UserID().Value())
+	} else {
+		log.Printf("Event: MembershipExpired - CommunityID: %s, UserID: %s, Outcome: moved to %s",
+			event.CommunityID().Value(), event.UserID().Value(), event.AlumniRole().Value())
+	}
+
+	return true, nil
+}
+
+// HandleSwapOwnerRole processes a SwapOwnerRoleCommand once a community ownership transfer is accepted.
+// The ownership itself is changed by the Community BC; this only keeps the subscription roles in line.
+func (s *subscriptionCommandServiceImpl) HandleSwapOwnerRole(ctx context.Context, cmd commands.SwapOwnerRoleCommand) error {
+	newOwnerSubscription, err := s.subscriptionRepo.FindByUserAndCommunity(ctx, cmd.NewOwnerID(), cmd.CommunityID())
+	if err != nil {
+		return fmt.Errorf("failed to find new owner subscription: %w", err)
+	}
+	if newOwnerSubscription == nil {
+		return errors.New("new owner is not subscribed to this community")
+	}
+
+	previousOwnerSubscription, err := s.subscriptionRepo.FindByUserAndCommunity(ctx, cmd.PreviousOwnerID(), cmd.CommunityID())
+	if err != nil {
+		return fmt.Errorf("failed to find previous owner subscription: %w", err)
+	}
+
+	formerRole := newOwnerSubscription.Role()
+	if err := newOwnerSubscription.UpdateRole(valueobjects.OwnerRole); err != nil {
+		return err
+	}
+	if err := s.subscriptionRepo.Update(ctx, newOwnerSubscription); err != nil {
+		return fmt.Errorf("failed to update new owner subscription: %w", err)
+	}
+
+	// Communities whose owner was never subscribed have no previous owner subscription to demote
+	if previousOwnerSubscription == nil {
+		return nil
+	}
+
+	if err := previousOwnerSubscription.UpdateRole(formerRole); err != nil {
+		return err
+	}
+	if err := s.subscriptionRepo.Update(ctx, previousOwnerSubscription); err != nil {
+		return fmt.Errorf("failed to update previous owner subscription: %w", err)
+	}
+
+	return nil
+}
+
+// HandleDeleteByCommunity removes all subscriptions, join requests, invitations, sanctions and custom roles for a given community
+func (s *subscriptionCommandServiceImpl) HandleDeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error {
+	if err := s.subscriptionRepo.DeleteByCommunity(ctx, communityID); err != nil {
+		return err
+	}
+	if err := s.joinRequestRepo.DeleteByCommunity(ctx, communityID); err != nil {
+		return err
+	}
+	if err := s.invitationRepo.DeleteByCommunity(ctx, communityID); err != nil {
+		return err
+	}
+	if err := s.sanctionRepo.DeleteByCommunity(ctx, communityID); err != nil {
+		return err
+	}
+	return s.customRoleRepo.DeleteByCommunity(ctx, communityID)
+}
+
+// HandleDeleteByUser removes all subscriptions and join requests of a given user
+func (s *subscriptionCommandServiceImpl) HandleDeleteByUser(ctx context.Context, userID valueobjects.UserID) error {
+	if err := s.subscriptionRepo.DeleteByUser(ctx, userID); err != nil {
+		return err
+	}
+	return s.joinRequestRepo.DeleteByUser(ctx, userID)
+}
+
+// ensureRoleDefined checks that a custom role is defined in the community. Predefined roles always exist.
+func (s *subscriptionCommandServiceImpl) ensureRoleDefined(ctx context.Context, communityID valueobjects.CommunityID, role valueobjects.CommunityRole) error {
+	if !role.IsCustom() {
+		return nil
+	}
+
+	exists, err := s.customRoleRepo.ExistsByCommunityAndName(ctx, communityID, role)
+	if err != nil {
+		return fmt.Errorf("failed to validate role existence: %w", err)
+	}
+	if !exists {
+		return services.ErrRoleNotFound
 	}
 
-	// Some environments store owner as profileID instead of userID. Try profileID if available.
-	profileID, err := s.externalUsersService.GetProfileIDByUserID(ctx, userID)
-	if err != nil || profileID == "" {
-		return false, nil
+	return nil
+}
+
+// ensureCanGrantRole checks that a requester who is not the owner may add a user with the given role
+func (s *subscriptionCommandServiceImpl) ensureCanGrantRole(ctx context.Context, communityID valueobjects.CommunityID, requestedBy valueobjects.UserID, role valueobjects.CommunityRole) error {
+	if role.IsOwner() {
+		return services.ErrRoleNotGrantable
+	}
+	if err := s.ensureRoleDefined(ctx, communityID, role); err != nil {
+		return err
+	}
+	if !role.IsAdmin() {
+		return nil
 	}
 
-	return s.externalCommunitiesService.ValidateUserIsOwner(ctx, communityID, profileID)
+	canManageAdmins, err := s.permissionService.CanManageAdmins(ctx, requestedBy, communityID)
+	if err != nil {
+		return fmt.Errorf("failed to check requester permissions: %w", err)
+	}
+	if !canManageAdmins {
+		return services.ErrCannotManageAdmins
+	}
+	return nil
+}
+
+// ensureNotArchived refuses new members in a community archived after its owner was removed
+func ensureNotArchived(ctx context.Context, externalCommunitiesService *acl.ExternalCommunitiesService, communityID valueobjects.CommunityID) error {
+	archived, err := externalCommunitiesService.IsCommunityArchived(ctx, communityID)
+	if err != nil {
+		return fmt.Errorf("failed to check community status: %w", err)
+	}
+	if archived {
+		return errors.New("community is archived")
+	}
+	return nil
 }
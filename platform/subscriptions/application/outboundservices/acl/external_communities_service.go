@@ -7,6 +7,13 @@ import (
 	"Gommunity/platform/subscriptions/domain/model/valueobjects"
 )
 
+// CommunitySummary holds the community details shown next to a subscription
+type CommunitySummary struct {
+	Name      string
+	IconURL   *string
+	IsPrivate bool
+}
+
 // ExternalCommunitiesService provides ACL implementation for accessing Community bounded context
 type ExternalCommunitiesService struct {
 	communitiesFacade communities_acl.CommunitiesFacade
@@ -24,11 +31,21 @@ func (s *ExternalCommunitiesService) ValidateCommunityExists(ctx context.Context
 	return s.communitiesFacade.ValidateCommunityExists(ctx, communityID.Value())
 }
 
+// IsCommunityArchived checks if a community was archived after its owner was removed
+func (s *ExternalCommunitiesService) IsCommunityArchived(ctx context.Context, communityID valueobjects.CommunityID) (bool, error) {
+	return s.communitiesFacade.IsCommunityArchived(ctx, communityID.Value())
+}
+
 // IsCommunityPrivate checks if a community is private
 func (s *ExternalCommunitiesService) IsCommunityPrivate(ctx context.Context, communityID valueobjects.CommunityID) (bool, error) {
 	return s.communitiesFacade.IsCommunityPrivate(ctx, communityID.Value())
 }
 
+// AdminsCanManageAdmins reports whether admins may change the roles of other admins in a community
+func (s *ExternalCommunitiesService) AdminsCanManageAdmins(ctx context.Context, communityID valueobjects.CommunityID) (bool, error) {
+	return s.communitiesFacade.AdminsCanManageAdmins(ctx, communityID.Value())
+}
+
 // GetCommunityOwnerID retrieves the owner ID of a community (as string UUID)
 func (s *ExternalCommunitiesService) GetCommunityOwnerID(ctx context.Context, communityID valueobjects.CommunityID) (string, error) {
 	return s.communitiesFacade.GetCommunityOwnerID(ctx, communityID.Value())
@@ -38,3 +55,27 @@ func (s *ExternalCommunitiesService) GetCommunityOwnerID(ctx context.Context, co
 func (s *ExternalCommunitiesService) ValidateUserIsOwner(ctx context.Context, communityID valueobjects.CommunityID, ownerID string) (bool, error) {
 	return s.communitiesFacade.ValidateUserIsOwner(ctx, communityID.Value(), ownerID)
 }
+
+// GetCommunitySummaries retrieves the details of several communities in a single lookup, keyed by community ID.
+// Communities that no longer exist are absent from the result.
+func (s *ExternalCommunitiesService) GetCommunitySummaries(ctx context.Context, communityIDs []valueobjects.CommunityID) (map[string]CommunitySummary, error) {
+	ids := make([]string, len(communityIDs))
+	for i, communityID := range communityIDs {
+		ids[i] = communityID.Value()
+	}
+
+	communitiesData, err := s.communitiesFacade.GetCommunitiesByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make(map[string]CommunitySummary, len(communitiesData))
+	for _, communityData := range communitiesData {
+		summaries[communityData.CommunityID] = CommunitySummary{
+			Name:      communityData.Name,
+			IconURL:   communityData.IconURL,
+			IsPrivate: communityData.IsPrivate,
+		}
+	}
+	return summaries, nil
+}
@@ -8,6 +8,14 @@ import (
 	"Gommunity/platform/subscriptions/domain/model/valueobjects"
 )
 
+// UserSummary holds the profile details shown next to a community member
+type UserSummary struct {
+	UserID     string
+	Username   string
+	ProfileURL *string
+	BannerURL  *string
+}
+
 // ExternalUsersService provides ACL implementation for accessing User bounded context
 type ExternalUsersService struct {
 	usersFacade users_acl.UsersFacade
@@ -20,17 +28,17 @@ func NewExternalUsersService(usersFacade users_acl.UsersFacade) *ExternalUsersSe
 	}
 }
 
-// FetchUserIDByUsername retrieves a user ID by username from the Users BC
+// FetchUserIDByUsername retrieves a user ID by username from the Users BC.
+// It returns nil when no user has the username; an error means the lookup itself failed.
 func (s *ExternalUsersService) FetchUserIDByUsername(ctx context.Context, username string) (*valueobjects.UserID, error) {
 	userIDValue, err := s.usersFacade.FindUserIDByUsername(ctx, username)
+	if errors.Is(err, users_acl.ErrUserNotFound) {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	if userIDValue == "" {
-		return nil, errors.New("user not found")
-	}
-
 	userID, err := valueobjects.NewUserID(userIDValue)
 	if err != nil {
 		return nil, err
@@ -53,3 +61,52 @@ func (s *ExternalUsersService) ValidateRoleExists(ctx context.Context, roleName
 func (s *ExternalUsersService) GetProfileIDByUserID(ctx context.Context, userID valueobjects.UserID) (string, error) {
 	return s.usersFacade.GetProfileIDByUserID(ctx, userID.Value())
 }
+
+// GetUserSummaries retrieves the public profiles of several users in a single lookup, keyed by user ID.
+// Users that no longer exist are absent from the result.
+func (s *ExternalUsersService) GetUserSummaries(ctx context.Context, userIDs []valueobjects.UserID) (map[string]UserSummary, error) {
+	ids := make([]string, len(userIDs))
+	for i, userID := range userIDs {
+		ids[i] = userID.Value()
+	}
+
+	usersData, err := s.usersFacade.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make(map[string]UserSummary, len(usersData))
+	for _, userData := range usersData {
+		summaries[userData.UserID] = toUserSummary(userData)
+	}
+	return summaries, nil
+}
+
+// SearchUserSummaries retrieves one page of the given users whose username starts with the prefix, ordered by username.
+// A zero limit returns every match; users that no longer exist are left out.
+func (s *ExternalUsersService) SearchUserSummaries(ctx context.Context, userIDs []valueobjects.UserID, usernamePrefix string, offset, limit int) ([]UserSummary, error) {
+	ids := make([]string, len(userIDs))
+	for i, userID := range userIDs {
+		ids[i] = userID.Value()
+	}
+
+	usersData, err := s.usersFacade.SearchUsersByIDs(ctx, ids, usernamePrefix, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]UserSummary, len(usersData))
+	for i, userData := range usersData {
+		summaries[i] = toUserSummary(userData)
+	}
+	return summaries, nil
+}
+
+func toUserSummary(userData *users_acl.UserSummaryData) UserSummary {
+	return UserSummary{
+		UserID:     userData.UserID,
+		Username:   userData.Username,
+		ProfileURL: userData.ProfileURL,
+		BannerURL:  userData.BannerURL,
+	}
+}
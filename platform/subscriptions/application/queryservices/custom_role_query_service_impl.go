@@ -0,0 +1,47 @@
+package queryservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Gommunity/platform/subscriptions/application/outboundservices/acl"
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/queries"
+	"Gommunity/platform/subscriptions/domain/repositories"
+	"Gommunity/platform/subscriptions/domain/services"
+)
+
+type customRoleQueryServiceImpl struct {
+	customRoleRepo             repositories.CustomRoleRepository
+	externalCommunitiesService *acl.ExternalCommunitiesService
+}
+
+// NewCustomRoleQueryService creates a new CustomRoleQueryService implementation
+func NewCustomRoleQueryService(
+	customRoleRepo repositories.CustomRoleRepository,
+	externalCommunitiesService *acl.ExternalCommunitiesService,
+) services.CustomRoleQueryService {
+	return &customRoleQueryServiceImpl{
+		customRoleRepo:             customRoleRepo,
+		externalCommunitiesService: externalCommunitiesService,
+	}
+}
+
+// HandleGetByCommunity processes a GetCustomRolesByCommunityQuery to list the custom roles of a community
+func (s *customRoleQueryServiceImpl) HandleGetByCommunity(ctx context.Context, query queries.GetCustomRolesByCommunityQuery) ([]*entities.CustomRole, error) {
+	communityExists, err := s.externalCommunitiesService.ValidateCommunityExists(ctx, query.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate community existence: %w", err)
+	}
+	if !communityExists {
+		return nil, errors.New("community not found")
+	}
+
+	roles, err := s.customRoleRepo.FindAllByCommunity(ctx, query.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find roles: %w", err)
+	}
+
+	return roles, nil
+}
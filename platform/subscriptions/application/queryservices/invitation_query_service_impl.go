@@ -0,0 +1,87 @@
+package queryservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Gommunity/platform/subscriptions/application/outboundservices/acl"
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/queries"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	"Gommunity/platform/subscriptions/domain/repositories"
+	"Gommunity/platform/subscriptions/domain/services"
+)
+
+type invitationQueryServiceImpl struct {
+	invitationRepo             repositories.InvitationRepository
+	tokenService               services.InvitationTokenService
+	permissionService          services.PermissionService
+	externalCommunitiesService *acl.ExternalCommunitiesService
+}
+
+// NewInvitationQueryService creates a new InvitationQueryService implementation
+func NewInvitationQueryService(
+	invitationRepo repositories.InvitationRepository,
+	tokenService services.InvitationTokenService,
+	permissionService services.PermissionService,
+	externalCommunitiesService *acl.ExternalCommunitiesService,
+) services.InvitationQueryService {
+	return &invitationQueryServiceImpl{
+		invitationRepo:             invitationRepo,
+		tokenService:               tokenService,
+		permissionService:          permissionService,
+		externalCommunitiesService: externalCommunitiesService,
+	}
+}
+
+// HandleGetByID processes a GetInvitationByIDQuery to retrieve a single invitation
+func (s *invitationQueryServiceImpl) HandleGetByID(ctx context.Context, query queries.GetInvitationByIDQuery) (*services.InvitationLink, error) {
+	invitation, err := s.invitationRepo.FindByID(ctx, query.InvitationID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find invitation: %w", err)
+	}
+	if invitation == nil {
+		return nil, nil
+	}
+
+	return s.toLink(invitation), nil
+}
+
+// HandleAll processes a GetInvitationsByCommunityQuery to list the invitations of a community
+func (s *invitationQueryServiceImpl) HandleAll(ctx context.Context, query queries.GetInvitationsByCommunityQuery) ([]*services.InvitationLink, error) {
+	communityExists, err := s.externalCommunitiesService.ValidateCommunityExists(ctx, query.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate community existence: %w", err)
+	}
+	if !communityExists {
+		return nil, errors.New("community not found")
+	}
+
+	canManage, err := s.permissionService.HasPermission(ctx, query.RequestedBy(), query.CommunityID(), valueobjects.ManageMembersPermission)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check requester permissions: %w", err)
+	}
+	if !canManage {
+		return nil, errors.New("only community owner or admins can manage invitations")
+	}
+
+	invitations, err := s.invitationRepo.FindByCommunity(ctx, query.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find invitations: %w", err)
+	}
+
+	links := make([]*services.InvitationLink, 0, len(invitations))
+	for _, invitation := range invitations {
+		links = append(links, s.toLink(invitation))
+	}
+
+	return links, nil
+}
+
+func (s *invitationQueryServiceImpl) toLink(invitation *entities.Invitation) *services.InvitationLink {
+	return &services.InvitationLink{
+		Invitation: invitation,
+		Token:      s.tokenService.Sign(invitation.InvitationID()),
+	}
+}
@@ -0,0 +1,69 @@
+package queryservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Gommunity/platform/subscriptions/application/outboundservices/acl"
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/queries"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	"Gommunity/platform/subscriptions/domain/repositories"
+	"Gommunity/platform/subscriptions/domain/services"
+)
+
+type joinRequestQueryServiceImpl struct {
+	joinRequestRepo            repositories.JoinRequestRepository
+	permissionService          services.PermissionService
+	externalCommunitiesService *acl.ExternalCommunitiesService
+}
+
+// NewJoinRequestQueryService creates a new JoinRequestQueryService implementation
+func NewJoinRequestQueryService(
+	joinRequestRepo repositories.JoinRequestRepository,
+	permissionService services.PermissionService,
+	externalCommunitiesService *acl.ExternalCommunitiesService,
+) services.JoinRequestQueryService {
+	return &joinRequestQueryServiceImpl{
+		joinRequestRepo:            joinRequestRepo,
+		permissionService:          permissionService,
+		externalCommunitiesService: externalCommunitiesService,
+	}
+}
+
+// HandleGetByID processes a GetJoinRequestByIDQuery to retrieve a single join request
+func (s *joinRequestQueryServiceImpl) HandleGetByID(ctx context.Context, query queries.GetJoinRequestByIDQuery) (*entities.JoinRequest, error) {
+	joinRequest, err := s.joinRequestRepo.FindByID(ctx, query.JoinRequestID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find join request: %w", err)
+	}
+
+	return joinRequest, nil
+}
+
+// HandlePending processes a GetPendingJoinRequestsQuery to list the pending requests of a community
+func (s *joinRequestQueryServiceImpl) HandlePending(ctx context.Context, query queries.GetPendingJoinRequestsQuery) ([]*entities.JoinRequest, error) {
+	communityExists, err := s.externalCommunitiesService.ValidateCommunityExists(ctx, query.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate community existence: %w", err)
+	}
+	if !communityExists {
+		return nil, errors.New("community not found")
+	}
+
+	canReview, err := s.permissionService.HasPermission(ctx, query.RequestedBy(), query.CommunityID(), valueobjects.ManageMembersPermission)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check requester permissions: %w", err)
+	}
+	if !canReview {
+		return nil, errors.New("only community owner or admins can review join requests")
+	}
+
+	joinRequests, err := s.joinRequestRepo.FindPendingByCommunity(ctx, query.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find join requests: %w", err)
+	}
+
+	return joinRequests, nil
+}
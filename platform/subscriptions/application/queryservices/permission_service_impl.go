@@ -0,0 +1,97 @@
+package queryservices
+
+import (
+	"context"
+
+	"Gommunity/platform/subscriptions/application/outboundservices/acl"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	"Gommunity/platform/subscriptions/domain/repositories"
+	"Gommunity/platform/subscriptions/domain/services"
+)
+
+type permissionServiceImpl struct {
+	subscriptionRepo           repositories.SubscriptionRepository
+	customRoleRepo             repositories.CustomRoleRepository
+	externalUsersService       *acl.ExternalUsersService
+	externalCommunitiesService *acl.ExternalCommunitiesService
+}
+
+// NewPermissionService creates a new PermissionService implementation
+func NewPermissionService(
+	subscriptionRepo repositories.SubscriptionRepository,
+	customRoleRepo repositories.CustomRoleRepository,
+	externalUsersService *acl.ExternalUsersService,
+	externalCommunitiesService *acl.ExternalCommunitiesService,
+) services.PermissionService {
+	return &permissionServiceImpl{
+		subscriptionRepo:           subscriptionRepo,
+		customRoleRepo:             customRoleRepo,
+		externalUsersService:       externalUsersService,
+		externalCommunitiesService: externalCommunitiesService,
+	}
+}
+
+// HasPermission checks whether the user may perform the action guarded by the permission in the community
+func (s *permissionServiceImpl) HasPermission(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID, permission valueobjects.Permission) (bool, error) {
+	subscription, err := s.subscriptionRepo.FindByUserAndCommunity(ctx, userID, communityID)
+	if err != nil {
+		return false, err
+	}
+
+	if subscription != nil {
+		role := subscription.Role()
+		switch {
+		case role.IsAdminOrOwner():
+			return true, nil
+		case role.IsCustom():
+			customRole, err := s.customRoleRepo.FindByCommunityAndName(ctx, communityID, role)
+			if err != nil {
+				return false, err
+			}
+			if customRole != nil && customRole.HasPermission(permission) {
+				return true, nil
+			}
+		}
+	}
+
+	// The owner may have no subscription or may have been re-subscribed with another role
+	return s.IsOwner(ctx, userID, communityID)
+}
+
+// IsOwner checks ownership using userID and, as fallback, the profileID to support both storage strategies.
+func (s *permissionServiceImpl) IsOwner(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID) (bool, error) {
+	isOwner, err := s.externalCommunitiesService.ValidateUserIsOwner(ctx, communityID, userID.Value())
+	if err != nil {
+		return false, err
+	}
+
+	if isOwner {
+		return true, nil
+	}
+
+	// Some environments store owner as profileID instead of userID. Try profileID if available.
+	profileID, err := s.externalUsersService.GetProfileIDByUserID(ctx, userID)
+	if err != nil || profileID == "" {
+		return false, nil
+	}
+
+	return s.externalCommunitiesService.ValidateUserIsOwner(ctx, communityID, profileID)
+}
+
+// CanManageAdmins checks whether the user is the owner, or an admin of a community whose owner lets admins manage each other
+func (s *permissionServiceImpl) CanManageAdmins(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID) (bool, error) {
+	isOwner, err := s.IsOwner(ctx, userID, communityID)
+	if err != nil || isOwner {
+		return isOwner, err
+	}
+
+	subscription, err := s.subscriptionRepo.FindByUserAndCommunity(ctx, userID, communityID)
+	if err != nil {
+		return false, err
+	}
+	if subscription == nil || !subscription.Role().IsAdmin() {
+		return false, nil
+	}
+
+	return s.externalCommunitiesService.AdminsCanManageAdmins(ctx, communityID)
+}
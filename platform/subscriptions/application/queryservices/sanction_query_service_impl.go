@@ -0,0 +1,60 @@
+package queryservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"Gommunity/platform/subscriptions/application/outboundservices/acl"
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/queries"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	"Gommunity/platform/subscriptions/domain/repositories"
+	"Gommunity/platform/subscriptions/domain/services"
+)
+
+type sanctionQueryServiceImpl struct {
+	sanctionRepo               repositories.SanctionRepository
+	permissionService          services.PermissionService
+	externalCommunitiesService *acl.ExternalCommunitiesService
+}
+
+// NewSanctionQueryService creates a new SanctionQueryService implementation
+func NewSanctionQueryService(
+	sanctionRepo repositories.SanctionRepository,
+	permissionService services.PermissionService,
+	externalCommunitiesService *acl.ExternalCommunitiesService,
+) services.SanctionQueryService {
+	return &sanctionQueryServiceImpl{
+		sanctionRepo:               sanctionRepo,
+		permissionService:          permissionService,
+		externalCommunitiesService: externalCommunitiesService,
+	}
+}
+
+// HandleGetActive processes a GetActiveSanctionsQuery to list the bans or mutes in force in a community
+func (s *sanctionQueryServiceImpl) HandleGetActive(ctx context.Context, query queries.GetActiveSanctionsQuery) ([]*entities.Sanction, error) {
+	communityExists, err := s.externalCommunitiesService.ValidateCommunityExists(ctx, query.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate community existence: %w", err)
+	}
+	if !communityExists {
+		return nil, errors.New("community not found")
+	}
+
+	canModerate, err := s.permissionService.HasPermission(ctx, query.RequestedBy(), query.CommunityID(), valueobjects.ManageMembersPermission)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check requester permissions: %w", err)
+	}
+	if !canModerate {
+		return nil, errors.New("only community owner or admins can ban or mute members")
+	}
+
+	sanctions, err := s.sanctionRepo.FindActiveByCommunity(ctx, query.CommunityID(), query.SanctionType(), time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sanctions: %w", err)
+	}
+
+	return sanctions, nil
+}
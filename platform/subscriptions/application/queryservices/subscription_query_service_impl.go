@@ -2,24 +2,36 @@ package queryservices
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"Gommunity/platform/subscriptions/application/outboundservices/acl"
 	"Gommunity/platform/subscriptions/domain/model/entities"
 	"Gommunity/platform/subscriptions/domain/model/queries"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
 	"Gommunity/platform/subscriptions/domain/repositories"
 	"Gommunity/platform/subscriptions/domain/services"
 )
 
 type subscriptionQueryServiceImpl struct {
-	subscriptionRepo repositories.SubscriptionRepository
+	subscriptionRepo           repositories.SubscriptionRepository
+	permissionService          services.PermissionService
+	externalUsersService       *acl.ExternalUsersService
+	externalCommunitiesService *acl.ExternalCommunitiesService
 }
 
 // NewSubscriptionQueryService creates a new SubscriptionQueryService implementation
 func NewSubscriptionQueryService(
 	subscriptionRepo repositories.SubscriptionRepository,
+	permissionService services.PermissionService,
+	externalUsersService *acl.ExternalUsersService,
+	externalCommunitiesService *acl.ExternalCommunitiesService,
 ) services.SubscriptionQueryService {
 	return &subscriptionQueryServiceImpl{
-		subscriptionRepo: subscriptionRepo,
+		subscriptionRepo:           subscriptionRepo,
+		permissionService:          permissionService,
+		externalUsersService:       externalUsersService,
+		externalCommunitiesService: externalCommunitiesService,
 	}
 }
 
@@ -43,16 +55,283 @@ func (s *subscriptionQueryServiceImpl) HandleCount(ctx context.Context, query qu
 	return count, nil
 }
 
-// HandleAll processes a GetAllSubscriptionsByCommunityQuery to retrieve all subscriptions for a community
-func (s *subscriptionQueryServiceImpl) HandleAll(ctx context.Context, query queries.GetAllSubscriptionsByCommunityQuery) ([]*entities.Subscription, error) {
-	subscriptions, err := s.subscriptionRepo.FindAllByCommunityID(ctx, query.CommunityID(), query.Limit(), query.Offset())
+// HandleAll processes a GetAllSubscriptionsByCommunityQuery to retrieve one page of the member directory of a community.
+// Pages listed by join date come straight from the repository and are hydrated with a single users lookup.
+// Username searches and sorting are matched and ordered by the users lookup, then paged by offset.
+func (s *subscriptionQueryServiceImpl) HandleAll(ctx context.Context, query queries.GetAllSubscriptionsByCommunityQuery) (*services.MemberDirectoryPage, error) {
+	limit := queries.DefaultMemberPageSize
+	if query.Limit() != nil && *query.Limit() > 0 {
+		limit = *query.Limit()
+	}
+	if limit > queries.MaxMemberPageSize {
+		limit = queries.MaxMemberPageSize
+	}
+
+	filter := repositories.SubscriptionFilter{
+		Role:        query.Role(),
+		OldestFirst: query.Sort().IsOldest(),
+	}
+
+	if query.UsernamePrefix() != "" || query.Sort().IsUsername() {
+		return s.searchMembers(ctx, query, filter, limit)
+	}
+
+	// Fetch one extra row to know whether another page exists
+	extra := limit + 1
+	subscriptions, err := s.subscriptionRepo.FindAllByCommunityID(ctx, query.CommunityID(), filter, &extra, query.Offset(), query.Cursor())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find subscriptions: %w", err)
+	}
+
+	page := &services.MemberDirectoryPage{}
+	if len(subscriptions) > limit {
+		subscriptions = subscriptions[:limit]
+		last := subscriptions[len(subscriptions)-1]
+		next := valueobjects.NewCursor(last.CreatedAt(), last.SubscriptionID())
+		page.NextCursor = &next
+	}
+
+	page.Members, err = s.hydrateMembers(ctx, subscriptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// searchMembers builds a directory page filtered by username prefix or sorted by username, paged by offset.
+// Only the user IDs of the members are read here; the users lookup does the matching and ordering by username,
+// so members whose user no longer exists are left out of these pages.
+func (s *subscriptionQueryServiceImpl) searchMembers(ctx context.Context, query queries.GetAllSubscriptionsByCommunityQuery, filter repositories.SubscriptionFilter, limit int) (*services.MemberDirectoryPage, error) {
+	memberIDs, err := s.subscriptionRepo.FindUserIDsByCommunityID(ctx, query.CommunityID(), filter.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find subscriptions: %w", err)
+	}
+
+	offset := 0
+	if query.Offset() != nil && *query.Offset() > 0 {
+		offset = *query.Offset()
+	}
+
+	page := &services.MemberDirectoryPage{Members: []services.CommunityMember{}}
+	if len(memberIDs) == 0 {
+		return page, nil
+	}
+
+	if !query.Sort().IsUsername() {
+		return s.searchMembersByJoinDate(ctx, query, filter, memberIDs, offset, limit)
+	}
+
+	// Fetch one extra user to know whether another page exists
+	users, err := s.externalUsersService.SearchUserSummaries(ctx, memberIDs, query.UsernamePrefix(), offset, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch users: %w", err)
+	}
+	if len(users) > limit {
+		users = users[:limit]
+		next := offset + limit
+		page.NextOffset = &next
+	}
+	if len(users) == 0 {
+		return page, nil
+	}
+
+	filter.UserIDs, err = userIDsOf(users)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions, err := s.subscriptionRepo.FindAllByCommunityID(ctx, query.CommunityID(), filter, nil, nil, valueobjects.Cursor{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find subscriptions: %w", err)
+	}
+
+	bySubscriber := make(map[string]*entities.Subscription, len(subscriptions))
+	for _, subscription := range subscriptions {
+		bySubscriber[subscription.UserID().Value()] = subscription
+	}
+
+	// Keep the username order; a member who left in the meantime is skipped
+	for _, user := range users {
+		subscription, ok := bySubscriber[user.UserID]
+		if !ok {
+			continue
+		}
+		page.Members = append(page.Members, toCommunityMember(subscription, user))
+	}
+
+	return page, nil
+}
+
+// searchMembersByJoinDate pages the members whose username matches the prefix in join date order
+func (s *subscriptionQueryServiceImpl) searchMembersByJoinDate(ctx context.Context, query queries.GetAllSubscriptionsByCommunityQuery, filter repositories.SubscriptionFilter, memberIDs []valueobjects.UserID, offset, limit int) (*services.MemberDirectoryPage, error) {
+	page := &services.MemberDirectoryPage{Members: []services.CommunityMember{}}
+
+	users, err := s.externalUsersService.SearchUserSummaries(ctx, memberIDs, query.UsernamePrefix(), 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch users: %w", err)
+	}
+	if len(users) == 0 {
+		return page, nil
+	}
+
+	byUserID := make(map[string]acl.UserSummary, len(users))
+	for _, user := range users {
+		byUserID[user.UserID] = user
+	}
+
+	filter.UserIDs, err = userIDsOf(users)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra row to know whether another page exists
+	extra := limit + 1
+	subscriptions, err := s.subscriptionRepo.FindAllByCommunityID(ctx, query.CommunityID(), filter, &extra, &offset, valueobjects.Cursor{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to find subscriptions: %w", err)
 	}
+	if len(subscriptions) > limit {
+		subscriptions = subscriptions[:limit]
+		next := offset + limit
+		page.NextOffset = &next
+	}
+
+	for _, subscription := range subscriptions {
+		page.Members = append(page.Members, toCommunityMember(subscription, byUserID[subscription.UserID().Value()]))
+	}
+
+	return page, nil
+}
+
+func userIDsOf(users []acl.UserSummary) ([]valueobjects.UserID, error) {
+	userIDs := make([]valueobjects.UserID, len(users))
+	for i, user := range users {
+		userID, err := valueobjects.NewUserID(user.UserID)
+		if err != nil {
+			return nil, err
+		}
+		userIDs[i] = userID
+	}
+	return userIDs, nil
+}
+
+func toCommunityMember(subscription *entities.Subscription, user acl.UserSummary) services.CommunityMember {
+	return services.CommunityMember{
+		Subscription: subscription,
+		Username:     user.Username,
+		ProfileURL:   user.ProfileURL,
+		BannerURL:    user.BannerURL,
+	}
+}
+
+// hydrateMembers attaches the profile of each subscribed user, fetched in a single users lookup
+func (s *subscriptionQueryServiceImpl) hydrateMembers(ctx context.Context, subscriptions []*entities.Subscription) ([]services.CommunityMember, error) {
+	members := make([]services.CommunityMember, 0, len(subscriptions))
+	if len(subscriptions) == 0 {
+		return members, nil
+	}
+
+	userIDs := make([]valueobjects.UserID, len(subscriptions))
+	for i, subscription := range subscriptions {
+		userIDs[i] = subscription.UserID()
+	}
+
+	users, err := s.externalUsersService.GetUserSummaries(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch users: %w", err)
+	}
+
+	for _, subscription := range subscriptions {
+		members = append(members, toCommunityMember(subscription, users[subscription.UserID().Value()]))
+	}
+
+	return members, nil
+}
+
+// HandleGetByUser processes a GetSubscriptionsByUserQuery to list the communities a user belongs to, newest first.
+// Community details and member counts are fetched in one batch each; subscriptions to deleted communities are left out.
+func (s *subscriptionQueryServiceImpl) HandleGetByUser(ctx context.Context, query queries.GetSubscriptionsByUserQuery) ([]services.UserMembership, error) {
+	subscriptions, err := s.subscriptionRepo.FindAllByUserID(ctx, query.UserID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find subscriptions: %w", err)
+	}
+	if len(subscriptions) == 0 {
+		return []services.UserMembership{}, nil
+	}
+
+	communityIDs := make([]valueobjects.CommunityID, len(subscriptions))
+	for i, subscription := range subscriptions {
+		communityIDs[i] = subscription.CommunityID()
+	}
+
+	communities, err := s.externalCommunitiesService.GetCommunitySummaries(ctx, communityIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch communities: %w", err)
+	}
+
+	counts, err := s.subscriptionRepo.CountByCommunityIDs(ctx, communityIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count subscriptions: %w", err)
+	}
+
+	memberships := make([]services.UserMembership, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		community, ok := communities[subscription.CommunityID().Value()]
+		if !ok {
+			continue
+		}
+
+		memberships = append(memberships, services.UserMembership{
+			Subscription:  subscription,
+			CommunityName: community.Name,
+			IconURL:       community.IconURL,
+			IsPrivate:     community.IsPrivate,
+			MemberCount:   counts[subscription.CommunityID().Value()],
+		})
+	}
+
+	return memberships, nil
+}
+
+// HandleExport processes an ExportMembersQuery to list every member of a community, newest first.
+// Only users allowed to manage members can export the member list.
+func (s *subscriptionQueryServiceImpl) HandleExport(ctx context.Context, query queries.ExportMembersQuery) ([]services.MemberExportRow, error) {
+	communityExists, err := s.externalCommunitiesService.ValidateCommunityExists(ctx, query.CommunityID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate community existence: %w", err)
+	}
+	if !communityExists {
+		return nil, errors.New("community not found")
+	}
+
+	hasPermission, err := s.permissionService.HasPermission(ctx, query.RequestedBy(), query.CommunityID(), valueobjects.ManageMembersPermission)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check requester permissions: %w", err)
+	}
+	if !hasPermission {
+		return nil, errors.New("only community owner or admins can export members")
+	}
+
+	subscriptions, err := s.subscriptionRepo.FindAllByCommunityID(ctx, query.CommunityID(), repositories.SubscriptionFilter{}, nil, nil, valueobjects.Cursor{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find subscriptions: %w", err)
+	}
+
+	// Users removed from the Users BC are still exported, without a username
+	members, err := s.hydrateMembers(ctx, subscriptions)
+	if err != nil {
+		return nil, err
+	}
 
-	if subscriptions == nil {
-		return []*entities.Subscription{}, nil
+	rows := make([]services.MemberExportRow, 0, len(members))
+	for _, member := range members {
+		rows = append(rows, services.MemberExportRow{
+			UserID:   member.Subscription.UserID().Value(),
+			Username: member.Username,
+			Role:     member.Subscription.Role().Value(),
+			JoinedAt: member.Subscription.CreatedAt(),
+		})
 	}
 
-	return subscriptions, nil
+	return rows, nil
 }
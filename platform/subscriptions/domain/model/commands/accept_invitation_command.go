@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"errors"
+	"strings"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// AcceptInvitationCommand represents a user joining a community through an invite link
+type AcceptInvitationCommand struct {
+	token  string
+	userID valueobjects.UserID
+}
+
+func NewAcceptInvitationCommand(token string, userID valueobjects.UserID) (AcceptInvitationCommand, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return AcceptInvitationCommand{}, errors.New("invitation token cannot be empty")
+	}
+	if userID.IsZero() {
+		return AcceptInvitationCommand{}, errors.New("user ID cannot be zero")
+	}
+
+	return AcceptInvitationCommand{
+		token:  token,
+		userID: userID,
+	}, nil
+}
+
+func (c AcceptInvitationCommand) Token() string {
+	return c.token
+}
+
+func (c AcceptInvitationCommand) UserID() valueobjects.UserID {
+	return c.userID
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// ChangeMemberRoleCommand represents the intention to promote or demote a subscribed user within a community
+type ChangeMemberRoleCommand struct {
+	userID      valueobjects.UserID
+	communityID valueobjects.CommunityID
+	newRole     valueobjects.CommunityRole
+	requestedBy valueobjects.UserID
+}
+
+func NewChangeMemberRoleCommand(
+	userID valueobjects.UserID,
+	communityID valueobjects.CommunityID,
+	newRole valueobjects.CommunityRole,
+	requestedBy valueobjects.UserID,
+) (ChangeMemberRoleCommand, error) {
+	if userID.IsZero() {
+		return ChangeMemberRoleCommand{}, errors.New("user ID cannot be zero")
+	}
+	if communityID.IsZero() {
+		return ChangeMemberRoleCommand{}, errors.New("community ID cannot be empty")
+	}
+	if newRole.IsZero() {
+		return ChangeMemberRoleCommand{}, errors.New("role cannot be empty")
+	}
+	if requestedBy.IsZero() {
+		return ChangeMemberRoleCommand{}, errors.New("requestedBy ID cannot be zero")
+	}
+
+	return ChangeMemberRoleCommand{
+		userID:      userID,
+		communityID: communityID,
+		newRole:     newRole,
+		requestedBy: requestedBy,
+	}, nil
+}
+
+func (c ChangeMemberRoleCommand) UserID() valueobjects.UserID {
+	return c.userID
+}
+
+func (c ChangeMemberRoleCommand) CommunityID() valueobjects.CommunityID {
+	return c.communityID
+}
+
+func (c ChangeMemberRoleCommand) NewRole() valueobjects.CommunityRole {
+	return c.newRole
+}
+
+func (c ChangeMemberRoleCommand) RequestedBy() valueobjects.UserID {
+	return c.requestedBy
+}
+
+func (c ChangeMemberRoleCommand) IsSelfChange() bool {
+	return c.userID.Equals(c.requestedBy)
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// CreateCustomRoleCommand represents the community owner defining a new custom role and the permissions it grants
+type CreateCustomRoleCommand struct {
+	communityID valueobjects.CommunityID
+	name        valueobjects.CommunityRole
+	permissions []valueobjects.Permission
+	requestedBy valueobjects.UserID
+}
+
+func NewCreateCustomRoleCommand(
+	communityID valueobjects.CommunityID,
+	name valueobjects.CommunityRole,
+	permissions []valueobjects.Permission,
+	requestedBy valueobjects.UserID,
+) (CreateCustomRoleCommand, error) {
+	if communityID.IsZero() {
+		return CreateCustomRoleCommand{}, errors.New("community ID cannot be empty")
+	}
+	if name.IsZero() {
+		return CreateCustomRoleCommand{}, errors.New("role cannot be empty")
+	}
+	if !name.IsCustom() {
+		return CreateCustomRoleCommand{}, errors.New("custom roles cannot use the member, admin or owner names")
+	}
+	if requestedBy.IsZero() {
+		return CreateCustomRoleCommand{}, errors.New("requestedBy ID cannot be zero")
+	}
+
+	return CreateCustomRoleCommand{
+		communityID: communityID,
+		name:        name,
+		permissions: permissions,
+		requestedBy: requestedBy,
+	}, nil
+}
+
+func (c CreateCustomRoleCommand) CommunityID() valueobjects.CommunityID {
+	return c.communityID
+}
+
+func (c CreateCustomRoleCommand) Name() valueobjects.CommunityRole {
+	return c.name
+}
+
+func (c CreateCustomRoleCommand) Permissions() []valueobjects.Permission {
+	return c.permissions
+}
+
+func (c CreateCustomRoleCommand) RequestedBy() valueobjects.UserID {
+	return c.requestedBy
+}
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"errors"
+	"time"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// CreateInvitationCommand represents an owner/admin creating an invite link for a community
+type CreateInvitationCommand struct {
+	communityID valueobjects.CommunityID
+	createdBy   valueobjects.UserID
+	role        valueobjects.CommunityRole
+	maxUses     int
+	expiresAt   *time.Time // nil uses the default invitation lifetime
+}
+
+func NewCreateInvitationCommand(
+	communityID valueobjects.CommunityID,
+	createdBy valueobjects.UserID,
+	role valueobjects.CommunityRole,
+	maxUses int,
+	expiresAt *time.Time,
+) (CreateInvitationCommand, error) {
+	if communityID.IsZero() {
+		return CreateInvitationCommand{}, errors.New("community ID cannot be empty")
+	}
+	if createdBy.IsZero() {
+		return CreateInvitationCommand{}, errors.New("createdBy ID cannot be zero")
+	}
+	if role.IsZero() {
+		return CreateInvitationCommand{}, errors.New("role cannot be empty")
+	}
+
+	return CreateInvitationCommand{
+		communityID: communityID,
+		createdBy:   createdBy,
+		role:        role,
+		maxUses:     maxUses,
+		expiresAt:   expiresAt,
+	}, nil
+}
+
+func (c CreateInvitationCommand) CommunityID() valueobjects.CommunityID {
+	return c.communityID
+}
+
+func (c CreateInvitationCommand) CreatedBy() valueobjects.UserID {
+	return c.createdBy
+}
+
+func (c CreateInvitationCommand) Role() valueobjects.CommunityRole {
+	return c.role
+}
+
+func (c CreateInvitationCommand) MaxUses() int {
+	return c.maxUses
+}
+
+func (c CreateInvitationCommand) ExpiresAt() *time.Time {
+	return c.expiresAt
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// DeleteCustomRoleCommand represents the community owner removing a custom role that nobody holds anymore
+type DeleteCustomRoleCommand struct {
+	communityID valueobjects.CommunityID
+	name        valueobjects.CommunityRole
+	requestedBy valueobjects.UserID
+}
+
+func NewDeleteCustomRoleCommand(
+	communityID valueobjects.CommunityID,
+	name valueobjects.CommunityRole,
+	requestedBy valueobjects.UserID,
+) (DeleteCustomRoleCommand, error) {
+	if communityID.IsZero() {
+		return DeleteCustomRoleCommand{}, errors.New("community ID cannot be empty")
+	}
+	if name.IsZero() {
+		return DeleteCustomRoleCommand{}, errors.New("role cannot be empty")
+	}
+	if !name.IsCustom() {
+		return DeleteCustomRoleCommand{}, errors.New("custom roles cannot use the member, admin or owner names")
+	}
+	if requestedBy.IsZero() {
+		return DeleteCustomRoleCommand{}, errors.New("requestedBy ID cannot be zero")
+	}
+
+	return DeleteCustomRoleCommand{
+		communityID: communityID,
+		name:        name,
+		requestedBy: requestedBy,
+	}, nil
+}
+
+func (c DeleteCustomRoleCommand) CommunityID() valueobjects.CommunityID {
+	return c.communityID
+}
+
+func (c DeleteCustomRoleCommand) Name() valueobjects.CommunityRole {
+	return c.name
+}
+
+func (c DeleteCustomRoleCommand) RequestedBy() valueobjects.UserID {
+	return c.requestedBy
+}
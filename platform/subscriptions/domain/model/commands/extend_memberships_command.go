@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// MaxMembershipExtensionUsers is the largest number of members whose membership can be extended at once
+const MaxMembershipExtensionUsers = 1000
+
+// ExtendMembershipsCommand represents the community owner moving the expiry of several memberships to a new date
+type ExtendMembershipsCommand struct {
+	communityID valueobjects.CommunityID
+	userIDs     []valueobjects.UserID
+	expiresAt   time.Time
+	requestedBy valueobjects.UserID
+}
+
+func NewExtendMembershipsCommand(
+	communityID valueobjects.CommunityID,
+	userIDs []valueobjects.UserID,
+	expiresAt time.Time,
+	requestedBy valueobjects.UserID,
+) (ExtendMembershipsCommand, error) {
+	if communityID.IsZero() {
+		return ExtendMembershipsCommand{}, errors.New("community ID cannot be empty")
+	}
+	if requestedBy.IsZero() {
+		return ExtendMembershipsCommand{}, errors.New("requestedBy ID cannot be zero")
+	}
+	if len(userIDs) == 0 {
+		return ExtendMembershipsCommand{}, errors.New("at least one user ID is required")
+	}
+	if len(userIDs) > MaxMembershipExtensionUsers {
+		return ExtendMembershipsCommand{}, fmt.Errorf("cannot extend more than %d memberships at once", MaxMembershipExtensionUsers)
+	}
+	if !expiresAt.After(time.Now()) {
+		return ExtendMembershipsCommand{}, errors.New("expiry date must be in the future")
+	}
+
+	return ExtendMembershipsCommand{
+		communityID: communityID,
+		userIDs:     userIDs,
+		expiresAt:   expiresAt,
+		requestedBy: requestedBy,
+	}, nil
+}
+
+func (c ExtendMembershipsCommand) CommunityID() valueobjects.CommunityID {
+	return c.communityID
+}
+
+func (c ExtendMembershipsCommand) UserIDs() []valueobjects.UserID {
+	return c.userIDs
+}
+
+// ExpiresAt returns the new end of the memberships
+func (c ExtendMembershipsCommand) ExpiresAt() time.Time {
+	return c.expiresAt
+}
+
+func (c ExtendMembershipsCommand) RequestedBy() valueobjects.UserID {
+	return c.requestedBy
+}
@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// MaxMemberImportRows is the largest number of rows accepted in a single member import
+const MaxMemberImportRows = 1000
+
+// MemberImportRow is one line of a member import. The user is identified by user ID or, when empty, by username.
+// The role is kept as written so that an invalid role is reported on its row instead of failing the whole import.
+type MemberImportRow struct {
+	line     int
+	userID   string
+	username string
+	role     string
+}
+
+func NewMemberImportRow(line int, userID, username, role string) MemberImportRow {
+	return MemberImportRow{
+		line:     line,
+		userID:   strings.TrimSpace(userID),
+		username: strings.TrimSpace(username),
+		role:     strings.TrimSpace(role),
+	}
+}
+
+// Line returns the line number of the row in the imported file
+func (r MemberImportRow) Line() int {
+	return r.line
+}
+
+func (r MemberImportRow) UserID() string {
+	return r.userID
+}
+
+func (r MemberImportRow) Username() string {
+	return r.username
+}
+
+// Role returns the requested role; empty means member
+func (r MemberImportRow) Role() string {
+	return r.role
+}
+
+// ImportMembersCommand represents an owner/admin subscribing a list of users to a community at once
+type ImportMembersCommand struct {
+	communityID valueobjects.CommunityID
+	rows        []MemberImportRow
+	requestedBy valueobjects.UserID
+}
+
+func NewImportMembersCommand(
+	communityID valueobjects.CommunityID,
+	rows []MemberImportRow,
+	requestedBy valueobjects.UserID,
+) (ImportMembersCommand, error) {
+	if communityID.IsZero() {
+		return ImportMembersCommand{}, errors.New("community ID cannot be empty")
+	}
+	if requestedBy.IsZero() {
+		return ImportMembersCommand{}, errors.New("requestedBy ID cannot be zero")
+	}
+	if len(rows) == 0 {
+		return ImportMembersCommand{}, errors.New("import must contain at least one row")
+	}
+	if len(rows) > MaxMemberImportRows {
+		return ImportMembersCommand{}, fmt.Errorf("import cannot contain more than %d rows", MaxMemberImportRows)
+	}
+
+	return ImportMembersCommand{
+		communityID: communityID,
+		rows:        rows,
+		requestedBy: requestedBy,
+	}, nil
+}
+
+func (c ImportMembersCommand) CommunityID() valueobjects.CommunityID {
+	return c.communityID
+}
+
+func (c ImportMembersCommand) Rows() []MemberImportRow {
+	return c.rows
+}
+
+func (c ImportMembersCommand) RequestedBy() valueobjects.UserID {
+	return c.requestedBy
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// LiftSanctionCommand represents an owner/admin lifting a ban or a mute before it expires
+type LiftSanctionCommand struct {
+	communityID  valueobjects.CommunityID
+	userID       valueobjects.UserID
+	requestedBy  valueobjects.UserID
+	sanctionType valueobjects.SanctionType
+}
+
+func NewLiftSanctionCommand(
+	communityID valueobjects.CommunityID,
+	userID valueobjects.UserID,
+	requestedBy valueobjects.UserID,
+	sanctionType valueobjects.SanctionType,
+) (LiftSanctionCommand, error) {
+	if communityID.IsZero() {
+		return LiftSanctionCommand{}, errors.New("community ID cannot be empty")
+	}
+	if userID.IsZero() {
+		return LiftSanctionCommand{}, errors.New("user ID cannot be zero")
+	}
+	if requestedBy.IsZero() {
+		return LiftSanctionCommand{}, errors.New("requestedBy ID cannot be zero")
+	}
+	if sanctionType.IsZero() {
+		return LiftSanctionCommand{}, errors.New("sanction type cannot be empty")
+	}
+
+	return LiftSanctionCommand{
+		communityID:  communityID,
+		userID:       userID,
+		requestedBy:  requestedBy,
+		sanctionType: sanctionType,
+	}, nil
+}
+
+func (c LiftSanctionCommand) CommunityID() valueobjects.CommunityID {
+	return c.communityID
+}
+
+func (c LiftSanctionCommand) UserID() valueobjects.UserID {
+	return c.userID
+}
+
+func (c LiftSanctionCommand) RequestedBy() valueobjects.UserID {
+	return c.requestedBy
+}
+
+func (c LiftSanctionCommand) SanctionType() valueobjects.SanctionType {
+	return c.sanctionType
+}
@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// RequestToJoinCommand represents a user's intention to join a private community
+type RequestToJoinCommand struct {
+	userID      valueobjects.UserID
+	communityID valueobjects.CommunityID
+	message     valueobjects.JoinRequestMessage
+}
+
+func NewRequestToJoinCommand(
+	userID valueobjects.UserID,
+	communityID valueobjects.CommunityID,
+	message valueobjects.JoinRequestMessage,
+) (RequestToJoinCommand, error) {
+	if userID.IsZero() {
+		return RequestToJoinCommand{}, errors.New("user ID cannot be zero")
+	}
+	if communityID.IsZero() {
+		return RequestToJoinCommand{}, errors.New("community ID cannot be empty")
+	}
+
+	return RequestToJoinCommand{
+		userID:      userID,
+		communityID: communityID,
+		message:     message,
+	}, nil
+}
+
+func (c RequestToJoinCommand) UserID() valueobjects.UserID {
+	return c.userID
+}
+
+func (c RequestToJoinCommand) CommunityID() valueobjects.CommunityID {
+	return c.communityID
+}
+
+func (c RequestToJoinCommand) Message() valueobjects.JoinRequestMessage {
+	return c.message
+}
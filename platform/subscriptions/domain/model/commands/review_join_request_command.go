@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// ReviewJoinRequestCommand represents an owner/admin decision on a pending join request
+type ReviewJoinRequestCommand struct {
+	joinRequestID valueobjects.JoinRequestID
+	reviewedBy    valueobjects.UserID
+	approve       bool
+}
+
+func NewReviewJoinRequestCommand(
+	joinRequestID valueobjects.JoinRequestID,
+	reviewedBy valueobjects.UserID,
+	approve bool,
+) (ReviewJoinRequestCommand, error) {
+	if joinRequestID.IsZero() {
+		return ReviewJoinRequestCommand{}, errors.New("join request ID cannot be empty")
+	}
+	if reviewedBy.IsZero() {
+		return ReviewJoinRequestCommand{}, errors.New("reviewedBy ID cannot be zero")
+	}
+
+	return ReviewJoinRequestCommand{
+		joinRequestID: joinRequestID,
+		reviewedBy:    reviewedBy,
+		approve:       approve,
+	}, nil
+}
+
+func (c ReviewJoinRequestCommand) JoinRequestID() valueobjects.JoinRequestID {
+	return c.joinRequestID
+}
+
+func (c ReviewJoinRequestCommand) ReviewedBy() valueobjects.UserID {
+	return c.reviewedBy
+}
+
+// Approve reports whether the request is approved (true) or rejected (false)
+func (c ReviewJoinRequestCommand) Approve() bool {
+	return c.approve
+}
@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// RevokeInvitationCommand represents an owner/admin invalidating an invite link
+type RevokeInvitationCommand struct {
+	invitationID valueobjects.InvitationID
+	requestedBy  valueobjects.UserID
+}
+
+func NewRevokeInvitationCommand(
+	invitationID valueobjects.InvitationID,
+	requestedBy valueobjects.UserID,
+) (RevokeInvitationCommand, error) {
+	if invitationID.IsZero() {
+		return RevokeInvitationCommand{}, errors.New("invitation ID cannot be empty")
+	}
+	if requestedBy.IsZero() {
+		return RevokeInvitationCommand{}, errors.New("requestedBy ID cannot be zero")
+	}
+
+	return RevokeInvitationCommand{
+		invitationID: invitationID,
+		requestedBy:  requestedBy,
+	}, nil
+}
+
+func (c RevokeInvitationCommand) InvitationID() valueobjects.InvitationID {
+	return c.invitationID
+}
+
+func (c RevokeInvitationCommand) RequestedBy() valueobjects.UserID {
+	return c.requestedBy
+}
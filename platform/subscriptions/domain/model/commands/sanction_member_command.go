@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"errors"
+	"time"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// SanctionMemberCommand represents an owner/admin banning or muting a user in a community
+type SanctionMemberCommand struct {
+	communityID  valueobjects.CommunityID
+	userID       valueobjects.UserID
+	issuedBy     valueobjects.UserID
+	sanctionType valueobjects.SanctionType
+	reason       valueobjects.SanctionReason
+	expiresAt    *time.Time
+}
+
+func NewSanctionMemberCommand(
+	communityID valueobjects.CommunityID,
+	userID valueobjects.UserID,
+	issuedBy valueobjects.UserID,
+	sanctionType valueobjects.SanctionType,
+	reason valueobjects.SanctionReason,
+	expiresAt *time.Time,
+) (SanctionMemberCommand, error) {
+	if communityID.IsZero() {
+		return SanctionMemberCommand{}, errors.New("community ID cannot be empty")
+	}
+	if userID.IsZero() {
+		return SanctionMemberCommand{}, errors.New("user ID cannot be zero")
+	}
+	if issuedBy.IsZero() {
+		return SanctionMemberCommand{}, errors.New("issuedBy ID cannot be zero")
+	}
+	if sanctionType.IsZero() {
+		return SanctionMemberCommand{}, errors.New("sanction type cannot be empty")
+	}
+	if sanctionType.IsMute() && expiresAt == nil {
+		return SanctionMemberCommand{}, errors.New("a mute needs an end time")
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return SanctionMemberCommand{}, errors.New("sanction end time must be in the future")
+	}
+
+	return SanctionMemberCommand{
+		communityID:  communityID,
+		userID:       userID,
+		issuedBy:     issuedBy,
+		sanctionType: sanctionType,
+		reason:       reason,
+		expiresAt:    expiresAt,
+	}, nil
+}
+
+func (c SanctionMemberCommand) CommunityID() valueobjects.CommunityID {
+	return c.communityID
+}
+
+func (c SanctionMemberCommand) UserID() valueobjects.UserID {
+	return c.userID
+}
+
+func (c SanctionMemberCommand) IssuedBy() valueobjects.UserID {
+	return c.issuedBy
+}
+
+func (c SanctionMemberCommand) SanctionType() valueobjects.SanctionType {
+	return c.sanctionType
+}
+
+func (c SanctionMemberCommand) Reason() valueobjects.SanctionReason {
+	return c.reason
+}
+
+// ExpiresAt returns when the sanction ends. Nil means a permanent ban.
+func (c SanctionMemberCommand) ExpiresAt() *time.Time {
+	return c.expiresAt
+}
+
+// IsSelfSanction checks if the issuer targets themselves
+func (c SanctionMemberCommand) IsSelfSanction() bool {
+	return c.userID.Equals(c.issuedBy)
+}
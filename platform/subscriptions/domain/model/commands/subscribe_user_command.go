@@ -2,6 +2,7 @@ package commands
 
 import (
 	"errors"
+	"time"
 
 	"Gommunity/platform/subscriptions/domain/model/valueobjects"
 )
@@ -14,6 +15,8 @@ type SubscribeUserCommand struct {
 	communityID valueobjects.CommunityID
 	role        valueobjects.CommunityRole
 	requestedBy valueobjects.UserID // Who is making the request (can be same as userID for self-subscription)
+	expiresAt   *time.Time
+	alumniRole  *valueobjects.CommunityRole
 }
 
 func NewSubscribeUserCommand(
@@ -43,6 +46,14 @@ func NewSubscribeUserCommand(
 	}, nil
 }
 
+// WithExpiry makes the membership end at expiresAt. When alumniRole is set the member moves to that role
+// on expiry instead of being removed.
+func (c SubscribeUserCommand) WithExpiry(expiresAt time.Time, alumniRole *valueobjects.CommunityRole) SubscribeUserCommand {
+	c.expiresAt = &expiresAt
+	c.alumniRole = alumniRole
+	return c
+}
+
 func (c SubscribeUserCommand) UserID() valueobjects.UserID {
 	return c.userID
 }
@@ -59,6 +70,15 @@ func (c SubscribeUserCommand) RequestedBy() valueobjects.UserID {
 	return c.requestedBy
 }
 
+// ExpiresAt returns when the membership ends, or nil for a membership that does not expire
+func (c SubscribeUserCommand) ExpiresAt() *time.Time {
+	return c.expiresAt
+}
+
+func (c SubscribeUserCommand) AlumniRole() *valueobjects.CommunityRole {
+	return c.alumniRole
+}
+
 func (c SubscribeUserCommand) IsSelfSubscription() bool {
 	return c.userID.Equals(c.requestedBy)
 }
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// SwapOwnerRoleCommand represents the subscription side of a community ownership transfer:
+// the new owner receives the owner role and the previous owner takes over the new owner's former role
+type SwapOwnerRoleCommand struct {
+	communityID     valueobjects.CommunityID
+	previousOwnerID valueobjects.UserID
+	newOwnerID      valueobjects.UserID
+}
+
+func NewSwapOwnerRoleCommand(
+	communityID valueobjects.CommunityID,
+	previousOwnerID valueobjects.UserID,
+	newOwnerID valueobjects.UserID,
+) (SwapOwnerRoleCommand, error) {
+	if communityID.IsZero() {
+		return SwapOwnerRoleCommand{}, errors.New("community ID cannot be empty")
+	}
+	if previousOwnerID.IsZero() {
+		return SwapOwnerRoleCommand{}, errors.New("previous owner ID cannot be zero")
+	}
+	if newOwnerID.IsZero() {
+		return SwapOwnerRoleCommand{}, errors.New("new owner ID cannot be zero")
+	}
+	if previousOwnerID.Equals(newOwnerID) {
+		return SwapOwnerRoleCommand{}, errors.New("previous and new owner cannot be the same user")
+	}
+
+	return SwapOwnerRoleCommand{
+		communityID:     communityID,
+		previousOwnerID: previousOwnerID,
+		newOwnerID:      newOwnerID,
+	}, nil
+}
+
+func (c SwapOwnerRoleCommand) CommunityID() valueobjects.CommunityID {
+	return c.communityID
+}
+
+func (c SwapOwnerRoleCommand) PreviousOwnerID() valueobjects.UserID {
+	return c.previousOwnerID
+}
+
+func (c SwapOwnerRoleCommand) NewOwnerID() valueobjects.UserID {
+	return c.newOwnerID
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// UpdateCustomRoleCommand represents the community owner replacing the permissions granted by a custom role
+type UpdateCustomRoleCommand struct {
+	communityID valueobjects.CommunityID
+	name        valueobjects.CommunityRole
+	permissions []valueobjects.Permission
+	requestedBy valueobjects.UserID
+}
+
+func NewUpdateCustomRoleCommand(
+	communityID valueobjects.CommunityID,
+	name valueobjects.CommunityRole,
+	permissions []valueobjects.Permission,
+	requestedBy valueobjects.UserID,
+) (UpdateCustomRoleCommand, error) {
+	if communityID.IsZero() {
+		return UpdateCustomRoleCommand{}, errors.New("community ID cannot be empty")
+	}
+	if name.IsZero() {
+		return UpdateCustomRoleCommand{}, errors.New("role cannot be empty")
+	}
+	if !name.IsCustom() {
+		return UpdateCustomRoleCommand{}, errors.New("custom roles cannot use the member, admin or owner names")
+	}
+	if requestedBy.IsZero() {
+		return UpdateCustomRoleCommand{}, errors.New("requestedBy ID cannot be zero")
+	}
+
+	return UpdateCustomRoleCommand{
+		communityID: communityID,
+		name:        name,
+		permissions: permissions,
+		requestedBy: requestedBy,
+	}, nil
+}
+
+func (c UpdateCustomRoleCommand) CommunityID() valueobjects.CommunityID {
+	return c.communityID
+}
+
+func (c UpdateCustomRoleCommand) Name() valueobjects.CommunityRole {
+	return c.name
+}
+
+func (c UpdateCustomRoleCommand) Permissions() []valueobjects.Permission {
+	return c.permissions
+}
+
+func (c UpdateCustomRoleCommand) RequestedBy() valueobjects.UserID {
+	return c.requestedBy
+}
@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// WithdrawJoinRequestCommand represents a user cancelling their own pending join request
+type WithdrawJoinRequestCommand struct {
+	joinRequestID valueobjects.JoinRequestID
+	requestedBy   valueobjects.UserID
+}
+
+func NewWithdrawJoinRequestCommand(
+	joinRequestID valueobjects.JoinRequestID,
+	requestedBy valueobjects.UserID,
+) (WithdrawJoinRequestCommand, error) {
+	if joinRequestID.IsZero() {
+		return WithdrawJoinRequestCommand{}, errors.New("join request ID cannot be empty")
+	}
+	if requestedBy.IsZero() {
+		return WithdrawJoinRequestCommand{}, errors.New("requestedBy ID cannot be zero")
+	}
+
+	return WithdrawJoinRequestCommand{
+		joinRequestID: joinRequestID,
+		requestedBy:   requestedBy,
+	}, nil
+}
+
+func (c WithdrawJoinRequestCommand) JoinRequestID() valueobjects.JoinRequestID {
+	return c.joinRequestID
+}
+
+func (c WithdrawJoinRequestCommand) RequestedBy() valueobjects.UserID {
+	return c.requestedBy
+}
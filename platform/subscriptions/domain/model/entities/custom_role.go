@@ -0,0 +1,150 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// CustomRole is a community-specific role, such as "teaching_assistant", defined by the community owner.
+// Members holding it get exactly the permissions listed on the role.
+type CustomRole struct {
+	id           string
+	customRoleID valueobjects.CustomRoleID
+	communityID  valueobjects.CommunityID
+	name         valueobjects.CommunityRole
+	permissions  []valueobjects.Permission
+	createdBy    valueobjects.UserID
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// NewCustomRole creates a custom role. The name cannot clash with the predefined member, admin and owner roles.
+func NewCustomRole(
+	communityID valueobjects.CommunityID,
+	name valueobjects.CommunityRole,
+	permissions []valueobjects.Permission,
+	createdBy valueobjects.UserID,
+) (*CustomRole, error) {
+	if communityID.IsZero() {
+		return nil, errors.New("community ID cannot be empty")
+	}
+	if !name.IsCustom() {
+		return nil, errors.New("custom roles cannot use the member, admin or owner names")
+	}
+	if createdBy.IsZero() {
+		return nil, errors.New("creator ID cannot be zero")
+	}
+
+	now := time.Now()
+	customRoleID := valueobjects.GenerateCustomRoleID()
+
+	return &CustomRole{
+		id:           customRoleID.Value(),
+		customRoleID: customRoleID,
+		communityID:  communityID,
+		name:         name,
+		permissions:  uniquePermissions(permissions),
+		createdBy:    createdBy,
+		createdAt:    now,
+		updatedAt:    now,
+	}, nil
+}
+
+// ReconstructCustomRole reconstructs a CustomRole from persistence
+func ReconstructCustomRole(
+	id string,
+	customRoleID valueobjects.CustomRoleID,
+	communityID valueobjects.CommunityID,
+	name valueobjects.CommunityRole,
+	permissions []valueobjects.Permission,
+	createdBy valueobjects.UserID,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *CustomRole {
+	return &CustomRole{
+		id:           id,
+		customRoleID: customRoleID,
+		communityID:  communityID,
+		name:         name,
+		permissions:  permissions,
+		createdBy:    createdBy,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}
+}
+
+// ID returns the MongoDB document ID
+func (r *CustomRole) ID() string {
+	return r.id
+}
+
+// CustomRoleID returns the custom role ID
+func (r *CustomRole) CustomRoleID() valueobjects.CustomRoleID {
+	return r.customRoleID
+}
+
+// CommunityID returns the community the role is defined in
+func (r *CustomRole) CommunityID() valueobjects.CommunityID {
+	return r.communityID
+}
+
+// Name returns the role name assigned to subscriptions
+func (r *CustomRole) Name() valueobjects.CommunityRole {
+	return r.name
+}
+
+// Permissions returns the permissions granted by the role
+func (r *CustomRole) Permissions() []valueobjects.Permission {
+	return r.permissions
+}
+
+// CreatedBy returns the user who defined the role
+func (r *CustomRole) CreatedBy() valueobjects.UserID {
+	return r.createdBy
+}
+
+// CreatedAt returns the creation timestamp
+func (r *CustomRole) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// UpdatedAt returns the last update timestamp
+func (r *CustomRole) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// HasPermission checks if the role grants the given permission
+func (r *CustomRole) HasPermission(permission valueobjects.Permission) bool {
+	for _, p := range r.permissions {
+		if p.Equals(permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdatePermissions replaces the permissions granted by the role
+func (r *CustomRole) UpdatePermissions(permissions []valueobjects.Permission) {
+	r.permissions = uniquePermissions(permissions)
+	r.updatedAt = time.Now()
+}
+
+// uniquePermissions drops repeated permissions while keeping their order
+func uniquePermissions(permissions []valueobjects.Permission) []valueobjects.Permission {
+	unique := make([]valueobjects.Permission, 0, len(permissions))
+	for _, p := range permissions {
+		duplicate := false
+		for _, u := range unique {
+			if u.Equals(p) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			unique = append(unique, p)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,238 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+const (
+	// MaxInvitationUses is the highest number of uses a single invitation can allow
+	MaxInvitationUses = 1000
+	// MaxInvitationLifetime is how far in the future an invitation may expire
+	MaxInvitationLifetime = 30 * 24 * time.Hour
+)
+
+// Invitation states derived from the expiry, the use count and revocation
+const (
+	InvitationActive    = "active"
+	InvitationExpired   = "expired"
+	InvitationExhausted = "exhausted"
+	InvitationRevoked   = "revoked"
+)
+
+// Invitation represents a shareable invite link to a community.
+// Every accepted invitation subscribes the user with the role the invitation grants,
+// until it expires, runs out of uses or is revoked.
+type Invitation struct {
+	id           string
+	invitationID valueobjects.InvitationID
+	communityID  valueobjects.CommunityID
+	createdBy    valueobjects.UserID
+	role         valueobjects.CommunityRole
+	maxUses      int
+	uses         int
+	expiresAt    time.Time
+	revokedBy    *valueobjects.UserID
+	revokedAt    *time.Time
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// NewInvitation creates a new Invitation aggregate
+func NewInvitation(
+	communityID valueobjects.CommunityID,
+	createdBy valueobjects.UserID,
+	role valueobjects.CommunityRole,
+	maxUses int,
+	expiresAt time.Time,
+) (*Invitation, error) {
+	if communityID.IsZero() {
+		return nil, errors.New("community ID cannot be empty")
+	}
+	if createdBy.IsZero() {
+		return nil, errors.New("creator ID cannot be zero")
+	}
+	if role.IsZero() {
+		return nil, errors.New("role cannot be empty")
+	}
+	if role.IsOwner() {
+		return nil, errors.New("invitations cannot grant the owner role")
+	}
+	if maxUses < 1 || maxUses > MaxInvitationUses {
+		return nil, errors.New("max uses has to be between 1 and 1000")
+	}
+
+	now := time.Now()
+	if !expiresAt.After(now) {
+		return nil, errors.New("invitation expiry has to be in the future")
+	}
+	if expiresAt.Sub(now) > MaxInvitationLifetime {
+		return nil, errors.New("invitation expiry cannot be more than 30 days ahead")
+	}
+
+	invitationID := valueobjects.GenerateInvitationID()
+
+	return &Invitation{
+		id:           invitationID.Value(),
+		invitationID: invitationID,
+		communityID:  communityID,
+		createdBy:    createdBy,
+		role:         role,
+		maxUses:      maxUses,
+		expiresAt:    expiresAt,
+		createdAt:    now,
+		updatedAt:    now,
+	}, nil
+}
+
+// ReconstructInvitation reconstructs an Invitation from persistence
+func ReconstructInvitation(
+	id string,
+	invitationID valueobjects.InvitationID,
+	communityID valueobjects.CommunityID,
+	createdBy valueobjects.UserID,
+	role valueobjects.CommunityRole,
+	maxUses int,
+	uses int,
+	expiresAt time.Time,
+	revokedBy *valueobjects.UserID,
+	revokedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Invitation {
+	return &Invitation{
+		id:           id,
+		invitationID: invitationID,
+		communityID:  communityID,
+		createdBy:    createdBy,
+		role:         role,
+		maxUses:      maxUses,
+		uses:         uses,
+		expiresAt:    expiresAt,
+		revokedBy:    revokedBy,
+		revokedAt:    revokedAt,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}
+}
+
+// ID returns the MongoDB document ID
+func (i *Invitation) ID() string {
+	return i.id
+}
+
+// InvitationID returns the invitation ID
+func (i *Invitation) InvitationID() valueobjects.InvitationID {
+	return i.invitationID
+}
+
+// CommunityID returns the community the invitation grants access to
+func (i *Invitation) CommunityID() valueobjects.CommunityID {
+	return i.communityID
+}
+
+// CreatedBy returns the owner/admin who created the invitation
+func (i *Invitation) CreatedBy() valueobjects.UserID {
+	return i.createdBy
+}
+
+// Role returns the role granted to users who accept the invitation
+func (i *Invitation) Role() valueobjects.CommunityRole {
+	return i.role
+}
+
+// MaxUses returns how many times the invitation can be accepted
+func (i *Invitation) MaxUses() int {
+	return i.maxUses
+}
+
+// Uses returns how many times the invitation has been accepted
+func (i *Invitation) Uses() int {
+	return i.uses
+}
+
+// ExpiresAt returns when the invitation stops being valid
+func (i *Invitation) ExpiresAt() time.Time {
+	return i.expiresAt
+}
+
+// RevokedBy returns who revoked the invitation, if it was revoked
+func (i *Invitation) RevokedBy() *valueobjects.UserID {
+	return i.revokedBy
+}
+
+// RevokedAt returns when the invitation was revoked, if it was
+func (i *Invitation) RevokedAt() *time.Time {
+	return i.revokedAt
+}
+
+// CreatedAt returns the creation timestamp
+func (i *Invitation) CreatedAt() time.Time {
+	return i.createdAt
+}
+
+// UpdatedAt returns the last update timestamp
+func (i *Invitation) UpdatedAt() time.Time {
+	return i.updatedAt
+}
+
+// IsRevoked checks if the invitation was revoked
+func (i *Invitation) IsRevoked() bool {
+	return i.revokedAt != nil
+}
+
+// IsExpired checks if the invitation expiry has passed at the given time
+func (i *Invitation) IsExpired(now time.Time) bool {
+	return !now.Before(i.expiresAt)
+}
+
+// IsExhausted checks if the invitation has no uses left
+func (i *Invitation) IsExhausted() bool {
+	return i.uses >= i.maxUses
+}
+
+// Status returns the state of the invitation at the given time
+func (i *Invitation) Status(now time.Time) string {
+	switch {
+	case i.IsRevoked():
+		return InvitationRevoked
+	case i.IsExpired(now):
+		return InvitationExpired
+	case i.IsExhausted():
+		return InvitationExhausted
+	default:
+		return InvitationActive
+	}
+}
+
+// EnsureUsable returns an error describing why the invitation cannot be accepted at the given time, if any
+func (i *Invitation) EnsureUsable(now time.Time) error {
+	switch i.Status(now) {
+	case InvitationRevoked:
+		return errors.New("invitation has been revoked")
+	case InvitationExpired:
+		return errors.New("invitation has expired")
+	case InvitationExhausted:
+		return errors.New("invitation has reached its maximum number of uses")
+	default:
+		return nil
+	}
+}
+
+// Revoke invalidates the invitation so it can no longer be accepted
+func (i *Invitation) Revoke(revokedBy valueobjects.UserID) error {
+	if revokedBy.IsZero() {
+		return errors.New("revoker ID cannot be zero")
+	}
+	if i.IsRevoked() {
+		return errors.New("invitation is already revoked")
+	}
+
+	now := time.Now()
+	i.revokedBy = &revokedBy
+	i.revokedAt = &now
+	i.updatedAt = now
+	return nil
+}
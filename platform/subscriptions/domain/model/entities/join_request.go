@@ -0,0 +1,170 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// JoinRequest represents a user's request to become a member of a private community.
+// It starts as pending and is approved or rejected by a community owner/admin, or withdrawn by the user.
+type JoinRequest struct {
+	id            string
+	joinRequestID valueobjects.JoinRequestID
+	userID        valueobjects.UserID
+	communityID   valueobjects.CommunityID
+	status        valueobjects.JoinRequestStatus
+	message       valueobjects.JoinRequestMessage
+	reviewedBy    *valueobjects.UserID
+	reviewedAt    *time.Time
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+// NewJoinRequest creates a new pending JoinRequest
+func NewJoinRequest(
+	userID valueobjects.UserID,
+	communityID valueobjects.CommunityID,
+	message valueobjects.JoinRequestMessage,
+) (*JoinRequest, error) {
+	if userID.IsZero() {
+		return nil, errors.New("user ID cannot be zero")
+	}
+	if communityID.IsZero() {
+		return nil, errors.New("community ID cannot be empty")
+	}
+
+	now := time.Now()
+	joinRequestID := valueobjects.GenerateJoinRequestID()
+
+	return &JoinRequest{
+		id:            joinRequestID.Value(),
+		joinRequestID: joinRequestID,
+		userID:        userID,
+		communityID:   communityID,
+		status:        valueobjects.JoinRequestPending,
+		message:       message,
+		createdAt:     now,
+		updatedAt:     now,
+	}, nil
+}
+
+// ReconstructJoinRequest reconstructs a JoinRequest from persistence
+func ReconstructJoinRequest(
+	id string,
+	joinRequestID valueobjects.JoinRequestID,
+	userID valueobjects.UserID,
+	communityID valueobjects.CommunityID,
+	status valueobjects.JoinRequestStatus,
+	message valueobjects.JoinRequestMessage,
+	reviewedBy *valueobjects.UserID,
+	reviewedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *JoinRequest {
+	return &JoinRequest{
+		id:            id,
+		joinRequestID: joinRequestID,
+		userID:        userID,
+		communityID:   communityID,
+		status:        status,
+		message:       message,
+		reviewedBy:    reviewedBy,
+		reviewedAt:    reviewedAt,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
+
+// ID returns the MongoDB document ID
+func (j *JoinRequest) ID() string {
+	return j.id
+}
+
+// JoinRequestID returns the join request ID
+func (j *JoinRequest) JoinRequestID() valueobjects.JoinRequestID {
+	return j.joinRequestID
+}
+
+// UserID returns the ID of the user asking to join
+func (j *JoinRequest) UserID() valueobjects.UserID {
+	return j.userID
+}
+
+// CommunityID returns the community the user wants to join
+func (j *JoinRequest) CommunityID() valueobjects.CommunityID {
+	return j.communityID
+}
+
+// Status returns the current status
+func (j *JoinRequest) Status() valueobjects.JoinRequestStatus {
+	return j.status
+}
+
+// Message returns the optional message left by the user
+func (j *JoinRequest) Message() valueobjects.JoinRequestMessage {
+	return j.message
+}
+
+// ReviewedBy returns the owner/admin who approved or rejected the request, if any
+func (j *JoinRequest) ReviewedBy() *valueobjects.UserID {
+	return j.reviewedBy
+}
+
+// ReviewedAt returns when the request was approved or rejected, if it was
+func (j *JoinRequest) ReviewedAt() *time.Time {
+	return j.reviewedAt
+}
+
+// CreatedAt returns the creation timestamp
+func (j *JoinRequest) CreatedAt() time.Time {
+	return j.createdAt
+}
+
+// UpdatedAt returns the last update timestamp
+func (j *JoinRequest) UpdatedAt() time.Time {
+	return j.updatedAt
+}
+
+// IsPending checks if the request is still awaiting a decision
+func (j *JoinRequest) IsPending() bool {
+	return j.status.IsPending()
+}
+
+// Approve marks the request as approved by the given reviewer
+func (j *JoinRequest) Approve(reviewer valueobjects.UserID) error {
+	return j.review(reviewer, valueobjects.JoinRequestApproved)
+}
+
+// Reject marks the request as rejected by the given reviewer
+func (j *JoinRequest) Reject(reviewer valueobjects.UserID) error {
+	return j.review(reviewer, valueobjects.JoinRequestRejected)
+}
+
+// Withdraw marks the request as withdrawn by the requesting user
+func (j *JoinRequest) Withdraw() error {
+	if !j.IsPending() {
+		return errors.New("join request is no longer pending")
+	}
+
+	j.status = valueobjects.JoinRequestWithdrawn
+	j.updatedAt = time.Now()
+	return nil
+}
+
+func (j *JoinRequest) review(reviewer valueobjects.UserID, status valueobjects.JoinRequestStatus) error {
+	if reviewer.IsZero() {
+		return errors.New("reviewer ID cannot be zero")
+	}
+	if !j.IsPending() {
+		return errors.New("join request is no longer pending")
+	}
+
+	now := time.Now()
+	j.status = status
+	j.reviewedBy = &reviewer
+	j.reviewedAt = &now
+	j.updatedAt = now
+	return nil
+}
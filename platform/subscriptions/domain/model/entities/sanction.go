@@ -0,0 +1,160 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// Sanction is a ban or a mute issued by a community owner/admin against a user.
+// It is kept apart from the Subscription so that leaving and rejoining the community does not lift it.
+type Sanction struct {
+	id           string
+	sanctionID   valueobjects.SanctionID
+	userID       valueobjects.UserID
+	communityID  valueobjects.CommunityID
+	sanctionType valueobjects.SanctionType
+	reason       valueobjects.SanctionReason
+	issuedBy     valueobjects.UserID
+	expiresAt    *time.Time
+	createdAt    time.Time
+}
+
+// NewBan creates a ban. A nil expiresAt makes the ban permanent until it is lifted.
+func NewBan(
+	userID valueobjects.UserID,
+	communityID valueobjects.CommunityID,
+	issuedBy valueobjects.UserID,
+	reason valueobjects.SanctionReason,
+	expiresAt *time.Time,
+) (*Sanction, error) {
+	return newSanction(userID, communityID, valueobjects.BanSanction, issuedBy, reason, expiresAt)
+}
+
+// NewMute creates a mute that ends at the given time
+func NewMute(
+	userID valueobjects.UserID,
+	communityID valueobjects.CommunityID,
+	issuedBy valueobjects.UserID,
+	reason valueobjects.SanctionReason,
+	until time.Time,
+) (*Sanction, error) {
+	if until.IsZero() {
+		return nil, errors.New("a mute needs an end time")
+	}
+	return newSanction(userID, communityID, valueobjects.MuteSanction, issuedBy, reason, &until)
+}
+
+func newSanction(
+	userID valueobjects.UserID,
+	communityID valueobjects.CommunityID,
+	sanctionType valueobjects.SanctionType,
+	issuedBy valueobjects.UserID,
+	reason valueobjects.SanctionReason,
+	expiresAt *time.Time,
+) (*Sanction, error) {
+	if userID.IsZero() {
+		return nil, errors.New("user ID cannot be zero")
+	}
+	if communityID.IsZero() {
+		return nil, errors.New("community ID cannot be empty")
+	}
+	if issuedBy.IsZero() {
+		return nil, errors.New("issuer ID cannot be zero")
+	}
+
+	now := time.Now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, errors.New("sanction end time must be in the future")
+	}
+
+	sanctionID := valueobjects.GenerateSanctionID()
+
+	return &Sanction{
+		id:           sanctionID.Value(),
+		sanctionID:   sanctionID,
+		userID:       userID,
+		communityID:  communityID,
+		sanctionType: sanctionType,
+		reason:       reason,
+		issuedBy:     issuedBy,
+		expiresAt:    expiresAt,
+		createdAt:    now,
+	}, nil
+}
+
+// ReconstructSanction reconstructs a Sanction from persistence
+func ReconstructSanction(
+	id string,
+	sanctionID valueobjects.SanctionID,
+	userID valueobjects.UserID,
+	communityID valueobjects.CommunityID,
+	sanctionType valueobjects.SanctionType,
+	reason valueobjects.SanctionReason,
+	issuedBy valueobjects.UserID,
+	expiresAt *time.Time,
+	createdAt time.Time,
+) *Sanction {
+	return &Sanction{
+		id:           id,
+		sanctionID:   sanctionID,
+		userID:       userID,
+		communityID:  communityID,
+		sanctionType: sanctionType,
+		reason:       reason,
+		issuedBy:     issuedBy,
+		expiresAt:    expiresAt,
+		createdAt:    createdAt,
+	}
+}
+
+// ID returns the MongoDB document ID
+func (s *Sanction) ID() string {
+	return s.id
+}
+
+// SanctionID returns the sanction ID
+func (s *Sanction) SanctionID() valueobjects.SanctionID {
+	return s.sanctionID
+}
+
+// UserID returns the sanctioned user
+func (s *Sanction) UserID() valueobjects.UserID {
+	return s.userID
+}
+
+// CommunityID returns the community the sanction applies to
+func (s *Sanction) CommunityID() valueobjects.CommunityID {
+	return s.communityID
+}
+
+// Type returns whether this is a ban or a mute
+func (s *Sanction) Type() valueobjects.SanctionType {
+	return s.sanctionType
+}
+
+// Reason returns the optional reason given by the moderator
+func (s *Sanction) Reason() valueobjects.SanctionReason {
+	return s.reason
+}
+
+// IssuedBy returns the owner/admin who issued the sanction
+func (s *Sanction) IssuedBy() valueobjects.UserID {
+	return s.issuedBy
+}
+
+// ExpiresAt returns when the sanction ends, or nil for a permanent ban
+func (s *Sanction) ExpiresAt() *time.Time {
+	return s.expiresAt
+}
+
+// CreatedAt returns the creation timestamp
+func (s *Sanction) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+// IsActive checks if the sanction still applies at the given time
+func (s *Sanction) IsActive(now time.Time) bool {
+	return s.expiresAt == nil || s.expiresAt.After(now)
+}
@@ -17,6 +17,8 @@ type Subscription struct {
 	role           valueobjects.CommunityRole  `bson:"role"`
 	createdAt      time.Time                   `bson:"created_at"`
 	updatedAt      time.Time                   `bson:"updated_at"`
+	expiresAt      *time.Time
+	alumniRole     *valueobjects.CommunityRole
 }
 
 // NewSubscription creates a new Subscription aggregate
@@ -56,6 +58,8 @@ func ReconstructSubscription(
 	userID valueobjects.UserID,
 	communityID valueobjects.CommunityID,
 	role valueobjects.CommunityRole,
+	expiresAt *time.Time,
+	alumniRole *valueobjects.CommunityRole,
 	createdAt time.Time,
 	updatedAt time.Time,
 ) *Subscription {
@@ -65,6 +69,8 @@ func ReconstructSubscription(
 		userID:         userID,
 		communityID:    communityID,
 		role:           role,
+		expiresAt:      expiresAt,
+		alumniRole:     alumniRole,
 		createdAt:      createdAt,
 		updatedAt:      updatedAt,
 	}
@@ -95,6 +101,22 @@ func (s *Subscription) Role() valueobjects.CommunityRole {
 	return s.role
 }
 
+// ExpiresAt returns when the membership ends, or nil when it does not expire
+func (s *Subscription) ExpiresAt() *time.Time {
+	return s.expiresAt
+}
+
+// AlumniRole returns the role the member moves to once the membership expires.
+// Nil means the subscription is removed on expiry.
+func (s *Subscription) AlumniRole() *valueobjects.CommunityRole {
+	return s.alumniRole
+}
+
+// IsExpired checks if the membership has expired at the given time
+func (s *Subscription) IsExpired(now time.Time) bool {
+	return s.expiresAt != nil && !s.expiresAt.After(now)
+}
+
 // CreatedAt returns the creation timestamp
 func (s *Subscription) CreatedAt() time.Time {
 	return s.createdAt
@@ -112,6 +134,51 @@ func (s *Subscription) UpdateRole(newRole valueobjects.CommunityRole) error {
 	}
 
 	s.role = newRole
+	// The owner always stays subscribed
+	if newRole.IsOwner() {
+		s.expiresAt = nil
+		s.alumniRole = nil
+	}
+	s.updatedAt = time.Now()
+	return nil
+}
+
+// SetExpiry makes the membership end at expiresAt. When alumniRole is set the member moves to that role
+// on expiry instead of being removed; it cannot be the owner or admin role.
+func (s *Subscription) SetExpiry(expiresAt time.Time, alumniRole *valueobjects.CommunityRole) error {
+	if s.role.IsOwner() {
+		return errors.New("the community owner's membership cannot expire")
+	}
+	if !expiresAt.After(time.Now()) {
+		return errors.New("expiry date must be in the future")
+	}
+	if alumniRole != nil && alumniRole.IsAdminOrOwner() {
+		return errors.New("alumni role must be member or a custom role")
+	}
+
+	s.expiresAt = &expiresAt
+	s.alumniRole = alumniRole
+	s.updatedAt = time.Now()
+	return nil
+}
+
+// ExtendExpiry moves the end of an expiring membership to expiresAt, keeping its alumni role
+func (s *Subscription) ExtendExpiry(expiresAt time.Time) error {
+	if s.expiresAt == nil {
+		return errors.New("membership does not expire")
+	}
+	return s.SetExpiry(expiresAt, s.alumniRole)
+}
+
+// MoveToAlumniRole applies the alumni role of an expired membership. The membership no longer expires afterwards.
+func (s *Subscription) MoveToAlumniRole() error {
+	if s.alumniRole == nil {
+		return errors.New("membership has no alumni role")
+	}
+
+	s.role = *s.alumniRole
+	s.expiresAt = nil
+	s.alumniRole = nil
 	s.updatedAt = time.Now()
 	return nil
 }
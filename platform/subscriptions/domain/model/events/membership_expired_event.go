@@ -0,0 +1,71 @@
+package events
+
+import (
+	"time"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// MembershipExpiredEvent is raised when the expiry sweeper ends a membership.
+// AlumniRole is the role the member was moved to, or nil when the subscription was removed.
+type MembershipExpiredEvent struct {
+	subscriptionID valueobjects.SubscriptionID
+	userID         valueobjects.UserID
+	communityID    valueobjects.CommunityID
+	formerRole     valueobjects.CommunityRole
+	alumniRole     *valueobjects.CommunityRole
+	expiredAt      time.Time
+	occurredOn     time.Time
+}
+
+func NewMembershipExpiredEvent(
+	subscriptionID valueobjects.SubscriptionID,
+	userID valueobjects.UserID,
+	communityID valueobjects.CommunityID,
+	formerRole valueobjects.CommunityRole,
+	alumniRole *valueobjects.CommunityRole,
+	expiredAt time.Time,
+) MembershipExpiredEvent {
+	return MembershipExpiredEvent{
+		subscriptionID: subscriptionID,
+		userID:         userID,
+		communityID:    communityID,
+		formerRole:     formerRole,
+		alumniRole:     alumniRole,
+		expiredAt:      expiredAt,
+		occurredOn:     time.Now(),
+	}
+}
+
+func (e MembershipExpiredEvent) SubscriptionID() valueobjects.SubscriptionID {
+	return e.subscriptionID
+}
+
+func (e MembershipExpiredEvent) UserID() valueobjects.UserID {
+	return e.userID
+}
+
+func (e MembershipExpiredEvent) CommunityID() valueobjects.CommunityID {
+	return e.communityID
+}
+
+func (e MembershipExpiredEvent) FormerRole() valueobjects.CommunityRole {
+	return e.formerRole
+}
+
+func (e MembershipExpiredEvent) AlumniRole() *valueobjects.CommunityRole {
+	return e.alumniRole
+}
+
+// IsRemoval indicates whether the subscription was removed rather than moved to an alumni role
+func (e MembershipExpiredEvent) IsRemoval() bool {
+	return e.alumniRole == nil
+}
+
+func (e MembershipExpiredEvent) ExpiredAt() time.Time {
+	return e.expiredAt
+}
+
+func (e MembershipExpiredEvent) OccurredOn() time.Time {
+	return e.occurredOn
+}
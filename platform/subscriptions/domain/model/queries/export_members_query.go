@@ -0,0 +1,35 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// ExportMembersQuery represents a request by an owner/admin to export the member list of a community
+type ExportMembersQuery struct {
+	communityID valueobjects.CommunityID
+	requestedBy valueobjects.UserID
+}
+
+func NewExportMembersQuery(communityID valueobjects.CommunityID, requestedBy valueobjects.UserID) (ExportMembersQuery, error) {
+	if communityID.IsZero() {
+		return ExportMembersQuery{}, errors.New("community ID cannot be empty")
+	}
+	if requestedBy.IsZero() {
+		return ExportMembersQuery{}, errors.New("requestedBy ID cannot be zero")
+	}
+
+	return ExportMembersQuery{
+		communityID: communityID,
+		requestedBy: requestedBy,
+	}, nil
+}
+
+func (q ExportMembersQuery) CommunityID() valueobjects.CommunityID {
+	return q.communityID
+}
+
+func (q ExportMembersQuery) RequestedBy() valueobjects.UserID {
+	return q.requestedBy
+}
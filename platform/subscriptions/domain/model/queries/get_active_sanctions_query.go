@@ -0,0 +1,48 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// GetActiveSanctionsQuery represents a request by an owner/admin to list the bans or mutes in force in a community
+type GetActiveSanctionsQuery struct {
+	communityID  valueobjects.CommunityID
+	requestedBy  valueobjects.UserID
+	sanctionType valueobjects.SanctionType
+}
+
+func NewGetActiveSanctionsQuery(
+	communityID valueobjects.CommunityID,
+	requestedBy valueobjects.UserID,
+	sanctionType valueobjects.SanctionType,
+) (GetActiveSanctionsQuery, error) {
+	if communityID.IsZero() {
+		return GetActiveSanctionsQuery{}, errors.New("community ID cannot be empty")
+	}
+	if requestedBy.IsZero() {
+		return GetActiveSanctionsQuery{}, errors.New("requestedBy ID cannot be zero")
+	}
+	if sanctionType.IsZero() {
+		return GetActiveSanctionsQuery{}, errors.New("sanction type cannot be empty")
+	}
+
+	return GetActiveSanctionsQuery{
+		communityID:  communityID,
+		requestedBy:  requestedBy,
+		sanctionType: sanctionType,
+	}, nil
+}
+
+func (q GetActiveSanctionsQuery) CommunityID() valueobjects.CommunityID {
+	return q.communityID
+}
+
+func (q GetActiveSanctionsQuery) RequestedBy() valueobjects.UserID {
+	return q.requestedBy
+}
+
+func (q GetActiveSanctionsQuery) SanctionType() valueobjects.SanctionType {
+	return q.sanctionType
+}
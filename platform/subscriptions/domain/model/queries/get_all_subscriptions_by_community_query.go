@@ -2,15 +2,26 @@ package queries
 
 import (
 	"errors"
+	"strings"
 
 	"Gommunity/platform/subscriptions/domain/model/valueobjects"
 )
 
-// GetAllSubscriptionsByCommunityQuery represents a request to get all subscriptions for a specific community
+// Page sizes of the member directory
+const (
+	DefaultMemberPageSize = 20
+	MaxMemberPageSize     = 100
+)
+
+// GetAllSubscriptionsByCommunityQuery represents a request to browse the member directory of a community
 type GetAllSubscriptionsByCommunityQuery struct {
-	communityID valueobjects.CommunityID
-	limit       *int
-	offset      *int
+	communityID    valueobjects.CommunityID
+	limit          *int
+	offset         *int
+	cursor         valueobjects.Cursor
+	usernamePrefix string
+	role           *valueobjects.CommunityRole
+	sort           valueobjects.MemberSort
 }
 
 func NewGetAllSubscriptionsByCommunityQuery(communityID valueobjects.CommunityID) (GetAllSubscriptionsByCommunityQuery, error) {
@@ -29,6 +40,30 @@ func (q GetAllSubscriptionsByCommunityQuery) WithPagination(limit, offset int) G
 	return q
 }
 
+// WithCursor continues the listing right after the cursor. It takes precedence over the offset.
+func (q GetAllSubscriptionsByCommunityQuery) WithCursor(cursor valueobjects.Cursor) GetAllSubscriptionsByCommunityQuery {
+	q.cursor = cursor
+	return q
+}
+
+// WithUsernamePrefix keeps the members whose username starts with the prefix, ignoring case
+func (q GetAllSubscriptionsByCommunityQuery) WithUsernamePrefix(prefix string) GetAllSubscriptionsByCommunityQuery {
+	q.usernamePrefix = strings.ToLower(strings.TrimSpace(prefix))
+	return q
+}
+
+// WithRole keeps the members holding the role
+func (q GetAllSubscriptionsByCommunityQuery) WithRole(role valueobjects.CommunityRole) GetAllSubscriptionsByCommunityQuery {
+	q.role = &role
+	return q
+}
+
+// WithSort sets the order of the directory. Members are listed newest first by default.
+func (q GetAllSubscriptionsByCommunityQuery) WithSort(sort valueobjects.MemberSort) GetAllSubscriptionsByCommunityQuery {
+	q.sort = sort
+	return q
+}
+
 func (q GetAllSubscriptionsByCommunityQuery) CommunityID() valueobjects.CommunityID {
 	return q.communityID
 }
@@ -40,3 +75,20 @@ func (q GetAllSubscriptionsByCommunityQuery) Limit() *int {
 func (q GetAllSubscriptionsByCommunityQuery) Offset() *int {
 	return q.offset
 }
+
+func (q GetAllSubscriptionsByCommunityQuery) Cursor() valueobjects.Cursor {
+	return q.cursor
+}
+
+// UsernamePrefix returns the lowercased username prefix, empty when members are not searched
+func (q GetAllSubscriptionsByCommunityQuery) UsernamePrefix() string {
+	return q.usernamePrefix
+}
+
+func (q GetAllSubscriptionsByCommunityQuery) Role() *valueobjects.CommunityRole {
+	return q.role
+}
+
+func (q GetAllSubscriptionsByCommunityQuery) Sort() valueobjects.MemberSort {
+	return q.sort
+}
@@ -0,0 +1,26 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// GetCustomRolesByCommunityQuery represents a request to list the custom roles defined in a community
+type GetCustomRolesByCommunityQuery struct {
+	communityID valueobjects.CommunityID
+}
+
+func NewGetCustomRolesByCommunityQuery(communityID valueobjects.CommunityID) (GetCustomRolesByCommunityQuery, error) {
+	if communityID.IsZero() {
+		return GetCustomRolesByCommunityQuery{}, errors.New("community ID cannot be empty")
+	}
+
+	return GetCustomRolesByCommunityQuery{
+		communityID: communityID,
+	}, nil
+}
+
+func (q GetCustomRolesByCommunityQuery) CommunityID() valueobjects.CommunityID {
+	return q.communityID
+}
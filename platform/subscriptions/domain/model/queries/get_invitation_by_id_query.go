@@ -0,0 +1,26 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// GetInvitationByIDQuery represents a request to get a single invitation
+type GetInvitationByIDQuery struct {
+	invitationID valueobjects.InvitationID
+}
+
+func NewGetInvitationByIDQuery(invitationID valueobjects.InvitationID) (GetInvitationByIDQuery, error) {
+	if invitationID.IsZero() {
+		return GetInvitationByIDQuery{}, errors.New("invitation ID cannot be empty")
+	}
+
+	return GetInvitationByIDQuery{
+		invitationID: invitationID,
+	}, nil
+}
+
+func (q GetInvitationByIDQuery) InvitationID() valueobjects.InvitationID {
+	return q.invitationID
+}
@@ -0,0 +1,38 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// GetInvitationsByCommunityQuery represents a request by an owner/admin to list the invitations of a community
+type GetInvitationsByCommunityQuery struct {
+	communityID valueobjects.CommunityID
+	requestedBy valueobjects.UserID
+}
+
+func NewGetInvitationsByCommunityQuery(
+	communityID valueobjects.CommunityID,
+	requestedBy valueobjects.UserID,
+) (GetInvitationsByCommunityQuery, error) {
+	if communityID.IsZero() {
+		return GetInvitationsByCommunityQuery{}, errors.New("community ID cannot be empty")
+	}
+	if requestedBy.IsZero() {
+		return GetInvitationsByCommunityQuery{}, errors.New("requestedBy ID cannot be zero")
+	}
+
+	return GetInvitationsByCommunityQuery{
+		communityID: communityID,
+		requestedBy: requestedBy,
+	}, nil
+}
+
+func (q GetInvitationsByCommunityQuery) CommunityID() valueobjects.CommunityID {
+	return q.communityID
+}
+
+func (q GetInvitationsByCommunityQuery) RequestedBy() valueobjects.UserID {
+	return q.requestedBy
+}
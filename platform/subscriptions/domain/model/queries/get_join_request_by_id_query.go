@@ -0,0 +1,26 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// GetJoinRequestByIDQuery represents a request to get a single join request
+type GetJoinRequestByIDQuery struct {
+	joinRequestID valueobjects.JoinRequestID
+}
+
+func NewGetJoinRequestByIDQuery(joinRequestID valueobjects.JoinRequestID) (GetJoinRequestByIDQuery, error) {
+	if joinRequestID.IsZero() {
+		return GetJoinRequestByIDQuery{}, errors.New("join request ID cannot be empty")
+	}
+
+	return GetJoinRequestByIDQuery{
+		joinRequestID: joinRequestID,
+	}, nil
+}
+
+func (q GetJoinRequestByIDQuery) JoinRequestID() valueobjects.JoinRequestID {
+	return q.joinRequestID
+}
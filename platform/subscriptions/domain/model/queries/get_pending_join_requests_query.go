@@ -0,0 +1,38 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// GetPendingJoinRequestsQuery represents a request by an owner/admin to list the pending join requests of a community
+type GetPendingJoinRequestsQuery struct {
+	communityID valueobjects.CommunityID
+	requestedBy valueobjects.UserID
+}
+
+func NewGetPendingJoinRequestsQuery(
+	communityID valueobjects.CommunityID,
+	requestedBy valueobjects.UserID,
+) (GetPendingJoinRequestsQuery, error) {
+	if communityID.IsZero() {
+		return GetPendingJoinRequestsQuery{}, errors.New("community ID cannot be empty")
+	}
+	if requestedBy.IsZero() {
+		return GetPendingJoinRequestsQuery{}, errors.New("requestedBy ID cannot be zero")
+	}
+
+	return GetPendingJoinRequestsQuery{
+		communityID: communityID,
+		requestedBy: requestedBy,
+	}, nil
+}
+
+func (q GetPendingJoinRequestsQuery) CommunityID() valueobjects.CommunityID {
+	return q.communityID
+}
+
+func (q GetPendingJoinRequestsQuery) RequestedBy() valueobjects.UserID {
+	return q.requestedBy
+}
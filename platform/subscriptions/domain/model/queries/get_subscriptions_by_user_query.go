@@ -0,0 +1,26 @@
+package queries
+
+import (
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// GetSubscriptionsByUserQuery represents a request to list the communities a user belongs to
+type GetSubscriptionsByUserQuery struct {
+	userID valueobjects.UserID
+}
+
+func NewGetSubscriptionsByUserQuery(userID valueobjects.UserID) (GetSubscriptionsByUserQuery, error) {
+	if userID.IsZero() {
+		return GetSubscriptionsByUserQuery{}, errors.New("user ID cannot be zero")
+	}
+
+	return GetSubscriptionsByUserQuery{
+		userID: userID,
+	}, nil
+}
+
+func (q GetSubscriptionsByUserQuery) UserID() valueobjects.UserID {
+	return q.userID
+}
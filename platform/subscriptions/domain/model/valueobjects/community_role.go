@@ -2,6 +2,7 @@ package valueobjects
 
 import (
 	"errors"
+	"regexp"
 	"strings"
 )
 
@@ -16,24 +17,32 @@ const (
 
 // CommunityRole represents a role that a user has within a specific community
 // Roles are scoped to communities - a user can have different roles in different communities
-// Valid roles: member, admin, owner
+// Predefined roles: member, admin, owner. Any other valid name refers to a custom role defined by the community owner.
 type CommunityRole struct {
 	value string `json:"value" bson:"role"`
 }
 
-var validCommunityRoles = map[string]bool{
+var predefinedCommunityRoles = map[string]bool{
 	MemberRoleName: true,
 	AdminRoleName:  true,
 	OwnerRoleName:  true,
 }
 
+// Custom role names are slugs such as "teaching_assistant"; spaces are turned into underscores
+var (
+	customRoleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,29}$`)
+	whitespacePattern     = regexp.MustCompile(`\s+`)
+)
+
+// NewCommunityRole creates a role from its name. It does not check that a custom role
+// is actually defined in the community; that is up to the caller.
 func NewCommunityRole(value string) (CommunityRole, error) {
-	normalized := strings.ToLower(strings.TrimSpace(value))
+	normalized := whitespacePattern.ReplaceAllString(strings.ToLower(strings.TrimSpace(value)), "_")
 	if normalized == "" {
 		return CommunityRole{}, errors.New("community role cannot be empty")
 	}
-	if !validCommunityRoles[normalized] {
-		return CommunityRole{}, errors.New("invalid community role: must be one of member, admin, owner")
+	if !predefinedCommunityRoles[normalized] && !customRoleNamePattern.MatchString(normalized) {
+		return CommunityRole{}, errors.New("invalid community role: must be member, admin, owner or a custom role name of 2 to 30 lowercase letters, digits or underscores")
 	}
 	return CommunityRole{value: normalized}, nil
 }
@@ -74,6 +83,11 @@ func (r CommunityRole) IsMember() bool {
 	return r.value == MemberRoleName
 }
 
+// IsCustom checks if the role is a custom role defined by the community owner
+func (r CommunityRole) IsCustom() bool {
+	return r.value != "" && !predefinedCommunityRoles[r.value]
+}
+
 // Predefined roles for convenience
 var (
 	MemberRole = CommunityRole{value: MemberRoleName}
@@ -0,0 +1,87 @@
+package valueobjects
+
+import "testing"
+
+func TestNewCommunityRolePredefinedRoles(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    CommunityRole
+		isAdmin bool
+		isOwner bool
+	}{
+		{input: "member", want: MemberRole},
+		{input: "admin", want: AdminRole, isAdmin: true},
+		{input: "owner", want: OwnerRole, isOwner: true},
+		{input: " Admin ", want: AdminRole, isAdmin: true},
+	}
+	for _, tt := range tests {
+		role, err := NewCommunityRole(tt.input)
+		if err != nil {
+			t.Errorf("NewCommunityRole(%q) returned an error: %v", tt.input, err)
+			continue
+		}
+		if !role.Equals(tt.want) {
+			t.Errorf("NewCommunityRole(%q) = %q, want %q", tt.input, role.Value(), tt.want.Value())
+		}
+		if role.IsCustom() {
+			t.Errorf("NewCommunityRole(%q) should not be a custom role", tt.input)
+		}
+		if role.IsAdmin() != tt.isAdmin || role.IsOwner() != tt.isOwner {
+			t.Errorf("NewCommunityRole(%q): IsAdmin = %v, IsOwner = %v", tt.input, role.IsAdmin(), role.IsOwner())
+		}
+		if role.IsAdminOrOwner() != (tt.isAdmin || tt.isOwner) {
+			t.Errorf("NewCommunityRole(%q): IsAdminOrOwner = %v", tt.input, role.IsAdminOrOwner())
+		}
+	}
+}
+
+func TestNewCommunityRoleCustomRoles(t *testing.T) {
+	tests := map[string]string{
+		"teaching_assistant":             "teaching_assistant",
+		"Teaching Assistant":             "teaching_assistant",
+		"  mentor  ":                     "mentor",
+		"study  group\tlead":             "study_group_lead",
+		"tutor2":                         "tutor2",
+		"ab":                             "ab",
+		"abcdefghijklmnopqrstuvwxyz1234": "abcdefghijklmnopqrstuvwxyz1234",
+	}
+	for input, want := range tests {
+		role, err := NewCommunityRole(input)
+		if err != nil {
+			t.Errorf("NewCommunityRole(%q) returned an error: %v", input, err)
+			continue
+		}
+		if role.Value() != want {
+			t.Errorf("NewCommunityRole(%q) = %q, want %q", input, role.Value(), want)
+		}
+		if !role.IsCustom() || role.IsAdminOrOwner() || role.IsMember() {
+			t.Errorf("NewCommunityRole(%q) should be a custom role", input)
+		}
+	}
+}
+
+func TestNewCommunityRoleRejectsInvalidNames(t *testing.T) {
+	tests := map[string]string{
+		"empty":            "",
+		"blank":            "   ",
+		"single character": "a",
+		"leading digit":    "2nd_mentor",
+		"leading _":        "_mentor",
+		"punctuation":      "mentor!",
+		"hyphen":           "study-lead",
+		"too long":         "abcdefghijklmnopqrstuvwxyz12345",
+	}
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewCommunityRole(input); err == nil {
+				t.Errorf("NewCommunityRole(%q) should fail", input)
+			}
+		})
+	}
+}
+
+func TestZeroCommunityRoleIsNotCustom(t *testing.T) {
+	if (CommunityRole{}).IsCustom() {
+		t.Error("the zero role should not be a custom role")
+	}
+}
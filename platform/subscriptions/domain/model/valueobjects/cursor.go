@@ -0,0 +1,67 @@
+package valueobjects
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cursor is an opaque keyset position (created_at + subscription ID) used to page through subscriptions.
+type Cursor struct {
+	createdAt      time.Time
+	subscriptionID string
+}
+
+// NewCursor builds a cursor pointing right after the given subscription.
+func NewCursor(createdAt time.Time, subscriptionID SubscriptionID) Cursor {
+	return Cursor{createdAt: createdAt, subscriptionID: subscriptionID.Value()}
+}
+
+// ParseCursor decodes an opaque cursor token.
+func ParseCursor(token string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, errors.New("invalid cursor")
+	}
+
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 {
+		return Cursor{}, errors.New("invalid cursor")
+	}
+
+	unix, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return Cursor{}, errors.New("invalid cursor")
+	}
+
+	subscriptionID, err := NewSubscriptionID(parts[1])
+	if err != nil {
+		return Cursor{}, errors.New("invalid cursor")
+	}
+
+	return Cursor{createdAt: time.Unix(unix, 0), subscriptionID: subscriptionID.Value()}, nil
+}
+
+// Encode returns the opaque token form of the cursor.
+func (c Cursor) Encode() string {
+	raw := fmt.Sprintf("%d:%s", c.createdAt.Unix(), c.subscriptionID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// CreatedAt returns the creation timestamp of the last seen subscription.
+func (c Cursor) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// SubscriptionID returns the identifier of the last seen subscription.
+func (c Cursor) SubscriptionID() string {
+	return c.subscriptionID
+}
+
+// IsZero indicates if the cursor is unset.
+func (c Cursor) IsZero() bool {
+	return c.subscriptionID == ""
+}
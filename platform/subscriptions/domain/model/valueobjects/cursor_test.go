@@ -0,0 +1,68 @@
+package valueobjects
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	subscriptionID := GenerateSubscriptionID()
+	createdAt := time.Unix(1700000000, 0)
+
+	cursor, err := ParseCursor(NewCursor(createdAt, subscriptionID).Encode())
+	if err != nil {
+		t.Fatalf("ParseCursor returned an error: %v", err)
+	}
+	if !cursor.CreatedAt().Equal(createdAt) {
+		t.Errorf("CreatedAt = %v, want %v", cursor.CreatedAt(), createdAt)
+	}
+	if cursor.SubscriptionID() != subscriptionID.Value() {
+		t.Errorf("SubscriptionID = %q, want %q", cursor.SubscriptionID(), subscriptionID.Value())
+	}
+	if cursor.IsZero() {
+		t.Error("parsed cursor should not be zero")
+	}
+}
+
+func TestCursorDropsSubSecondPrecision(t *testing.T) {
+	createdAt := time.Unix(1700000000, 999999999)
+
+	cursor, err := ParseCursor(NewCursor(createdAt, GenerateSubscriptionID()).Encode())
+	if err != nil {
+		t.Fatalf("ParseCursor returned an error: %v", err)
+	}
+	if cursor.CreatedAt().Unix() != createdAt.Unix() || cursor.CreatedAt().Nanosecond() != 0 {
+		t.Errorf("CreatedAt = %v, want %v truncated to the second", cursor.CreatedAt(), createdAt)
+	}
+}
+
+func TestParseCursorRejectsInvalidTokens(t *testing.T) {
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+	subscriptionID := GenerateSubscriptionID().Value()
+
+	tests := map[string]string{
+		"empty":                   "",
+		"not base64":              "not a cursor!",
+		"padded base64":           base64.URLEncoding.EncodeToString([]byte("1700000000:" + subscriptionID)),
+		"missing separator":       encode("1700000000"),
+		"invalid timestamp":       encode("yesterday:" + subscriptionID),
+		"invalid subscription ID": encode("1700000000:42"),
+		"empty subscription ID":   encode("1700000000:"),
+	}
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseCursor(token); err == nil {
+				t.Errorf("ParseCursor(%q) should fail", token)
+			}
+		})
+	}
+}
+
+func TestZeroCursor(t *testing.T) {
+	if !(Cursor{}).IsZero() {
+		t.Error("the zero cursor should report IsZero")
+	}
+}
@@ -0,0 +1,42 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CustomRoleID identifies a custom role defined in a community
+type CustomRoleID struct {
+	value string
+}
+
+func NewCustomRoleID(value string) (CustomRoleID, error) {
+	if value == "" {
+		return CustomRoleID{}, errors.New("custom role ID cannot be empty")
+	}
+	if !primitive.IsValidObjectID(value) {
+		return CustomRoleID{}, errors.New("custom role ID must be a valid ObjectID")
+	}
+	return CustomRoleID{value: value}, nil
+}
+
+func GenerateCustomRoleID() CustomRoleID {
+	return CustomRoleID{value: primitive.NewObjectID().Hex()}
+}
+
+func (s CustomRoleID) Value() string {
+	return s.value
+}
+
+func (s CustomRoleID) String() string {
+	return s.value
+}
+
+func (s CustomRoleID) IsZero() bool {
+	return s.value == ""
+}
+
+func (s CustomRoleID) Equals(other CustomRoleID) bool {
+	return s.value == other.value
+}
@@ -0,0 +1,42 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// InvitationID identifies an invitation link to a community
+type InvitationID struct {
+	value string
+}
+
+func NewInvitationID(value string) (InvitationID, error) {
+	if value == "" {
+		return InvitationID{}, errors.New("invitation ID cannot be empty")
+	}
+	if !primitive.IsValidObjectID(value) {
+		return InvitationID{}, errors.New("invitation ID must be a valid ObjectID")
+	}
+	return InvitationID{value: value}, nil
+}
+
+func GenerateInvitationID() InvitationID {
+	return InvitationID{value: primitive.NewObjectID().Hex()}
+}
+
+func (i InvitationID) Value() string {
+	return i.value
+}
+
+func (i InvitationID) String() string {
+	return i.value
+}
+
+func (i InvitationID) IsZero() bool {
+	return i.value == ""
+}
+
+func (i InvitationID) Equals(other InvitationID) bool {
+	return i.value == other.value
+}
@@ -0,0 +1,42 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// JoinRequestID identifies a request to join a private community
+type JoinRequestID struct {
+	value string
+}
+
+func NewJoinRequestID(value string) (JoinRequestID, error) {
+	if value == "" {
+		return JoinRequestID{}, errors.New("join request ID cannot be empty")
+	}
+	if !primitive.IsValidObjectID(value) {
+		return JoinRequestID{}, errors.New("join request ID must be a valid ObjectID")
+	}
+	return JoinRequestID{value: value}, nil
+}
+
+func GenerateJoinRequestID() JoinRequestID {
+	return JoinRequestID{value: primitive.NewObjectID().Hex()}
+}
+
+func (j JoinRequestID) Value() string {
+	return j.value
+}
+
+func (j JoinRequestID) String() string {
+	return j.value
+}
+
+func (j JoinRequestID) IsZero() bool {
+	return j.value == ""
+}
+
+func (j JoinRequestID) Equals(other JoinRequestID) bool {
+	return j.value == other.value
+}
@@ -0,0 +1,39 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxJoinRequestMessageLength = 500
+
+// JoinRequestMessage is the optional note a user attaches when asking to join a community
+type JoinRequestMessage struct {
+	value string
+}
+
+// NewJoinRequestMessage creates a message. An empty value is allowed and yields an empty message.
+func NewJoinRequestMessage(value string) (JoinRequestMessage, error) {
+	trimmed := strings.TrimSpace(value)
+	if utf8.RuneCountInString(trimmed) > maxJoinRequestMessageLength {
+		return JoinRequestMessage{}, errors.New("join request message cannot exceed 500 characters")
+	}
+	return JoinRequestMessage{value: trimmed}, nil
+}
+
+func (m JoinRequestMessage) Value() string {
+	return m.value
+}
+
+func (m JoinRequestMessage) String() string {
+	return m.value
+}
+
+func (m JoinRequestMessage) IsEmpty() bool {
+	return m.value == ""
+}
+
+func (m JoinRequestMessage) Equals(other JoinRequestMessage) bool {
+	return m.value == other.value
+}
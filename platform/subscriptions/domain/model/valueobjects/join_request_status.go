@@ -0,0 +1,67 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+// Join request lifecycle states
+const (
+	JoinRequestPendingName   = "pending"
+	JoinRequestApprovedName  = "approved"
+	JoinRequestRejectedName  = "rejected"
+	JoinRequestWithdrawnName = "withdrawn"
+)
+
+// JoinRequestStatus represents the state of a join request.
+// A request starts as pending and ends as approved, rejected or withdrawn.
+type JoinRequestStatus struct {
+	value string
+}
+
+var validJoinRequestStatuses = map[string]bool{
+	JoinRequestPendingName:   true,
+	JoinRequestApprovedName:  true,
+	JoinRequestRejectedName:  true,
+	JoinRequestWithdrawnName: true,
+}
+
+func NewJoinRequestStatus(value string) (JoinRequestStatus, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	if normalized == "" {
+		return JoinRequestStatus{}, errors.New("join request status cannot be empty")
+	}
+	if !validJoinRequestStatuses[normalized] {
+		return JoinRequestStatus{}, errors.New("invalid join request status: must be one of pending, approved, rejected, withdrawn")
+	}
+	return JoinRequestStatus{value: normalized}, nil
+}
+
+func (s JoinRequestStatus) Value() string {
+	return s.value
+}
+
+func (s JoinRequestStatus) String() string {
+	return s.value
+}
+
+func (s JoinRequestStatus) IsZero() bool {
+	return s.value == ""
+}
+
+func (s JoinRequestStatus) Equals(other JoinRequestStatus) bool {
+	return s.value == other.value
+}
+
+// IsPending checks if the request is still awaiting a decision
+func (s JoinRequestStatus) IsPending() bool {
+	return s.value == JoinRequestPendingName
+}
+
+// Predefined statuses for convenience
+var (
+	JoinRequestPending   = JoinRequestStatus{value: JoinRequestPendingName}
+	JoinRequestApproved  = JoinRequestStatus{value: JoinRequestApprovedName}
+	JoinRequestRejected  = JoinRequestStatus{value: JoinRequestRejectedName}
+	JoinRequestWithdrawn = JoinRequestStatus{value: JoinRequestWithdrawnName}
+)
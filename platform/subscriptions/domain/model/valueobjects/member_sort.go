@@ -0,0 +1,49 @@
+package valueobjects
+
+import "errors"
+
+const (
+	NewestMemberSort   = "newest"
+	OldestMemberSort   = "oldest"
+	UsernameMemberSort = "username"
+)
+
+// MemberSort is the order of the member directory of a community
+type MemberSort struct {
+	value string
+}
+
+func NewMemberSort(value string) (MemberSort, error) {
+	switch value {
+	case NewestMemberSort, OldestMemberSort, UsernameMemberSort:
+		return MemberSort{value: value}, nil
+	default:
+		return MemberSort{}, errors.New("sort has to be one of newest, oldest or username")
+	}
+}
+
+func (s MemberSort) Value() string {
+	if s.value == "" {
+		return NewestMemberSort
+	}
+	return s.value
+}
+
+func (s MemberSort) String() string {
+	return s.Value()
+}
+
+// IsNewest reports whether members are listed by join date, newest first. The zero sort is newest.
+func (s MemberSort) IsNewest() bool {
+	return s.Value() == NewestMemberSort
+}
+
+// IsOldest reports whether members are listed by join date, oldest first
+func (s MemberSort) IsOldest() bool {
+	return s.value == OldestMemberSort
+}
+
+// IsUsername reports whether members are listed alphabetically by username
+func (s MemberSort) IsUsername() bool {
+	return s.value == UsernameMemberSort
+}
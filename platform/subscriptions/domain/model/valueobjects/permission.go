@@ -0,0 +1,70 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+// Permission names granted by community roles
+const (
+	PublishPostPermissionName         = "publish_post"
+	PublishAnnouncementPermissionName = "publish_announcement"
+	DeleteAnyPostPermissionName       = "delete_any_post"
+	ManageMembersPermissionName       = "manage_members"
+	PinPostPermissionName             = "pin_post"
+	ModerateReactionsPermissionName   = "moderate_reactions"
+)
+
+// Permission is an action a community role allows its holders to perform.
+// Owners and admins hold every permission, plain members hold none,
+// and custom roles hold the permissions the owner picked for them.
+// Publishing announcements is a permission of its own, so pinning posts does not imply it.
+type Permission struct {
+	value string
+}
+
+var validPermissions = map[string]bool{
+	PublishPostPermissionName:         true,
+	PublishAnnouncementPermissionName: true,
+	DeleteAnyPostPermissionName:       true,
+	ManageMembersPermissionName:       true,
+	PinPostPermissionName:             true,
+	ModerateReactionsPermissionName:   true,
+}
+
+func NewPermission(value string) (Permission, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	if normalized == "" {
+		return Permission{}, errors.New("permission cannot be empty")
+	}
+	if !validPermissions[normalized] {
+		return Permission{}, errors.New("invalid permission: must be one of publish_post, publish_announcement, delete_any_post, manage_members, pin_post, moderate_reactions")
+	}
+	return Permission{value: normalized}, nil
+}
+
+func (p Permission) Value() string {
+	return p.value
+}
+
+func (p Permission) String() string {
+	return p.value
+}
+
+func (p Permission) IsZero() bool {
+	return p.value == ""
+}
+
+func (p Permission) Equals(other Permission) bool {
+	return p.value == other.value
+}
+
+// Predefined permissions for convenience
+var (
+	PublishPostPermission         = Permission{value: PublishPostPermissionName}
+	PublishAnnouncementPermission = Permission{value: PublishAnnouncementPermissionName}
+	DeleteAnyPostPermission       = Permission{value: DeleteAnyPostPermissionName}
+	ManageMembersPermission       = Permission{value: ManageMembersPermissionName}
+	PinPostPermission             = Permission{value: PinPostPermissionName}
+	ModerateReactionsPermission   = Permission{value: ModerateReactionsPermissionName}
+)
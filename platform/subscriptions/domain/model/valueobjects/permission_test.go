@@ -0,0 +1,40 @@
+package valueobjects
+
+import "testing"
+
+func TestNewPermissionNormalizesNames(t *testing.T) {
+	tests := map[string]Permission{
+		"publish_post":           PublishPostPermission,
+		"publish_announcement":   PublishAnnouncementPermission,
+		"delete_any_post":        DeleteAnyPostPermission,
+		"manage_members":         ManageMembersPermission,
+		"pin_post":               PinPostPermission,
+		"moderate_reactions":     ModerateReactionsPermission,
+		"  PIN_POST ":            PinPostPermission,
+		"Publish_Announcement\t": PublishAnnouncementPermission,
+	}
+	for input, want := range tests {
+		got, err := NewPermission(input)
+		if err != nil {
+			t.Errorf("NewPermission(%q) returned an error: %v", input, err)
+			continue
+		}
+		if !got.Equals(want) {
+			t.Errorf("NewPermission(%q) = %q, want %q", input, got.Value(), want.Value())
+		}
+	}
+}
+
+func TestNewPermissionRejectsUnknownNames(t *testing.T) {
+	for _, input := range []string{"", "   ", "admin", "pin post", "publish_posts", "announce"} {
+		if _, err := NewPermission(input); err == nil {
+			t.Errorf("NewPermission(%q) should fail", input)
+		}
+	}
+}
+
+func TestPinningDoesNotImplyAnnouncing(t *testing.T) {
+	if PinPostPermission.Equals(PublishAnnouncementPermission) {
+		t.Error("pin_post and publish_announcement should be distinct permissions")
+	}
+}
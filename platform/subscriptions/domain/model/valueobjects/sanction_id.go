@@ -0,0 +1,42 @@
+package valueobjects
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SanctionID identifies a ban or mute issued in a community
+type SanctionID struct {
+	value string
+}
+
+func NewSanctionID(value string) (SanctionID, error) {
+	if value == "" {
+		return SanctionID{}, errors.New("sanction ID cannot be empty")
+	}
+	if !primitive.IsValidObjectID(value) {
+		return SanctionID{}, errors.New("sanction ID must be a valid ObjectID")
+	}
+	return SanctionID{value: value}, nil
+}
+
+func GenerateSanctionID() SanctionID {
+	return SanctionID{value: primitive.NewObjectID().Hex()}
+}
+
+func (s SanctionID) Value() string {
+	return s.value
+}
+
+func (s SanctionID) String() string {
+	return s.value
+}
+
+func (s SanctionID) IsZero() bool {
+	return s.value == ""
+}
+
+func (s SanctionID) Equals(other SanctionID) bool {
+	return s.value == other.value
+}
@@ -0,0 +1,39 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxSanctionReasonLength = 500
+
+// SanctionReason is the optional explanation a moderator gives when banning or muting a member
+type SanctionReason struct {
+	value string
+}
+
+// NewSanctionReason creates a reason. An empty value is allowed and yields an empty reason.
+func NewSanctionReason(value string) (SanctionReason, error) {
+	trimmed := strings.TrimSpace(value)
+	if utf8.RuneCountInString(trimmed) > maxSanctionReasonLength {
+		return SanctionReason{}, errors.New("sanction reason cannot exceed 500 characters")
+	}
+	return SanctionReason{value: trimmed}, nil
+}
+
+func (r SanctionReason) Value() string {
+	return r.value
+}
+
+func (r SanctionReason) String() string {
+	return r.value
+}
+
+func (r SanctionReason) IsEmpty() bool {
+	return r.value == ""
+}
+
+func (r SanctionReason) Equals(other SanctionReason) bool {
+	return r.value == other.value
+}
@@ -0,0 +1,59 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+// Sanction types
+const (
+	BanSanctionName  = "ban"
+	MuteSanctionName = "mute"
+)
+
+// SanctionType tells what a sanction restricts.
+// A ban keeps the user out of the community; a mute keeps them in but stops them from posting, commenting and reacting.
+type SanctionType struct {
+	value string
+}
+
+func NewSanctionType(value string) (SanctionType, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	if normalized == "" {
+		return SanctionType{}, errors.New("sanction type cannot be empty")
+	}
+	if normalized != BanSanctionName && normalized != MuteSanctionName {
+		return SanctionType{}, errors.New("invalid sanction type: must be one of ban, mute")
+	}
+	return SanctionType{value: normalized}, nil
+}
+
+func (t SanctionType) Value() string {
+	return t.value
+}
+
+func (t SanctionType) String() string {
+	return t.value
+}
+
+func (t SanctionType) IsZero() bool {
+	return t.value == ""
+}
+
+func (t SanctionType) Equals(other SanctionType) bool {
+	return t.value == other.value
+}
+
+func (t SanctionType) IsBan() bool {
+	return t.value == BanSanctionName
+}
+
+func (t SanctionType) IsMute() bool {
+	return t.value == MuteSanctionName
+}
+
+// Predefined sanction types for convenience
+var (
+	BanSanction  = SanctionType{value: BanSanctionName}
+	MuteSanction = SanctionType{value: MuteSanctionName}
+)
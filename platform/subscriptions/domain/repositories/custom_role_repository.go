@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"context"
+
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// CustomRoleRepository defines the contract for custom community role persistence operations
+type CustomRoleRepository interface {
+	// Save persists a new custom role
+	Save(ctx context.Context, role *entities.CustomRole) error
+
+	// Update persists the permissions of an existing custom role
+	Update(ctx context.Context, role *entities.CustomRole) error
+
+	// FindByCommunityAndName retrieves a custom role of a community by its name
+	FindByCommunityAndName(ctx context.Context, communityID valueobjects.CommunityID, name valueobjects.CommunityRole) (*entities.CustomRole, error)
+
+	// FindAllByCommunity retrieves the custom roles of a community, sorted by name
+	FindAllByCommunity(ctx context.Context, communityID valueobjects.CommunityID) ([]*entities.CustomRole, error)
+
+	// ExistsByCommunityAndName checks if a community defines a custom role with the given name
+	ExistsByCommunityAndName(ctx context.Context, communityID valueobjects.CommunityID, name valueobjects.CommunityRole) (bool, error)
+
+	// Delete removes a custom role from a community
+	Delete(ctx context.Context, communityID valueobjects.CommunityID, name valueobjects.CommunityRole) error
+
+	// DeleteByCommunity removes all custom roles for a given community
+	DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error
+}
@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// InvitationRepository defines the contract for invitation persistence operations
+type InvitationRepository interface {
+	// Save persists a new invitation
+	Save(ctx context.Context, invitation *entities.Invitation) error
+
+	// Update persists the revocation data of an existing invitation
+	Update(ctx context.Context, invitation *entities.Invitation) error
+
+	// FindByID retrieves an invitation by its ID
+	FindByID(ctx context.Context, id valueobjects.InvitationID) (*entities.Invitation, error)
+
+	// FindByCommunity retrieves all invitations of a community, newest first
+	FindByCommunity(ctx context.Context, communityID valueobjects.CommunityID) ([]*entities.Invitation, error)
+
+	// ReserveUse atomically counts one use of the invitation if it is still usable at the given time.
+	// Returns false when the invitation was revoked, expired or used up in the meantime.
+	ReserveUse(ctx context.Context, id valueobjects.InvitationID, now time.Time) (bool, error)
+
+	// ReleaseUse gives back a use reserved by ReserveUse
+	ReleaseUse(ctx context.Context, id valueobjects.InvitationID) error
+
+	// DeleteByCommunity removes all invitations for a given community
+	DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error
+}
@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"context"
+
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// JoinRequestRepository defines the contract for join request persistence operations
+type JoinRequestRepository interface {
+	// Save persists a new join request
+	Save(ctx context.Context, joinRequest *entities.JoinRequest) error
+
+	// Update persists the status and review data of an existing join request
+	Update(ctx context.Context, joinRequest *entities.JoinRequest) error
+
+	// FindByID retrieves a join request by its ID
+	FindByID(ctx context.Context, id valueobjects.JoinRequestID) (*entities.JoinRequest, error)
+
+	// FindPendingByUserAndCommunity retrieves the pending join request of a user for a community, if any
+	FindPendingByUserAndCommunity(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID) (*entities.JoinRequest, error)
+
+	// FindPendingByCommunity retrieves the pending join requests of a community, oldest first
+	FindPendingByCommunity(ctx context.Context, communityID valueobjects.CommunityID) ([]*entities.JoinRequest, error)
+
+	// DeleteByCommunity removes all join requests for a given community
+	DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error
+
+	// DeleteByUser removes all join requests of a user
+	DeleteByUser(ctx context.Context, userID valueobjects.UserID) error
+}
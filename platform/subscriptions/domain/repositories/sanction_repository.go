@@ -0,0 +1,27 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// SanctionRepository defines the contract for ban and mute persistence operations
+type SanctionRepository interface {
+	// Save persists a sanction, replacing any previous sanction of the same type for the user in the community
+	Save(ctx context.Context, sanction *entities.Sanction) error
+
+	// FindActive retrieves the sanction of the given type that still applies to a user at the given time, if any
+	FindActive(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID, sanctionType valueobjects.SanctionType, now time.Time) (*entities.Sanction, error)
+
+	// FindActiveByCommunity retrieves the sanctions of the given type that still apply in a community, newest first
+	FindActiveByCommunity(ctx context.Context, communityID valueobjects.CommunityID, sanctionType valueobjects.SanctionType, now time.Time) ([]*entities.Sanction, error)
+
+	// Delete removes the sanction of the given type for a user in a community
+	Delete(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID, sanctionType valueobjects.SanctionType) error
+
+	// DeleteByCommunity removes all sanctions for a given community
+	DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error
+}
@@ -2,40 +2,83 @@ package repositories
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"Gommunity/platform/subscriptions/domain/model/entities"
 	"Gommunity/platform/subscriptions/domain/model/valueobjects"
 )
 
-// SubscriptionRepository defines the contract for subscription persistence operations
+// ErrSubscriptionExists is returned by Save when the user is already subscribed to the community
+var ErrSubscriptionExists = errors.New("subscription already exists")
+
+// SubscriptionFilter narrows down and orders the subscriptions of a community.
+// A nil role matches every role and nil UserIDs match every member; subscriptions are listed newest first unless OldestFirst is set.
+type SubscriptionFilter struct {
+	Role        *valueobjects.CommunityRole
+	UserIDs     []valueobjects.UserID
+	OldestFirst bool
+}
+
+// SubscriptionRepository defines the contract for subscription persistence operations.
+// Lookups, listings and counts only see active subscriptions: expired ones are left out even before the expiry sweeper removes them.
 type SubscriptionRepository interface {
-	// Save persists a subscription
+	// Save persists a subscription, replacing an expired subscription of the same user and community.
+	// It fails with ErrSubscriptionExists when the user already holds an active subscription.
 	Save(ctx context.Context, subscription *entities.Subscription) error
 
+	// Update persists the role and expiry of an existing subscription
+	Update(ctx context.Context, subscription *entities.Subscription) error
+
+	// UpdateExpired persists the role and expiry of a subscription only while its stored expiry is not after now,
+	// so an extension made in the meantime wins. It reports whether the subscription was updated.
+	UpdateExpired(ctx context.Context, subscription *entities.Subscription, now time.Time) (bool, error)
+
 	// FindByID retrieves a subscription by its ID
 	FindByID(ctx context.Context, id valueobjects.SubscriptionID) (*entities.Subscription, error)
 
-	// FindByUserAndCommunity retrieves a subscription by user ID and community ID
+	// FindByUserAndCommunity retrieves the active subscription of a user in a community
 	FindByUserAndCommunity(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID) (*entities.Subscription, error)
 
-	// FindAllByCommunityID retrieves all subscriptions for a specific community
-	FindAllByCommunityID(ctx context.Context, communityID valueobjects.CommunityID, limit, offset *int) ([]*entities.Subscription, error)
+	// FindAllByCommunityID retrieves the subscriptions of a community matching the filter, by join date.
+	// A set cursor continues right after it and takes precedence over the offset.
+	FindAllByCommunityID(ctx context.Context, communityID valueobjects.CommunityID, filter SubscriptionFilter, limit, offset *int, cursor valueobjects.Cursor) ([]*entities.Subscription, error)
+
+	// FindUserIDsByCommunityID retrieves the user IDs of the members of a community, optionally narrowed to a role
+	FindUserIDsByCommunityID(ctx context.Context, communityID valueobjects.CommunityID, role *valueobjects.CommunityRole) ([]valueobjects.UserID, error)
 
-	// FindAllByUserID retrieves all subscriptions for a specific user
+	// FindAllByUserID retrieves the active subscriptions of a user
 	FindAllByUserID(ctx context.Context, userID valueobjects.UserID) ([]*entities.Subscription, error)
 
-	// CountByCommunityID returns the total number of subscriptions for a community
+	// FindExpired retrieves up to limit subscriptions whose expiry is not after now, oldest expiry first
+	FindExpired(ctx context.Context, now time.Time, limit int) ([]*entities.Subscription, error)
+
+	// CountByCommunityID returns the number of active subscriptions for a community
 	CountByCommunityID(ctx context.Context, communityID valueobjects.CommunityID) (int64, error)
 
+	// CountByCommunityIDs returns the number of subscriptions of each community in a single query.
+	// Communities without subscriptions are absent from the result.
+	CountByCommunityIDs(ctx context.Context, communityIDs []valueobjects.CommunityID) (map[string]int64, error)
+
 	// ExistsByUserAndCommunity checks if a subscription exists for a user in a community
 	ExistsByUserAndCommunity(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID) (bool, error)
 
+	// ExistsByCommunityAndRole checks if any member of a community holds the given role
+	ExistsByCommunityAndRole(ctx context.Context, communityID valueobjects.CommunityID, role valueobjects.CommunityRole) (bool, error)
+
 	// Delete removes a subscription
 	Delete(ctx context.Context, id valueobjects.SubscriptionID) error
 
+	// DeleteExpired removes a subscription only while its stored expiry is not after now,
+	// so an extension made in the meantime wins. It reports whether the subscription was removed.
+	DeleteExpired(ctx context.Context, id valueobjects.SubscriptionID, now time.Time) (bool, error)
+
 	// DeleteByUserAndCommunity removes a subscription by user and community
 	DeleteByUserAndCommunity(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID) error
 
 	// DeleteByCommunity removes all subscriptions for a given community
 	DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error
+
+	// DeleteByUser removes all subscriptions of a user
+	DeleteByUser(ctx context.Context, userID valueobjects.UserID) error
 }
@@ -0,0 +1,21 @@
+package services
+
+import (
+	"context"
+
+	"Gommunity/platform/subscriptions/domain/model/commands"
+	"Gommunity/platform/subscriptions/domain/model/entities"
+)
+
+// CustomRoleCommandService defines the contract for custom community role command operations.
+// Only the community owner can manage custom roles.
+type CustomRoleCommandService interface {
+	// HandleCreate processes a CreateCustomRoleCommand to define a new role in a community
+	HandleCreate(ctx context.Context, cmd commands.CreateCustomRoleCommand) (*entities.CustomRole, error)
+
+	// HandleUpdate processes an UpdateCustomRoleCommand. The new permissions apply at once to every holder of the role.
+	HandleUpdate(ctx context.Context, cmd commands.UpdateCustomRoleCommand) (*entities.CustomRole, error)
+
+	// HandleDelete processes a DeleteCustomRoleCommand. A role still held by members cannot be deleted.
+	HandleDelete(ctx context.Context, cmd commands.DeleteCustomRoleCommand) error
+}
@@ -0,0 +1,14 @@
+package services
+
+import (
+	"context"
+
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/queries"
+)
+
+// CustomRoleQueryService defines the contract for custom community role query operations
+type CustomRoleQueryService interface {
+	// HandleGetByCommunity processes a GetCustomRolesByCommunityQuery to list the custom roles of a community, sorted by name
+	HandleGetByCommunity(ctx context.Context, query queries.GetCustomRolesByCommunityQuery) ([]*entities.CustomRole, error)
+}
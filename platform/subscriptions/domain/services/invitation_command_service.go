@@ -0,0 +1,23 @@
+package services
+
+import (
+	"context"
+
+	"Gommunity/platform/subscriptions/domain/model/commands"
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// InvitationCommandService defines the contract for invitation command operations
+type InvitationCommandService interface {
+	// HandleCreate processes a CreateInvitationCommand. Admins can invite members; only the owner,
+	// or admins when the owner allows it, can invite admins.
+	HandleCreate(ctx context.Context, cmd commands.CreateInvitationCommand) (*valueobjects.InvitationID, error)
+
+	// HandleAccept processes an AcceptInvitationCommand and subscribes the user with the invitation role,
+	// including in private communities
+	HandleAccept(ctx context.Context, cmd commands.AcceptInvitationCommand) (*entities.Subscription, error)
+
+	// HandleRevoke processes a RevokeInvitationCommand so the invitation can no longer be accepted
+	HandleRevoke(ctx context.Context, cmd commands.RevokeInvitationCommand) error
+}
@@ -0,0 +1,24 @@
+package services
+
+import (
+	"context"
+
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/queries"
+)
+
+// InvitationLink is an invitation together with the token to share
+type InvitationLink struct {
+	Invitation *entities.Invitation
+	Token      string
+}
+
+// InvitationQueryService defines the contract for invitation query operations
+type InvitationQueryService interface {
+	// HandleGetByID processes a GetInvitationByIDQuery to retrieve a single invitation
+	HandleGetByID(ctx context.Context, query queries.GetInvitationByIDQuery) (*InvitationLink, error)
+
+	// HandleAll processes a GetInvitationsByCommunityQuery to list the invitations of a community, newest first.
+	// Only the community owner and admins may list them.
+	HandleAll(ctx context.Context, query queries.GetInvitationsByCommunityQuery) ([]*InvitationLink, error)
+}
@@ -0,0 +1,12 @@
+package services
+
+import "Gommunity/platform/subscriptions/domain/model/valueobjects"
+
+// InvitationTokenService turns invitation IDs into tamper-proof tokens that can be shared as invite links
+type InvitationTokenService interface {
+	// Sign returns the token for an invitation
+	Sign(invitationID valueobjects.InvitationID) string
+
+	// Verify checks the token signature and returns the invitation it was issued for
+	Verify(token string) (valueobjects.InvitationID, error)
+}
@@ -0,0 +1,20 @@
+package services
+
+import (
+	"context"
+
+	"Gommunity/platform/subscriptions/domain/model/commands"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// JoinRequestCommandService defines the contract for join request command operations
+type JoinRequestCommandService interface {
+	// HandleRequest processes a RequestToJoinCommand and opens a pending join request for a private community
+	HandleRequest(ctx context.Context, cmd commands.RequestToJoinCommand) (*valueobjects.JoinRequestID, error)
+
+	// HandleReview processes a ReviewJoinRequestCommand. Approving a request subscribes the user as a member.
+	HandleReview(ctx context.Context, cmd commands.ReviewJoinRequestCommand) error
+
+	// HandleWithdraw processes a WithdrawJoinRequestCommand to cancel the user's own pending request
+	HandleWithdraw(ctx context.Context, cmd commands.WithdrawJoinRequestCommand) error
+}
@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/queries"
+)
+
+// JoinRequestQueryService defines the contract for join request query operations
+type JoinRequestQueryService interface {
+	// HandleGetByID processes a GetJoinRequestByIDQuery to retrieve a single join request
+	HandleGetByID(ctx context.Context, query queries.GetJoinRequestByIDQuery) (*entities.JoinRequest, error)
+
+	// HandlePending processes a GetPendingJoinRequestsQuery to list the pending requests of a community, oldest first.
+	// Only the community owner and admins may list them.
+	HandlePending(ctx context.Context, query queries.GetPendingJoinRequestsQuery) ([]*entities.JoinRequest, error)
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// Errors returned when a role cannot be given to a user
+var (
+	ErrRoleNotFound       = errors.New("role not found in this community")
+	ErrRoleNotGrantable   = errors.New("the owner role cannot be granted to other users")
+	ErrCannotManageAdmins = errors.New("only the community owner, or admins when the owner allows it, can manage admins")
+)
+
+// PermissionService is the single place where community permissions are evaluated.
+// Every bounded context asks it, through its ACL, instead of comparing role names.
+type PermissionService interface {
+	// HasPermission checks whether the user may perform the action guarded by the permission in the community.
+	// The community owner and admins hold every permission, plain members hold none,
+	// and holders of a custom role get the permissions configured on that role.
+	HasPermission(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID, permission valueobjects.Permission) (bool, error)
+
+	// IsOwner checks whether the user owns the community.
+	IsOwner(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID) (bool, error)
+
+	// CanManageAdmins checks whether the user may grant, revoke or sanction admin rights in the community:
+	// the owner always can, admins only when the owner lets admins manage each other.
+	CanManageAdmins(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID) (bool, error)
+}
@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+
+	"Gommunity/platform/subscriptions/domain/model/commands"
+	"Gommunity/platform/subscriptions/domain/model/entities"
+)
+
+// SanctionCommandService defines the contract for ban and mute command operations
+type SanctionCommandService interface {
+	// HandleSanction processes a SanctionMemberCommand. Banning a user also removes their subscription.
+	HandleSanction(ctx context.Context, cmd commands.SanctionMemberCommand) (*entities.Sanction, error)
+
+	// HandleLift processes a LiftSanctionCommand to end a ban or a mute early
+	HandleLift(ctx context.Context, cmd commands.LiftSanctionCommand) error
+}
@@ -0,0 +1,15 @@
+package services
+
+import (
+	"context"
+
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/queries"
+)
+
+// SanctionQueryService defines the contract for ban and mute query operations
+type SanctionQueryService interface {
+	// HandleGetActive processes a GetActiveSanctionsQuery to list the bans or mutes in force in a community, newest first.
+	// Only the community owner and admins may list them.
+	HandleGetActive(ctx context.Context, query queries.GetActiveSanctionsQuery) ([]*entities.Sanction, error)
+}
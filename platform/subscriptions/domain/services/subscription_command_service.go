@@ -7,6 +7,43 @@ import (
 	"Gommunity/platform/subscriptions/domain/model/valueobjects"
 )
 
+// MemberImportStatus is the outcome of one row of a member import
+type MemberImportStatus string
+
+const (
+	MemberImportCreated           MemberImportStatus = "created"
+	MemberImportAlreadySubscribed MemberImportStatus = "already_subscribed"
+	MemberImportUserNotFound      MemberImportStatus = "user_not_found"
+	MemberImportInvalidRole       MemberImportStatus = "invalid_role"
+	MemberImportRejected          MemberImportStatus = "rejected"
+)
+
+// MemberImportResult reports what happened to one row of a member import.
+// Reason explains rejected and invalid_role rows.
+type MemberImportResult struct {
+	Line     int
+	UserID   string
+	Username string
+	Role     string
+	Status   MemberImportStatus
+	Reason   string
+}
+
+// MembershipExtensionStatus is the outcome of extending the membership of one user
+type MembershipExtensionStatus string
+
+const (
+	MembershipExtended      MembershipExtensionStatus = "extended"
+	MembershipNotSubscribed MembershipExtensionStatus = "not_subscribed"
+	MembershipWithoutExpiry MembershipExtensionStatus = "no_expiry"
+)
+
+// MembershipExtensionResult reports what happened to one user of a bulk membership extension
+type MembershipExtensionResult struct {
+	UserID string
+	Status MembershipExtensionStatus
+}
+
 // SubscriptionCommandService defines the contract for subscription command operations
 type SubscriptionCommandService interface {
 	// Handle processes a SubscribeUserCommand to add a user to a community with a role
@@ -15,6 +52,25 @@ type SubscriptionCommandService interface {
 	// HandleUnsubscribe processes an UnsubscribeUserCommand to remove a user from a community
 	HandleUnsubscribe(ctx context.Context, cmd commands.UnsubscribeUserCommand) error
 
-	// HandleDeleteByCommunity removes all subscriptions linked to a community
+	// HandleImport processes an ImportMembersCommand to subscribe many users at once and reports the outcome of each row
+	HandleImport(ctx context.Context, cmd commands.ImportMembersCommand) ([]MemberImportResult, error)
+
+	// HandleChangeRole processes a ChangeMemberRoleCommand to promote or demote a subscribed user
+	HandleChangeRole(ctx context.Context, cmd commands.ChangeMemberRoleCommand) error
+
+	// HandleExtend processes an ExtendMembershipsCommand to move the expiry of several memberships and reports the outcome for each user
+	HandleExtend(ctx context.Context, cmd commands.ExtendMembershipsCommand) ([]MembershipExtensionResult, error)
+
+	// HandleExpireDue removes every expired membership, or moves it to its alumni role, and returns how many were processed
+	HandleExpireDue(ctx context.Context) (int, error)
+
+	// HandleSwapOwnerRole processes a SwapOwnerRoleCommand once a community ownership transfer is accepted
+	HandleSwapOwnerRole(ctx context.Context, cmd commands.SwapOwnerRoleCommand) error
+
+	// HandleDeleteByCommunity removes all subscriptions, join requests, invitations and sanctions linked to a community
 	HandleDeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error
+
+	// HandleDeleteByUser removes all subscriptions and join requests of a user whose account was removed.
+	// Communities the user owned must be handed over first.
+	HandleDeleteByUser(ctx context.Context, userID valueobjects.UserID) error
 }
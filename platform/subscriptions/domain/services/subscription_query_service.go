@@ -2,11 +2,48 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"Gommunity/platform/subscriptions/domain/model/entities"
 	"Gommunity/platform/subscriptions/domain/model/queries"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
 )
 
+// MemberExportRow is one member of a community in a member export
+type MemberExportRow struct {
+	UserID   string
+	Username string // empty when the user no longer exists in the Users BC
+	Role     string
+	JoinedAt time.Time
+}
+
+// CommunityMember is a subscription of a community together with the profile of its user.
+// The profile fields are empty when the user no longer exists in the Users BC.
+type CommunityMember struct {
+	Subscription *entities.Subscription
+	Username     string
+	ProfileURL   *string
+	BannerURL    *string
+}
+
+// MemberDirectoryPage is one page of the member directory of a community.
+// Pages listed by join date continue with NextCursor; searches and username sorting continue with NextOffset.
+// Both are nil on the last page.
+type MemberDirectoryPage struct {
+	Members    []CommunityMember
+	NextCursor *valueobjects.Cursor
+	NextOffset *int
+}
+
+// UserMembership is a subscription of a user together with the details of its community
+type UserMembership struct {
+	Subscription  *entities.Subscription
+	CommunityName string
+	IconURL       *string
+	IsPrivate     bool
+	MemberCount   int64
+}
+
 // SubscriptionQueryService defines the contract for subscription query operations
 type SubscriptionQueryService interface {
 	// Handle processes a GetSubscriptionByUserAndCommunityQuery to retrieve a specific subscription
@@ -15,6 +52,12 @@ type SubscriptionQueryService interface {
 	// HandleCount processes a GetSubscriptionCountByCommunityQuery to get total subscriptions for a community
 	HandleCount(ctx context.Context, query queries.GetSubscriptionCountByCommunityQuery) (int64, error)
 
-	// HandleAll processes a GetAllSubscriptionsByCommunityQuery to retrieve all subscriptions for a community
-	HandleAll(ctx context.Context, query queries.GetAllSubscriptionsByCommunityQuery) ([]*entities.Subscription, error)
+	// HandleAll processes a GetAllSubscriptionsByCommunityQuery to retrieve one page of the member directory of a community
+	HandleAll(ctx context.Context, query queries.GetAllSubscriptionsByCommunityQuery) (*MemberDirectoryPage, error)
+
+	// HandleExport processes an ExportMembersQuery to list every member of a community with their username and join date
+	HandleExport(ctx context.Context, query queries.ExportMembersQuery) ([]MemberExportRow, error)
+
+	// HandleGetByUser processes a GetSubscriptionsByUserQuery to list the communities a user belongs to, newest first
+	HandleGetByUser(ctx context.Context, query queries.GetSubscriptionsByUserQuery) ([]UserMembership, error)
 }
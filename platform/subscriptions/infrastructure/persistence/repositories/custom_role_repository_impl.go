@@ -0,0 +1,238 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	domain_repos "Gommunity/platform/subscriptions/domain/repositories"
+)
+
+type customRoleRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+// NewCustomRoleRepository creates a new CustomRoleRepository implementation
+func NewCustomRoleRepository(collection *mongo.Collection) domain_repos.CustomRoleRepository {
+	return &customRoleRepositoryImpl{
+		collection: collection,
+	}
+}
+
+// customRoleDocument represents the MongoDB document structure
+type customRoleDocument struct {
+	ID           string   `bson:"_id"`
+	CustomRoleID string   `bson:"custom_role_id"`
+	CommunityID  string   `bson:"community_id"`
+	Name         string   `bson:"name"`
+	Permissions  []string `bson:"permissions"`
+	CreatedBy    string   `bson:"created_by"`
+	CreatedAt    int64    `bson:"created_at"`
+	UpdatedAt    int64    `bson:"updated_at"`
+}
+
+// Save persists a new custom role
+func (r *customRoleRepositoryImpl) Save(ctx context.Context, role *entities.CustomRole) error {
+	doc := r.toDocument(role)
+
+	_, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errors.New("role already exists in this community")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Update persists the permissions of an existing custom role
+func (r *customRoleRepositoryImpl) Update(ctx context.Context, role *entities.CustomRole) error {
+	doc := r.toDocument(role)
+
+	filter := bson.M{"custom_role_id": doc.CustomRoleID}
+	update := bson.M{
+		"$set": bson.M{
+			"permissions": doc.Permissions,
+			"updated_at":  doc.UpdatedAt,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("role not found")
+	}
+
+	return nil
+}
+
+// FindByCommunityAndName retrieves a custom role of a community by its name
+func (r *customRoleRepositoryImpl) FindByCommunityAndName(ctx context.Context, communityID valueobjects.CommunityID, name valueobjects.CommunityRole) (*entities.CustomRole, error) {
+	filter := bson.M{
+		"community_id": communityID.Value(),
+		"name":         name.Value(),
+	}
+
+	var doc customRoleDocument
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.toEntity(&doc)
+}
+
+// FindAllByCommunity retrieves the custom roles of a community, sorted by name
+func (r *customRoleRepositoryImpl) FindAllByCommunity(ctx context.Context, communityID valueobjects.CommunityID) ([]*entities.CustomRole, error) {
+	filter := bson.M{"community_id": communityID.Value()}
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var roles []*entities.CustomRole
+	for cursor.Next(ctx) {
+		var doc customRoleDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		role, err := r.toEntity(&doc)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// ExistsByCommunityAndName checks if a community defines a custom role with the given name
+func (r *customRoleRepositoryImpl) ExistsByCommunityAndName(ctx context.Context, communityID valueobjects.CommunityID, name valueobjects.CommunityRole) (bool, error) {
+	filter := bson.M{
+		"community_id": communityID.Value(),
+		"name":         name.Value(),
+	}
+
+	count, err := r.collection.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Delete removes a custom role from a community
+func (r *customRoleRepositoryImpl) Delete(ctx context.Context, communityID valueobjects.CommunityID, name valueobjects.CommunityRole) error {
+	filter := bson.M{
+		"community_id": communityID.Value(),
+		"name":         name.Value(),
+	}
+
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.New("role not found")
+	}
+
+	return nil
+}
+
+// DeleteByCommunity removes all custom roles for a community
+func (r *customRoleRepositoryImpl) DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error {
+	filter := bson.M{
+		"community_id": communityID.Value(),
+	}
+
+	_, err := r.collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// toDocument converts an entity to a document
+func (r *customRoleRepositoryImpl) toDocument(role *entities.CustomRole) *customRoleDocument {
+	permissions := make([]string, len(role.Permissions()))
+	for i, permission := range role.Permissions() {
+		permissions[i] = permission.Value()
+	}
+
+	return &customRoleDocument{
+		ID:           role.ID(),
+		CustomRoleID: role.CustomRoleID().Value(),
+		CommunityID:  role.CommunityID().Value(),
+		Name:         role.Name().Value(),
+		Permissions:  permissions,
+		CreatedBy:    role.CreatedBy().Value(),
+		CreatedAt:    role.CreatedAt().Unix(),
+		UpdatedAt:    role.UpdatedAt().Unix(),
+	}
+}
+
+// toEntity converts a document to an entity
+func (r *customRoleRepositoryImpl) toEntity(doc *customRoleDocument) (*entities.CustomRole, error) {
+	customRoleID, err := valueobjects.NewCustomRoleID(doc.CustomRoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	communityID, err := valueobjects.NewCommunityID(doc.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := valueobjects.NewCommunityRole(doc.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := make([]valueobjects.Permission, 0, len(doc.Permissions))
+	for _, value := range doc.Permissions {
+		permission, err := valueobjects.NewPermission(value)
+		if err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, permission)
+	}
+
+	createdBy, err := valueobjects.NewUserID(doc.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.ReconstructCustomRole(
+		doc.ID,
+		customRoleID,
+		communityID,
+		name,
+		permissions,
+		createdBy,
+		time.Unix(doc.CreatedAt, 0),
+		time.Unix(doc.UpdatedAt, 0),
+	), nil
+}
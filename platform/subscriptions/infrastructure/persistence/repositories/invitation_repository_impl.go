@@ -0,0 +1,260 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	domain_repos "Gommunity/platform/subscriptions/domain/repositories"
+)
+
+type invitationRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+// NewInvitationRepository creates a new InvitationRepository implementation
+func NewInvitationRepository(collection *mongo.Collection) domain_repos.InvitationRepository {
+	return &invitationRepositoryImpl{
+		collection: collection,
+	}
+}
+
+// invitationDocument represents the MongoDB document structure
+type invitationDocument struct {
+	ID           string  `bson:"_id"`
+	InvitationID string  `bson:"invitation_id"`
+	CommunityID  string  `bson:"community_id"`
+	CreatedBy    string  `bson:"created_by"`
+	Role         string  `bson:"role"`
+	MaxUses      int     `bson:"max_uses"`
+	Uses         int     `bson:"uses"`
+	ExpiresAt    int64   `bson:"expires_at"`
+	RevokedBy    *string `bson:"revoked_by,omitempty"`
+	RevokedAt    *int64  `bson:"revoked_at,omitempty"`
+	CreatedAt    int64   `bson:"created_at"`
+	UpdatedAt    int64   `bson:"updated_at"`
+}
+
+// Save persists a new invitation
+func (r *invitationRepositoryImpl) Save(ctx context.Context, invitation *entities.Invitation) error {
+	doc := r.toDocument(invitation)
+
+	_, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errors.New("invitation already exists")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Update persists the revocation data of an existing invitation
+func (r *invitationRepositoryImpl) Update(ctx context.Context, invitation *entities.Invitation) error {
+	doc := r.toDocument(invitation)
+
+	filter := bson.M{"invitation_id": doc.InvitationID}
+	update := bson.M{
+		"$set": bson.M{
+			"revoked_by": doc.RevokedBy,
+			"revoked_at": doc.RevokedAt,
+			"updated_at": doc.UpdatedAt,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("invitation not found")
+	}
+
+	return nil
+}
+
+// FindByID retrieves an invitation by its ID
+func (r *invitationRepositoryImpl) FindByID(ctx context.Context, id valueobjects.InvitationID) (*entities.Invitation, error) {
+	filter := bson.M{"invitation_id": id.Value()}
+
+	var doc invitationDocument
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.toEntity(&doc)
+}
+
+// FindByCommunity retrieves all invitations of a community, newest first
+func (r *invitationRepositoryImpl) FindByCommunity(ctx context.Context, communityID valueobjects.CommunityID) ([]*entities.Invitation, error) {
+	filter := bson.M{"community_id": communityID.Value()}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "invitation_id", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var invitations []*entities.Invitation
+	for cursor.Next(ctx) {
+		var doc invitationDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		invitation, err := r.toEntity(&doc)
+		if err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, invitation)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return invitations, nil
+}
+
+// ReserveUse atomically counts one use of the invitation if it is still usable at the given time
+func (r *invitationRepositoryImpl) ReserveUse(ctx context.Context, id valueobjects.InvitationID, now time.Time) (bool, error) {
+	filter := bson.M{
+		"invitation_id": id.Value(),
+		"revoked_at":    nil,
+		"expires_at":    bson.M{"$gt": now.Unix()},
+		"$expr":         bson.M{"$lt": bson.A{"$uses", "$max_uses"}},
+	}
+	update := bson.M{
+		"$inc": bson.M{"uses": 1},
+		"$set": bson.M{"updated_at": now.Unix()},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+// ReleaseUse gives back a use reserved by ReserveUse
+func (r *invitationRepositoryImpl) ReleaseUse(ctx context.Context, id valueobjects.InvitationID) error {
+	filter := bson.M{
+		"invitation_id": id.Value(),
+		"uses":          bson.M{"$gt": 0},
+	}
+	update := bson.M{
+		"$inc": bson.M{"uses": -1},
+		"$set": bson.M{"updated_at": time.Now().Unix()},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// DeleteByCommunity removes all invitations for a community
+func (r *invitationRepositoryImpl) DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error {
+	filter := bson.M{
+		"community_id": communityID.Value(),
+	}
+
+	_, err := r.collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// toDocument converts an entity to a document
+func (r *invitationRepositoryImpl) toDocument(invitation *entities.Invitation) *invitationDocument {
+	doc := &invitationDocument{
+		ID:           invitation.ID(),
+		InvitationID: invitation.InvitationID().Value(),
+		CommunityID:  invitation.CommunityID().Value(),
+		CreatedBy:    invitation.CreatedBy().Value(),
+		Role:         invitation.Role().Value(),
+		MaxUses:      invitation.MaxUses(),
+		Uses:         invitation.Uses(),
+		ExpiresAt:    invitation.ExpiresAt().Unix(),
+		CreatedAt:    invitation.CreatedAt().Unix(),
+		UpdatedAt:    invitation.UpdatedAt().Unix(),
+	}
+
+	if revokedBy := invitation.RevokedBy(); revokedBy != nil {
+		value := revokedBy.Value()
+		doc.RevokedBy = &value
+	}
+	if revokedAt := invitation.RevokedAt(); revokedAt != nil {
+		value := revokedAt.Unix()
+		doc.RevokedAt = &value
+	}
+
+	return doc
+}
+
+// toEntity converts a document to an entity
+func (r *invitationRepositoryImpl) toEntity(doc *invitationDocument) (*entities.Invitation, error) {
+	invitationID, err := valueobjects.NewInvitationID(doc.InvitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	communityID, err := valueobjects.NewCommunityID(doc.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	createdBy, err := valueobjects.NewUserID(doc.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := valueobjects.NewCommunityRole(doc.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	var revokedBy *valueobjects.UserID
+	if doc.RevokedBy != nil {
+		revoker, err := valueobjects.NewUserID(*doc.RevokedBy)
+		if err != nil {
+			return nil, err
+		}
+		revokedBy = &revoker
+	}
+
+	var revokedAt *time.Time
+	if doc.RevokedAt != nil {
+		t := time.Unix(*doc.RevokedAt, 0)
+		revokedAt = &t
+	}
+
+	return entities.ReconstructInvitation(
+		doc.ID,
+		invitationID,
+		communityID,
+		createdBy,
+		role,
+		doc.MaxUses,
+		doc.Uses,
+		time.Unix(doc.ExpiresAt, 0),
+		revokedBy,
+		revokedAt,
+		time.Unix(doc.CreatedAt, 0),
+		time.Unix(doc.UpdatedAt, 0),
+	), nil
+}
@@ -0,0 +1,252 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	domain_repos "Gommunity/platform/subscriptions/domain/repositories"
+)
+
+type joinRequestRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+// NewJoinRequestRepository creates a new JoinRequestRepository implementation
+func NewJoinRequestRepository(collection *mongo.Collection) domain_repos.JoinRequestRepository {
+	return &joinRequestRepositoryImpl{
+		collection: collection,
+	}
+}
+
+// joinRequestDocument represents the MongoDB document structure
+type joinRequestDocument struct {
+	ID            string  `bson:"_id"`
+	JoinRequestID string  `bson:"join_request_id"`
+	UserID        string  `bson:"user_id"`
+	CommunityID   string  `bson:"community_id"`
+	Status        string  `bson:"status"`
+	Message       string  `bson:"message,omitempty"`
+	ReviewedBy    *string `bson:"reviewed_by,omitempty"`
+	ReviewedAt    *int64  `bson:"reviewed_at,omitempty"`
+	CreatedAt     int64   `bson:"created_at"`
+	UpdatedAt     int64   `bson:"updated_at"`
+}
+
+// Save persists a new join request
+func (r *joinRequestRepositoryImpl) Save(ctx context.Context, joinRequest *entities.JoinRequest) error {
+	doc := r.toDocument(joinRequest)
+
+	_, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errors.New("join request already pending for this community")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Update persists the status and review data of an existing join request
+func (r *joinRequestRepositoryImpl) Update(ctx context.Context, joinRequest *entities.JoinRequest) error {
+	doc := r.toDocument(joinRequest)
+
+	filter := bson.M{"join_request_id": doc.JoinRequestID}
+	update := bson.M{
+		"$set": bson.M{
+			"status":      doc.Status,
+			"reviewed_by": doc.ReviewedBy,
+			"reviewed_at": doc.ReviewedAt,
+			"updated_at":  doc.UpdatedAt,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("join request not found")
+	}
+
+	return nil
+}
+
+// FindByID retrieves a join request by its ID
+func (r *joinRequestRepositoryImpl) FindByID(ctx context.Context, id valueobjects.JoinRequestID) (*entities.JoinRequest, error) {
+	return r.findOne(ctx, bson.M{"join_request_id": id.Value()})
+}
+
+// FindPendingByUserAndCommunity retrieves the pending join request of a user for a community
+func (r *joinRequestRepositoryImpl) FindPendingByUserAndCommunity(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID) (*entities.JoinRequest, error) {
+	return r.findOne(ctx, bson.M{
+		"user_id":      userID.Value(),
+		"community_id": communityID.Value(),
+		"status":       valueobjects.JoinRequestPendingName,
+	})
+}
+
+// FindPendingByCommunity retrieves the pending join requests of a community, oldest first
+func (r *joinRequestRepositoryImpl) FindPendingByCommunity(ctx context.Context, communityID valueobjects.CommunityID) ([]*entities.JoinRequest, error) {
+	filter := bson.M{
+		"community_id": communityID.Value(),
+		"status":       valueobjects.JoinRequestPendingName,
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "join_request_id", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var joinRequests []*entities.JoinRequest
+	for cursor.Next(ctx) {
+		var doc joinRequestDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		joinRequest, err := r.toEntity(&doc)
+		if err != nil {
+			return nil, err
+		}
+		joinRequests = append(joinRequests, joinRequest)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return joinRequests, nil
+}
+
+// DeleteByCommunity removes all join requests for a community
+func (r *joinRequestRepositoryImpl) DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error {
+	filter := bson.M{
+		"community_id": communityID.Value(),
+	}
+
+	_, err := r.collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteByUser removes all join requests of a user
+func (r *joinRequestRepositoryImpl) DeleteByUser(ctx context.Context, userID valueobjects.UserID) error {
+	filter := bson.M{
+		"user_id": userID.Value(),
+	}
+
+	_, err := r.collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *joinRequestRepositoryImpl) findOne(ctx context.Context, filter bson.M) (*entities.JoinRequest, error) {
+	var doc joinRequestDocument
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.toEntity(&doc)
+}
+
+// toDocument converts an entity to a document
+func (r *joinRequestRepositoryImpl) toDocument(joinRequest *entities.JoinRequest) *joinRequestDocument {
+	doc := &joinRequestDocument{
+		ID:            joinRequest.ID(),
+		JoinRequestID: joinRequest.JoinRequestID().Value(),
+		UserID:        joinRequest.UserID().Value(),
+		CommunityID:   joinRequest.CommunityID().Value(),
+		Status:        joinRequest.Status().Value(),
+		Message:       joinRequest.Message().Value(),
+		CreatedAt:     joinRequest.CreatedAt().Unix(),
+		UpdatedAt:     joinRequest.UpdatedAt().Unix(),
+	}
+
+	if reviewedBy := joinRequest.ReviewedBy(); reviewedBy != nil {
+		value := reviewedBy.Value()
+		doc.ReviewedBy = &value
+	}
+	if reviewedAt := joinRequest.ReviewedAt(); reviewedAt != nil {
+		value := reviewedAt.Unix()
+		doc.ReviewedAt = &value
+	}
+
+	return doc
+}
+
+// toEntity converts a document to an entity
+func (r *joinRequestRepositoryImpl) toEntity(doc *joinRequestDocument) (*entities.JoinRequest, error) {
+	joinRequestID, err := valueobjects.NewJoinRequestID(doc.JoinRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := valueobjects.NewUserID(doc.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	communityID, err := valueobjects.NewCommunityID(doc.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := valueobjects.NewJoinRequestStatus(doc.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := valueobjects.NewJoinRequestMessage(doc.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	var reviewedBy *valueobjects.UserID
+	if doc.ReviewedBy != nil {
+		reviewer, err := valueobjects.NewUserID(*doc.ReviewedBy)
+		if err != nil {
+			return nil, err
+		}
+		reviewedBy = &reviewer
+	}
+
+	var reviewedAt *time.Time
+	if doc.ReviewedAt != nil {
+		t := time.Unix(*doc.ReviewedAt, 0)
+		reviewedAt = &t
+	}
+
+	return entities.ReconstructJoinRequest(
+		doc.ID,
+		joinRequestID,
+		userID,
+		communityID,
+		status,
+		message,
+		reviewedBy,
+		reviewedAt,
+		time.Unix(doc.CreatedAt, 0),
+		time.Unix(doc.UpdatedAt, 0),
+	), nil
+}
@@ -0,0 +1,224 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	domain_repos "Gommunity/platform/subscriptions/domain/repositories"
+)
+
+type sanctionRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+// NewSanctionRepository creates a new SanctionRepository implementation
+func NewSanctionRepository(collection *mongo.Collection) domain_repos.SanctionRepository {
+	return &sanctionRepositoryImpl{
+		collection: collection,
+	}
+}
+
+// sanctionDocument represents the MongoDB document structure
+type sanctionDocument struct {
+	ID          string `bson:"_id"`
+	SanctionID  string `bson:"sanction_id"`
+	UserID      string `bson:"user_id"`
+	CommunityID string `bson:"community_id"`
+	Type        string `bson:"type"`
+	Reason      string `bson:"reason,omitempty"`
+	IssuedBy    string `bson:"issued_by"`
+	ExpiresAt   *int64 `bson:"expires_at,omitempty"`
+	CreatedAt   int64  `bson:"created_at"`
+}
+
+// Save persists a sanction. A user holds at most one sanction of each type per community,
+// so issuing a new one overwrites the previous one instead of piling up.
+func (r *sanctionRepositoryImpl) Save(ctx context.Context, sanction *entities.Sanction) error {
+	doc := r.toDocument(sanction)
+
+	filter := bson.M{
+		"user_id":      doc.UserID,
+		"community_id": doc.CommunityID,
+		"type":         doc.Type,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"sanction_id": doc.SanctionID,
+			"reason":      doc.Reason,
+			"issued_by":   doc.IssuedBy,
+			"expires_at":  doc.ExpiresAt,
+			"created_at":  doc.CreatedAt,
+		},
+		"$setOnInsert": bson.M{
+			"_id": doc.ID,
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// FindActive retrieves the sanction of the given type that still applies to a user
+func (r *sanctionRepositoryImpl) FindActive(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID, sanctionType valueobjects.SanctionType, now time.Time) (*entities.Sanction, error) {
+	filter := r.activeFilter(communityID, sanctionType, now)
+	filter["user_id"] = userID.Value()
+
+	var doc sanctionDocument
+	err := r.collection.FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.toEntity(&doc)
+}
+
+// FindActiveByCommunity retrieves the sanctions of the given type that still apply in a community, newest first
+func (r *sanctionRepositoryImpl) FindActiveByCommunity(ctx context.Context, communityID valueobjects.CommunityID, sanctionType valueobjects.SanctionType, now time.Time) ([]*entities.Sanction, error) {
+	filter := r.activeFilter(communityID, sanctionType, now)
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "sanction_id", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var sanctions []*entities.Sanction
+	for cursor.Next(ctx) {
+		var doc sanctionDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		sanction, err := r.toEntity(&doc)
+		if err != nil {
+			return nil, err
+		}
+		sanctions = append(sanctions, sanction)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return sanctions, nil
+}
+
+// Delete removes the sanction of the given type for a user in a community
+func (r *sanctionRepositoryImpl) Delete(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID, sanctionType valueobjects.SanctionType) error {
+	filter := bson.M{
+		"user_id":      userID.Value(),
+		"community_id": communityID.Value(),
+		"type":         sanctionType.Value(),
+	}
+
+	_, err := r.collection.DeleteOne(ctx, filter)
+	return err
+}
+
+// DeleteByCommunity removes all sanctions for a community
+func (r *sanctionRepositoryImpl) DeleteByCommunity(ctx context.Context, communityID valueobjects.CommunityID) error {
+	filter := bson.M{
+		"community_id": communityID.Value(),
+	}
+
+	_, err := r.collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// activeFilter matches permanent sanctions and the ones that end after now
+func (r *sanctionRepositoryImpl) activeFilter(communityID valueobjects.CommunityID, sanctionType valueobjects.SanctionType, now time.Time) bson.M {
+	return bson.M{
+		"community_id": communityID.Value(),
+		"type":         sanctionType.Value(),
+		"$or": bson.A{
+			bson.M{"expires_at": nil},
+			bson.M{"expires_at": bson.M{"$gt": now.Unix()}},
+		},
+	}
+}
+
+// toDocument converts an entity to a document
+func (r *sanctionRepositoryImpl) toDocument(sanction *entities.Sanction) *sanctionDocument {
+	doc := &sanctionDocument{
+		ID:          sanction.ID(),
+		SanctionID:  sanction.SanctionID().Value(),
+		UserID:      sanction.UserID().Value(),
+		CommunityID: sanction.CommunityID().Value(),
+		Type:        sanction.Type().Value(),
+		Reason:      sanction.Reason().Value(),
+		IssuedBy:    sanction.IssuedBy().Value(),
+		CreatedAt:   sanction.CreatedAt().Unix(),
+	}
+
+	if expiresAt := sanction.ExpiresAt(); expiresAt != nil {
+		value := expiresAt.Unix()
+		doc.ExpiresAt = &value
+	}
+
+	return doc
+}
+
+// toEntity converts a document to an entity
+func (r *sanctionRepositoryImpl) toEntity(doc *sanctionDocument) (*entities.Sanction, error) {
+	sanctionID, err := valueobjects.NewSanctionID(doc.SanctionID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := valueobjects.NewUserID(doc.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	communityID, err := valueobjects.NewCommunityID(doc.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	sanctionType, err := valueobjects.NewSanctionType(doc.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	reason, err := valueobjects.NewSanctionReason(doc.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	issuedBy, err := valueobjects.NewUserID(doc.IssuedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt *time.Time
+	if doc.ExpiresAt != nil {
+		value := time.Unix(*doc.ExpiresAt, 0)
+		expiresAt = &value
+	}
+
+	return entities.ReconstructSanction(
+		doc.ID,
+		sanctionID,
+		userID,
+		communityID,
+		sanctionType,
+		reason,
+		issuedBy,
+		expiresAt,
+		time.Unix(doc.CreatedAt, 0),
+	), nil
+}
@@ -32,6 +32,8 @@ type subscriptionDocument struct {
 	UserID         string `bson:"user_id"`
 	CommunityID    string `bson:"community_id"`
 	Role           string `bson:"role"`
+	ExpiresAt      *int64 `bson:"expires_at,omitempty"`
+	AlumniRole     string `bson:"alumni_role,omitempty"`
 	CreatedAt      int64  `bson:"created_at"`
 	UpdatedAt      int64  `bson:"updated_at"`
 }
@@ -40,10 +42,20 @@ type subscriptionDocument struct {
 func (r *subscriptionRepositoryImpl) Save(ctx context.Context, subscription *entities.Subscription) error {
 	doc := r.toDocument(subscription)
 
+	// A rejoining user replaces the expired membership the sweeper has not removed yet
+	lingering := bson.M{
+		"user_id":      doc.UserID,
+		"community_id": doc.CommunityID,
+		"expires_at":   bson.M{"$lte": time.Now().Unix()},
+	}
+	if _, err := r.collection.DeleteMany(ctx, lingering); err != nil {
+		return err
+	}
+
 	_, err := r.collection.InsertOne(ctx, doc)
 	if err != nil {
 		if mongo.IsDuplicateKeyError(err) {
-			return errors.New("subscription already exists")
+			return domain_repos.ErrSubscriptionExists
 		}
 		return err
 	}
@@ -51,6 +63,63 @@ func (r *subscriptionRepositoryImpl) Save(ctx context.Context, subscription *ent
 	return nil
 }
 
+// Update persists the role and expiry of an existing subscription
+func (r *subscriptionRepositoryImpl) Update(ctx context.Context, subscription *entities.Subscription) error {
+	result, err := r.update(ctx, bson.M{"subscription_id": subscription.SubscriptionID().Value()}, subscription)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("subscription not found")
+	}
+
+	return nil
+}
+
+// UpdateExpired persists the role and expiry of a subscription only while its stored expiry is not after now
+func (r *subscriptionRepositoryImpl) UpdateExpired(ctx context.Context, subscription *entities.Subscription, now time.Time) (bool, error) {
+	filter := bson.M{
+		"subscription_id": subscription.SubscriptionID().Value(),
+		"expires_at":      bson.M{"$lte": now.Unix()},
+	}
+
+	result, err := r.update(ctx, filter, subscription)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount == 1, nil
+}
+
+// update writes the role and expiry of the subscription to the document matching the filter
+func (r *subscriptionRepositoryImpl) update(ctx context.Context, filter bson.M, subscription *entities.Subscription) (*mongo.UpdateResult, error) {
+	doc := r.toDocument(subscription)
+
+	set := bson.M{
+		"role":       doc.Role,
+		"updated_at": doc.UpdatedAt,
+	}
+	unset := bson.M{}
+	if doc.ExpiresAt != nil {
+		set["expires_at"] = *doc.ExpiresAt
+	} else {
+		unset["expires_at"] = ""
+	}
+	if doc.AlumniRole != "" {
+		set["alumni_role"] = doc.AlumniRole
+	} else {
+		unset["alumni_role"] = ""
+	}
+
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	return r.collection.UpdateOne(ctx, filter, update)
+}
+
 // FindByID retrieves a subscription by its ID
 func (r *subscriptionRepositoryImpl) FindByID(ctx context.Context, id valueobjects.SubscriptionID) (*entities.Subscription, error) {
 	filter := bson.M{"subscription_id": id.Value()}
@@ -67,11 +136,18 @@ func (r *subscriptionRepositoryImpl) FindByID(ctx context.Context, id valueobjec
 	return r.toEntity(&doc)
 }
 
-// FindByUserAndCommunity retrieves a subscription by user ID and community ID
+// notExpired matches subscriptions without an expiry or whose expiry is still ahead,
+// leaving out the expired ones the sweeper has not removed yet
+func notExpired() bson.M {
+	return bson.M{"$not": bson.M{"$lte": time.Now().Unix()}}
+}
+
+// FindByUserAndCommunity retrieves the active subscription of a user in a community
 func (r *subscriptionRepositoryImpl) FindByUserAndCommunity(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID) (*entities.Subscription, error) {
 	filter := bson.M{
 		"user_id":      userID.Value(),
 		"community_id": communityID.Value(),
+		"expires_at":   notExpired(),
 	}
 
 	var doc subscriptionDocument
@@ -86,13 +162,35 @@ func (r *subscriptionRepositoryImpl) FindByUserAndCommunity(ctx context.Context,
 	return r.toEntity(&doc)
 }
 
-// FindAllByCommunityID retrieves all subscriptions for a specific community
-func (r *subscriptionRepositoryImpl) FindAllByCommunityID(ctx context.Context, communityID valueobjects.CommunityID, limit, offset *int) ([]*entities.Subscription, error) {
-	filter := bson.M{"community_id": communityID.Value()}
+// FindAllByCommunityID retrieves the subscriptions of a community using keyset pagination
+func (r *subscriptionRepositoryImpl) FindAllByCommunityID(ctx context.Context, communityID valueobjects.CommunityID, subscriptionFilter domain_repos.SubscriptionFilter, limit, offset *int, cursor valueobjects.Cursor) ([]*entities.Subscription, error) {
+	filter := bson.M{"community_id": communityID.Value(), "expires_at": notExpired()}
+	if subscriptionFilter.Role != nil {
+		filter["role"] = subscriptionFilter.Role.Value()
+	}
+	if subscriptionFilter.UserIDs != nil {
+		userIDs := make([]string, len(subscriptionFilter.UserIDs))
+		for i, userID := range subscriptionFilter.UserIDs {
+			userIDs[i] = userID.Value()
+		}
+		filter["user_id"] = bson.M{"$in": userIDs}
+	}
+
+	// Newest first by default; the keyset comparison follows the sort direction
+	direction, comparison := -1, "$lt"
+	if subscriptionFilter.OldestFirst {
+		direction, comparison = 1, "$gt"
+	}
 
-	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: direction}, {Key: "subscription_id", Value: direction}})
 
-	if offset != nil && *offset > 0 {
+	if !cursor.IsZero() {
+		createdAt := cursor.CreatedAt().Unix()
+		filter["$or"] = bson.A{
+			bson.M{"created_at": bson.M{comparison: createdAt}},
+			bson.M{"created_at": createdAt, "subscription_id": bson.M{comparison: cursor.SubscriptionID()}},
+		}
+	} else if offset != nil && *offset > 0 {
 		opts.SetSkip(int64(*offset))
 	}
 
@@ -100,6 +198,84 @@ func (r *subscriptionRepositoryImpl) FindAllByCommunityID(ctx context.Context, c
 		opts.SetLimit(int64(*limit))
 	}
 
+	cursorResult, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursorResult.Close(ctx)
+
+	var subscriptions []*entities.Subscription
+	for cursorResult.Next(ctx) {
+		var doc subscriptionDocument
+		if err := cursorResult.Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		subscription, err := r.toEntity(&doc)
+		if err != nil {
+			return nil, err
+		}
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err := cursorResult.Err(); err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+// FindUserIDsByCommunityID retrieves the user IDs of the members of a community, reading only the user_id field
+func (r *subscriptionRepositoryImpl) FindUserIDsByCommunityID(ctx context.Context, communityID valueobjects.CommunityID, role *valueobjects.CommunityRole) ([]valueobjects.UserID, error) {
+	filter := bson.M{"community_id": communityID.Value(), "expires_at": notExpired()}
+	if role != nil {
+		filter["role"] = role.Value()
+	}
+
+	opts := options.Find().SetProjection(bson.M{"user_id": 1, "_id": 0})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var userIDs []valueobjects.UserID
+	for cursor.Next(ctx) {
+		var doc struct {
+			UserID string `bson:"user_id"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		userID, err := valueobjects.NewUserID(doc.UserID)
+		if err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+// FindAllByUserID retrieves the active subscriptions of a user, leaving out expired ones the sweeper has not removed yet
+func (r *subscriptionRepositoryImpl) FindAllByUserID(ctx context.Context, userID valueobjects.UserID) ([]*entities.Subscription, error) {
+	filter := bson.M{
+		"user_id": userID.Value(),
+		"$or": bson.A{
+			bson.M{"expires_at": nil},
+			bson.M{"expires_at": bson.M{"$gt": time.Now().Unix()}},
+		},
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
 	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
@@ -128,11 +304,12 @@ func (r *subscriptionRepositoryImpl) FindAllByCommunityID(ctx context.Context, c
 	return subscriptions, nil
 }
 
-// FindAllByUserID retrieves all subscriptions for a specific user
-func (r *subscriptionRepositoryImpl) FindAllByUserID(ctx context.Context, userID valueobjects.UserID) ([]*entities.Subscription, error) {
-	filter := bson.M{"user_id": userID.Value()}
-
-	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+// FindExpired retrieves subscriptions whose expiry is not after now, oldest expiry first
+func (r *subscriptionRepositoryImpl) FindExpired(ctx context.Context, now time.Time, limit int) ([]*entities.Subscription, error) {
+	filter := bson.M{"expires_at": bson.M{"$lte": now.Unix()}}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "expires_at", Value: 1}}).
+		SetLimit(int64(limit))
 
 	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
@@ -162,9 +339,9 @@ func (r *subscriptionRepositoryImpl) FindAllByUserID(ctx context.Context, userID
 	return subscriptions, nil
 }
 
-// CountByCommunityID returns the total number of subscriptions for a community
+// CountByCommunityID returns the number of active subscriptions for a community
 func (r *subscriptionRepositoryImpl) CountByCommunityID(ctx context.Context, communityID valueobjects.CommunityID) (int64, error) {
-	filter := bson.M{"community_id": communityID.Value()}
+	filter := bson.M{"community_id": communityID.Value(), "expires_at": notExpired()}
 
 	count, err := r.collection.CountDocuments(ctx, filter)
 	if err != nil {
@@ -174,11 +351,68 @@ func (r *subscriptionRepositoryImpl) CountByCommunityID(ctx context.Context, com
 	return count, nil
 }
 
+// CountByCommunityIDs returns the number of subscriptions of each community in a single aggregation
+func (r *subscriptionRepositoryImpl) CountByCommunityIDs(ctx context.Context, communityIDs []valueobjects.CommunityID) (map[string]int64, error) {
+	counts := make(map[string]int64, len(communityIDs))
+	if len(communityIDs) == 0 {
+		return counts, nil
+	}
+
+	ids := make([]string, len(communityIDs))
+	for i, communityID := range communityIDs {
+		ids[i] = communityID.Value()
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"community_id": bson.M{"$in": ids}, "expires_at": notExpired()}}},
+		{{Key: "$group", Value: bson.M{"_id": "$community_id", "count": bson.M{"$sum": 1}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var row struct {
+			CommunityID string `bson:"_id"`
+			Count       int64  `bson:"count"`
+		}
+		if err := cursor.Decode(&row); err != nil {
+			return nil, err
+		}
+		counts[row.CommunityID] = row.Count
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
 // ExistsByUserAndCommunity checks if a subscription exists for a user in a community
 func (r *subscriptionRepositoryImpl) ExistsByUserAndCommunity(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID) (bool, error) {
 	filter := bson.M{
 		"user_id":      userID.Value(),
 		"community_id": communityID.Value(),
+		"expires_at":   notExpired(),
+	}
+
+	count, err := r.collection.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// ExistsByCommunityAndRole checks if any member of a community holds the given role
+func (r *subscriptionRepositoryImpl) ExistsByCommunityAndRole(ctx context.Context, communityID valueobjects.CommunityID, role valueobjects.CommunityRole) (bool, error) {
+	filter := bson.M{
+		"community_id": communityID.Value(),
+		"role":         role.Value(),
 	}
 
 	count, err := r.collection.CountDocuments(ctx, filter, options.Count().SetLimit(1))
@@ -205,6 +439,21 @@ func (r *subscriptionRepositoryImpl) Delete(ctx context.Context, id valueobjects
 	return nil
 }
 
+// DeleteExpired removes a subscription only while its stored expiry is not after now
+func (r *subscriptionRepositoryImpl) DeleteExpired(ctx context.Context, id valueobjects.SubscriptionID, now time.Time) (bool, error) {
+	filter := bson.M{
+		"subscription_id": id.Value(),
+		"expires_at":      bson.M{"$lte": now.Unix()},
+	}
+
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+
+	return result.DeletedCount == 1, nil
+}
+
 // DeleteByUserAndCommunity removes a subscription by user and community
 func (r *subscriptionRepositoryImpl) DeleteByUserAndCommunity(ctx context.Context, userID valueobjects.UserID, communityID valueobjects.CommunityID) error {
 	filter := bson.M{
@@ -238,9 +487,23 @@ func (r *subscriptionRepositoryImpl) DeleteByCommunity(ctx context.Context, comm
 	return nil
 }
 
+// DeleteByUser removes all subscriptions for a given user
+func (r *subscriptionRepositoryImpl) DeleteByUser(ctx context.Context, userID valueobjects.UserID) error {
+	filter := bson.M{
+		"user_id": userID.Value(),
+	}
+
+	_, err := r.collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // toDocument converts an entity to a document
 func (r *subscriptionRepositoryImpl) toDocument(subscription *entities.Subscription) *subscriptionDocument {
-	return &subscriptionDocument{
+	doc := &subscriptionDocument{
 		ID:             subscription.ID(),
 		SubscriptionID: subscription.SubscriptionID().Value(),
 		UserID:         subscription.UserID().Value(),
@@ -249,6 +512,16 @@ func (r *subscriptionRepositoryImpl) toDocument(subscription *entities.Subscript
 		CreatedAt:      subscription.CreatedAt().Unix(),
 		UpdatedAt:      subscription.UpdatedAt().Unix(),
 	}
+
+	if subscription.ExpiresAt() != nil {
+		expiresAt := subscription.ExpiresAt().Unix()
+		doc.ExpiresAt = &expiresAt
+	}
+	if subscription.AlumniRole() != nil {
+		doc.AlumniRole = subscription.AlumniRole().Value()
+	}
+
+	return doc
 }
 
 // toEntity converts a document to an entity
@@ -273,12 +546,29 @@ func (r *subscriptionRepositoryImpl) toEntity(doc *subscriptionDocument) (*entit
 		return nil, err
 	}
 
+	var expiresAt *time.Time
+	if doc.ExpiresAt != nil {
+		t := time.Unix(*doc.ExpiresAt, 0)
+		expiresAt = &t
+	}
+
+	var alumniRole *valueobjects.CommunityRole
+	if doc.AlumniRole != "" {
+		parsed, err := valueobjects.NewCommunityRole(doc.AlumniRole)
+		if err != nil {
+			return nil, err
+		}
+		alumniRole = &parsed
+	}
+
 	return entities.ReconstructSubscription(
 		doc.ID,
 		subscriptionID,
 		userID,
 		communityID,
 		role,
+		expiresAt,
+		alumniRole,
 		time.Unix(doc.CreatedAt, 0),
 		time.Unix(doc.UpdatedAt, 0),
 	), nil
@@ -0,0 +1,66 @@
+package scheduling
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"Gommunity/platform/subscriptions/domain/services"
+)
+
+// MembershipExpirySweeper periodically ends the memberships whose expiry date has been reached.
+type MembershipExpirySweeper struct {
+	commandService services.SubscriptionCommandService
+	interval       time.Duration
+	stop           chan struct{}
+	done           sync.WaitGroup
+}
+
+// NewMembershipExpirySweeper creates a sweeper that checks for expired memberships every interval.
+func NewMembershipExpirySweeper(commandService services.SubscriptionCommandService, interval time.Duration) *MembershipExpirySweeper {
+	return &MembershipExpirySweeper{
+		commandService: commandService,
+		interval:       interval,
+		stop:           make(chan struct{}),
+	}
+}
+
+// Start runs the sweeper in a background goroutine until Stop is called or ctx is cancelled.
+func (s *MembershipExpirySweeper) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	s.done.Add(1)
+	go func() {
+		defer s.done.Done()
+		defer ticker.Stop()
+
+		log.Printf("Membership expiry sweeper started (interval %s)", s.interval)
+		for {
+			select {
+			case <-ticker.C:
+				s.expireDue(ctx)
+			case <-s.stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop signals the sweeper to exit and waits for the current run to finish.
+func (s *MembershipExpirySweeper) Stop() {
+	close(s.stop)
+	s.done.Wait()
+	log.Println("Membership expiry sweeper stopped")
+}
+
+func (s *MembershipExpirySweeper) expireDue(ctx context.Context) {
+	expired, err := s.commandService.HandleExpireDue(ctx)
+	if err != nil {
+		log.Printf("Membership expiry sweep error: %v", err)
+	}
+	if expired > 0 {
+		log.Printf("Expired %d membership(s)", expired)
+	}
+}
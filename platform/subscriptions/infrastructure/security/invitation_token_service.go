@@ -0,0 +1,55 @@
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	"Gommunity/platform/subscriptions/domain/services"
+)
+
+type hmacInvitationTokenService struct {
+	secret []byte
+}
+
+// NewInvitationTokenService creates an InvitationTokenService that signs tokens with HMAC-SHA256.
+// Tokens have the form "<invitationID>.<signature>".
+func NewInvitationTokenService(secret string) services.InvitationTokenService {
+	return &hmacInvitationTokenService{
+		secret: []byte(secret),
+	}
+}
+
+// Sign returns the token for an invitation
+func (s *hmacInvitationTokenService) Sign(invitationID valueobjects.InvitationID) string {
+	return invitationID.Value() + "." + base64.RawURLEncoding.EncodeToString(s.signature(invitationID.Value()))
+}
+
+// Verify checks the token signature and returns the invitation it was issued for
+func (s *hmacInvitationTokenService) Verify(token string) (valueobjects.InvitationID, error) {
+	id, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return valueobjects.InvitationID{}, errors.New("invalid invitation token")
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, s.signature(id)) {
+		return valueobjects.InvitationID{}, errors.New("invalid invitation token")
+	}
+
+	invitationID, err := valueobjects.NewInvitationID(id)
+	if err != nil {
+		return valueobjects.InvitationID{}, errors.New("invalid invitation token")
+	}
+
+	return invitationID, nil
+}
+
+func (s *hmacInvitationTokenService) signature(id string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte("invitation:" + id))
+	return mac.Sum(nil)
+}
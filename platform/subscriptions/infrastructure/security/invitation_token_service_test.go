@@ -0,0 +1,60 @@
+package security
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+func TestVerifyAcceptsSignedToken(t *testing.T) {
+	service := NewInvitationTokenService("test-secret")
+	invitationID := valueobjects.GenerateInvitationID()
+
+	verified, err := service.Verify(service.Sign(invitationID))
+	if err != nil {
+		t.Fatalf("Verify returned an error: %v", err)
+	}
+	if verified.Value() != invitationID.Value() {
+		t.Errorf("Verify = %q, want %q", verified.Value(), invitationID.Value())
+	}
+}
+
+func TestVerifyRejectsTamperedTokens(t *testing.T) {
+	service := NewInvitationTokenService("test-secret")
+	invitationID := valueobjects.GenerateInvitationID()
+	token := service.Sign(invitationID)
+	_, signature, _ := strings.Cut(token, ".")
+
+	otherID := valueobjects.GenerateInvitationID()
+	otherToken := service.Sign(otherID)
+	_, otherSignature, _ := strings.Cut(otherToken, ".")
+
+	tests := map[string]string{
+		"empty":                 "",
+		"missing signature":     invitationID.Value(),
+		"empty signature":       invitationID.Value() + ".",
+		"signature not base64":  invitationID.Value() + ".not base64!",
+		"signature of other ID": invitationID.Value() + "." + otherSignature,
+		"ID swapped":            otherID.Value() + "." + signature,
+		"signature truncated":   token[:len(token)-1],
+		"invalid ID":            "42." + base64.RawURLEncoding.EncodeToString(service.(*hmacInvitationTokenService).signature("42")),
+	}
+	for name, candidate := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := service.Verify(candidate); err == nil {
+				t.Errorf("Verify(%q) should fail", candidate)
+			}
+		})
+	}
+}
+
+func TestVerifyRejectsTokenSignedWithAnotherSecret(t *testing.T) {
+	invitationID := valueobjects.GenerateInvitationID()
+	token := NewInvitationTokenService("other-secret").Sign(invitationID)
+
+	if _, err := NewInvitationTokenService("test-secret").Verify(token); err == nil {
+		t.Error("Verify should reject a token signed with another secret")
+	}
+}
@@ -1,16 +1,46 @@
 package acl
 
-import "context"
+import (
+	"context"
+
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+)
+
+// Permission names accepted by HasPermission
+const (
+	PublishPostPermission         = valueobjects.PublishPostPermissionName
+	PublishAnnouncementPermission = valueobjects.PublishAnnouncementPermissionName
+	DeleteAnyPostPermission       = valueobjects.DeleteAnyPostPermissionName
+	ManageMembersPermission       = valueobjects.ManageMembersPermissionName
+	PinPostPermission             = valueobjects.PinPostPermissionName
+	ModerateReactionsPermission   = valueobjects.ModerateReactionsPermissionName
+)
 
 // SubscriptionsFacade exposes subscription-specific operations to other bounded contexts.
 type SubscriptionsFacade interface {
-	// GetUserRoleInCommunity returns the role name (member, admin, owner) for a user in a community.
+	// GetUserRoleInCommunity returns the role name (member, admin, owner or a custom role) for a user in a community.
 	// Returns empty string when no subscription exists.
 	GetUserRoleInCommunity(ctx context.Context, userID string, communityID string) (string, error)
 
+	// HasPermission indicates whether the user holds the permission in the community.
+	// Authorization checks should use it instead of comparing role names.
+	HasPermission(ctx context.Context, userID string, communityID string, permission string) (bool, error)
+
 	// IsUserSubscribed indicates whether the user belongs to the community.
 	IsUserSubscribed(ctx context.Context, userID string, communityID string) (bool, error)
 
+	// IsUserMuted indicates whether the user is currently muted in the community.
+	// Muted members stay subscribed but cannot post, comment or react.
+	IsUserMuted(ctx context.Context, userID string, communityID string) (bool, error)
+
 	// GetUserCommunityIDs retrieves all community IDs that a user is subscribed to
 	GetUserCommunityIDs(ctx context.Context, userID string) ([]string, error)
+
+	// CountMembersByCommunities returns the member count of each community, keyed by community ID.
+	// Communities without members are absent from the result.
+	CountMembersByCommunities(ctx context.Context, communityIDs []string) (map[string]int64, error)
+
+	// GetLongestStandingAdminID returns the admin who joined the community first, leaving out excludedUserID.
+	// Returns empty string when the community has no other admin.
+	GetLongestStandingAdminID(ctx context.Context, communityID string, excludedUserID string) (string, error)
 }
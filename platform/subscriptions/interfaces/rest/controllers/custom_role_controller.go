@@ -0,0 +1,282 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"Gommunity/platform/subscriptions/domain/model/commands"
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/queries"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	"Gommunity/platform/subscriptions/domain/services"
+	"Gommunity/platform/subscriptions/interfaces/rest/resources"
+)
+
+type CustomRoleController struct {
+	commandService services.CustomRoleCommandService
+	queryService   services.CustomRoleQueryService
+}
+
+func NewCustomRoleController(
+	commandService services.CustomRoleCommandService,
+	queryService services.CustomRoleQueryService,
+) *CustomRoleController {
+	return &CustomRoleController{
+		commandService: commandService,
+		queryService:   queryService,
+	}
+}
+
+// @Summary Define a custom community role
+// @Description Define a role such as "teaching_assistant" with a set of permissions: publish_post, publish_announcement, delete_any_post, manage_members, pin_post, moderate_reactions. The role can then be given to members like the predefined ones. Only the community owner can manage custom roles.
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Param request body resources.CreateCustomRoleResource true "Custom role"
+// @Success 201 {object} resources.CustomRoleResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/roles [post]
+func (c *CustomRoleController) CreateRole(ctx *gin.Context) {
+	requestedBy, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req resources.CreateCustomRoleResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid community ID"})
+		return
+	}
+
+	name, err := valueobjects.NewCommunityRole(req.Name)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	permissions, err := toPermissions(req.Permissions)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewCreateCustomRoleCommand(communityID, name, permissions, requestedBy)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	role, err := c.commandService.HandleCreate(ctx.Request.Context(), cmd)
+	if err != nil {
+		ctx.JSON(customRoleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, toCustomRoleResource(role))
+}
+
+// @Summary List the custom roles of a community
+// @Description List the custom roles defined in a community and their permissions, sorted by name
+// @Tags subscriptions
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Success 200 {object} resources.CustomRoleListResource
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/roles [get]
+func (c *CustomRoleController) GetRoles(ctx *gin.Context) {
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid community ID"})
+		return
+	}
+
+	query, err := queries.NewGetCustomRolesByCommunityQuery(communityID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	roles, err := c.queryService.HandleGetByCommunity(ctx.Request.Context(), query)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err.Error() == "community not found" {
+			statusCode = http.StatusNotFound
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	roleResources := make([]resources.CustomRoleResource, 0, len(roles))
+	for _, role := range roles {
+		roleResources = append(roleResources, toCustomRoleResource(role))
+	}
+
+	ctx.JSON(http.StatusOK, resources.CustomRoleListResource{
+		Roles: roleResources,
+		Total: len(roleResources),
+	})
+}
+
+// @Summary Update the permissions of a custom role
+// @Description Replace the permissions of a custom role. Members holding the role get the new permissions at once. Only the community owner can manage custom roles.
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Param role_name path string true "Role name"
+// @Param request body resources.UpdateCustomRoleResource true "New permissions"
+// @Success 200 {object} resources.CustomRoleResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/roles/{role_name} [put]
+func (c *CustomRoleController) UpdateRole(ctx *gin.Context) {
+	requestedBy, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req resources.UpdateCustomRoleResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid community ID"})
+		return
+	}
+
+	name, err := valueobjects.NewCommunityRole(ctx.Param("role_name"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	permissions, err := toPermissions(req.Permissions)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewUpdateCustomRoleCommand(communityID, name, permissions, requestedBy)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	role, err := c.commandService.HandleUpdate(ctx.Request.Context(), cmd)
+	if err != nil {
+		ctx.JSON(customRoleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toCustomRoleResource(role))
+}
+
+// @Summary Delete a custom role
+// @Description Delete a custom role. A role still held by members cannot be deleted; give them another role first. Only the community owner can manage custom roles.
+// @Tags subscriptions
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Param role_name path string true "Role name"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/roles/{role_name} [delete]
+func (c *CustomRoleController) DeleteRole(ctx *gin.Context) {
+	requestedBy, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid community ID"})
+		return
+	}
+
+	name, err := valueobjects.NewCommunityRole(ctx.Param("role_name"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewDeleteCustomRoleCommand(communityID, name, requestedBy)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleDelete(ctx.Request.Context(), cmd); err != nil {
+		ctx.JSON(customRoleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// customRoleErrorStatus maps custom role command errors to HTTP status codes
+func customRoleErrorStatus(err error) int {
+	switch err.Error() {
+	case "community not found", "role not found":
+		return http.StatusNotFound
+	case "only the community owner can manage custom roles":
+		return http.StatusForbidden
+	case "role already exists in this community", "role is still assigned to members of this community":
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
+
+func toPermissions(values []string) ([]valueobjects.Permission, error) {
+	permissions := make([]valueobjects.Permission, 0, len(values))
+	for _, value := range values {
+		permission, err := valueobjects.NewPermission(value)
+		if err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, permission)
+	}
+	return permissions, nil
+}
+
+func toCustomRoleResource(role *entities.CustomRole) resources.CustomRoleResource {
+	permissions := make([]string, len(role.Permissions()))
+	for i, permission := range role.Permissions() {
+		permissions[i] = permission.Value()
+	}
+
+	return resources.CustomRoleResource{
+		CustomRoleID: role.CustomRoleID().Value(),
+		CommunityID:  role.CommunityID().Value(),
+		Name:         role.Name().Value(),
+		Permissions:  permissions,
+		CreatedBy:    role.CreatedBy().Value(),
+		CreatedAt:    role.CreatedAt(),
+		UpdatedAt:    role.UpdatedAt(),
+	}
+}
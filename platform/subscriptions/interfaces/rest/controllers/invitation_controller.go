@@ -0,0 +1,296 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"Gommunity/platform/subscriptions/domain/model/commands"
+	"Gommunity/platform/subscriptions/domain/model/queries"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	"Gommunity/platform/subscriptions/domain/services"
+	"Gommunity/platform/subscriptions/interfaces/rest/resources"
+)
+
+type InvitationController struct {
+	commandService services.InvitationCommandService
+	queryService   services.InvitationQueryService
+}
+
+func NewInvitationController(
+	commandService services.InvitationCommandService,
+	queryService services.InvitationQueryService,
+) *InvitationController {
+	return &InvitationController{
+		commandService: commandService,
+		queryService:   queryService,
+	}
+}
+
+// @Summary Create an invitation link
+// @Description Create a shareable invitation for a community. Owners and admins can invite members; invitations that grant the admin role need the owner, or an admin when the owner lets admins manage each other.
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Param request body resources.CreateInvitationResource true "Invitation settings"
+// @Success 201 {object} resources.InvitationResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/invitations [post]
+func (c *InvitationController) CreateInvitation(ctx *gin.Context) {
+	var req resources.CreateInvitationResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	createdBy, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid community ID"})
+		return
+	}
+
+	role, err := valueobjects.NewCommunityRole(req.Role)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	maxUses := 1
+	if req.MaxUses != nil {
+		maxUses = *req.MaxUses
+	}
+
+	cmd, err := commands.NewCreateInvitationCommand(communityID, createdBy, role, maxUses, req.ExpiresAt)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	invitationID, err := c.commandService.HandleCreate(ctx.Request.Context(), cmd)
+	if err != nil {
+		statusCode := http.StatusBadRequest
+		if strings.HasPrefix(err.Error(), "failed to") {
+			statusCode = http.StatusInternalServerError
+		} else if err.Error() == "community not found" || err.Error() == "role not found in this community" {
+			statusCode = http.StatusNotFound
+		} else if err.Error() == "only community owner or admins can manage invitations" ||
+			err.Error() == "only the community owner, or admins when the owner allows it, can manage admins" {
+			statusCode = http.StatusForbidden
+		} else if err.Error() == "community is archived" {
+			statusCode = http.StatusConflict
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	query, _ := queries.NewGetInvitationByIDQuery(*invitationID)
+	link, err := c.queryService.HandleGetByID(ctx.Request.Context(), query)
+	if err != nil || link == nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to retrieve created invitation"})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, toInvitationResource(link, time.Now()))
+}
+
+// @Summary List invitations of a community
+// @Description List every invitation of a community, newest first, including revoked, expired and used-up ones. Only the community owner and admins can see them.
+// @Tags subscriptions
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Success 200 {object} resources.InvitationListResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/invitations [get]
+func (c *InvitationController) GetInvitations(ctx *gin.Context) {
+	requestedBy, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid community ID"})
+		return
+	}
+
+	query, err := queries.NewGetInvitationsByCommunityQuery(communityID, requestedBy)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	links, err := c.queryService.HandleAll(ctx.Request.Context(), query)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err.Error() == "community not found" {
+			statusCode = http.StatusNotFound
+		} else if err.Error() == "only community owner or admins can manage invitations" {
+			statusCode = http.StatusForbidden
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	now := time.Now()
+	invitationResources := make([]resources.InvitationResource, 0, len(links))
+	for _, link := range links {
+		invitationResources = append(invitationResources, toInvitationResource(link, now))
+	}
+
+	ctx.JSON(http.StatusOK, resources.InvitationListResource{
+		Invitations: invitationResources,
+		Total:       len(invitationResources),
+	})
+}
+
+// @Summary Revoke an invitation
+// @Description Revoke an invitation so it can no longer be accepted. Existing subscriptions are kept.
+// @Tags subscriptions
+// @Produce json
+// @Param invitation_id path string true "Invitation ID"
+// @Success 200 {object} resources.InvitationResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/invitations/{invitation_id} [delete]
+func (c *InvitationController) RevokeInvitation(ctx *gin.Context) {
+	requestedBy, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	invitationID, err := valueobjects.NewInvitationID(ctx.Param("invitation_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid invitation ID"})
+		return
+	}
+
+	cmd, err := commands.NewRevokeInvitationCommand(invitationID, requestedBy)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleRevoke(ctx.Request.Context(), cmd); err != nil {
+		statusCode := http.StatusInternalServerError
+		if err.Error() == "invitation not found" {
+			statusCode = http.StatusNotFound
+		} else if err.Error() == "only community owner or admins can manage invitations" {
+			statusCode = http.StatusForbidden
+		} else if err.Error() == "invitation is already revoked" {
+			statusCode = http.StatusConflict
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	query, _ := queries.NewGetInvitationByIDQuery(invitationID)
+	link, err := c.queryService.HandleGetByID(ctx.Request.Context(), query)
+	if err != nil || link == nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to retrieve invitation"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toInvitationResource(link, time.Now()))
+}
+
+// @Summary Accept an invitation
+// @Description Join a community through an invitation token. The user gets the role granted by the invitation, also in private communities.
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Param request body resources.AcceptInvitationResource true "Invitation token"
+// @Success 201 {object} resources.SubscriptionResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 410 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/invitations/accept [post]
+func (c *InvitationController) AcceptInvitation(ctx *gin.Context) {
+	var req resources.AcceptInvitationResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	cmd, err := commands.NewAcceptInvitationCommand(req.Token, userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	subscription, err := c.commandService.HandleAccept(ctx.Request.Context(), cmd)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch err.Error() {
+		case "invalid invitation token":
+			statusCode = http.StatusBadRequest
+		case "invitation not found", "user not found":
+			statusCode = http.StatusNotFound
+		case "user is already subscribed to this community", "community is archived":
+			statusCode = http.StatusConflict
+		case "user is banned from this community":
+			statusCode = http.StatusForbidden
+		case "invitation has been revoked", "invitation has expired",
+			"invitation has reached its maximum number of uses", "invitation is no longer valid",
+			"role not found in this community":
+			statusCode = http.StatusGone
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, resources.SubscriptionResource{
+		SubscriptionID: subscription.SubscriptionID().Value(),
+		UserID:         subscription.UserID().Value(),
+		CommunityID:    subscription.CommunityID().Value(),
+		Role:           subscription.Role().Value(),
+		CreatedAt:      subscription.CreatedAt(),
+		UpdatedAt:      subscription.UpdatedAt(),
+	})
+}
+
+func toInvitationResource(link *services.InvitationLink, now time.Time) resources.InvitationResource {
+	invitation := link.Invitation
+	return resources.InvitationResource{
+		InvitationID: invitation.InvitationID().Value(),
+		CommunityID:  invitation.CommunityID().Value(),
+		CreatedBy:    invitation.CreatedBy().Value(),
+		Role:         invitation.Role().Value(),
+		Token:        link.Token,
+		Status:       invitation.Status(now),
+		MaxUses:      invitation.MaxUses(),
+		Uses:         invitation.Uses(),
+		ExpiresAt:    invitation.ExpiresAt(),
+		RevokedAt:    invitation.RevokedAt(),
+		CreatedAt:    invitation.CreatedAt(),
+	}
+}
@@ -0,0 +1,320 @@
+package controllers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"Gommunity/platform/subscriptions/domain/model/commands"
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/queries"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	"Gommunity/platform/subscriptions/domain/services"
+	"Gommunity/platform/subscriptions/interfaces/rest/resources"
+)
+
+type JoinRequestController struct {
+	commandService services.JoinRequestCommandService
+	queryService   services.JoinRequestQueryService
+}
+
+func NewJoinRequestController(
+	commandService services.JoinRequestCommandService,
+	queryService services.JoinRequestQueryService,
+) *JoinRequestController {
+	return &JoinRequestController{
+		commandService: commandService,
+		queryService:   queryService,
+	}
+}
+
+// @Summary Request to join a private community
+// @Description Open a pending join request for a private community. Public communities are joined directly through POST /subscriptions.
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Param request body resources.CreateJoinRequestResource false "Optional message for the community admins"
+// @Success 201 {object} resources.JoinRequestResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/join-requests [post]
+func (c *JoinRequestController) RequestToJoin(ctx *gin.Context) {
+	var req resources.CreateJoinRequestResource
+	if err := ctx.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid community ID"})
+		return
+	}
+
+	message, err := valueobjects.NewJoinRequestMessage(req.Message)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewRequestToJoinCommand(userID, communityID, message)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	joinRequestID, err := c.commandService.HandleRequest(ctx.Request.Context(), cmd)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err.Error() == "community not found" || err.Error() == "user not found" {
+			statusCode = http.StatusNotFound
+		} else if err.Error() == "user is already subscribed to this community" ||
+			err.Error() == "join request already pending for this community" ||
+			err.Error() == "community is archived" {
+			statusCode = http.StatusConflict
+		} else if err.Error() == "public communities can be joined directly" {
+			statusCode = http.StatusBadRequest
+		} else if err.Error() == "user is banned from this community" {
+			statusCode = http.StatusForbidden
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.respondWithJoinRequest(ctx, *joinRequestID, http.StatusCreated)
+}
+
+// @Summary List pending join requests of a community
+// @Description List the pending join requests of a community, oldest first. Only the community owner and admins can see them.
+// @Tags subscriptions
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Success 200 {object} resources.JoinRequestListResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/join-requests [get]
+func (c *JoinRequestController) GetPendingJoinRequests(ctx *gin.Context) {
+	requestedBy, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid community ID"})
+		return
+	}
+
+	query, err := queries.NewGetPendingJoinRequestsQuery(communityID, requestedBy)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	joinRequests, err := c.queryService.HandlePending(ctx.Request.Context(), query)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err.Error() == "community not found" {
+			statusCode = http.StatusNotFound
+		} else if err.Error() == "only community owner or admins can review join requests" {
+			statusCode = http.StatusForbidden
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	joinRequestResources := make([]resources.JoinRequestResource, 0, len(joinRequests))
+	for _, joinRequest := range joinRequests {
+		joinRequestResources = append(joinRequestResources, toJoinRequestResource(joinRequest))
+	}
+
+	ctx.JSON(http.StatusOK, resources.JoinRequestListResource{
+		JoinRequests: joinRequestResources,
+		Total:        len(joinRequestResources),
+	})
+}
+
+// @Summary Approve a join request
+// @Description Approve a pending join request. The user is subscribed to the community with the member role.
+// @Tags subscriptions
+// @Produce json
+// @Param join_request_id path string true "Join request ID"
+// @Success 200 {object} resources.JoinRequestResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/join-requests/{join_request_id}/approve [post]
+func (c *JoinRequestController) ApproveJoinRequest(ctx *gin.Context) {
+	c.review(ctx, true)
+}
+
+// @Summary Reject a join request
+// @Description Reject a pending join request
+// @Tags subscriptions
+// @Produce json
+// @Param join_request_id path string true "Join request ID"
+// @Success 200 {object} resources.JoinRequestResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/join-requests/{join_request_id}/reject [post]
+func (c *JoinRequestController) RejectJoinRequest(ctx *gin.Context) {
+	c.review(ctx, false)
+}
+
+// @Summary Withdraw a join request
+// @Description Withdraw one of your own pending join requests
+// @Tags subscriptions
+// @Produce json
+// @Param join_request_id path string true "Join request ID"
+// @Success 200 {object} resources.JoinRequestResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/join-requests/{join_request_id} [delete]
+func (c *JoinRequestController) WithdrawJoinRequest(ctx *gin.Context) {
+	requestedBy, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	joinRequestID, err := valueobjects.NewJoinRequestID(ctx.Param("join_request_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid join request ID"})
+		return
+	}
+
+	cmd, err := commands.NewWithdrawJoinRequestCommand(joinRequestID, requestedBy)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleWithdraw(ctx.Request.Context(), cmd); err != nil {
+		statusCode := http.StatusInternalServerError
+		if err.Error() == "join request not found" {
+			statusCode = http.StatusNotFound
+		} else if err.Error() == "users can only withdraw their own join requests" {
+			statusCode = http.StatusForbidden
+		} else if err.Error() == "join request is no longer pending" {
+			statusCode = http.StatusConflict
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.respondWithJoinRequest(ctx, joinRequestID, http.StatusOK)
+}
+
+// review approves or rejects the join request in the path on behalf of the authenticated user
+func (c *JoinRequestController) review(ctx *gin.Context, approve bool) {
+	reviewedBy, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	joinRequestID, err := valueobjects.NewJoinRequestID(ctx.Param("join_request_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid join request ID"})
+		return
+	}
+
+	cmd, err := commands.NewReviewJoinRequestCommand(joinRequestID, reviewedBy, approve)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleReview(ctx.Request.Context(), cmd); err != nil {
+		statusCode := http.StatusInternalServerError
+		if err.Error() == "join request not found" {
+			statusCode = http.StatusNotFound
+		} else if err.Error() == "only community owner or admins can review join requests" ||
+			err.Error() == "user is banned from this community" {
+			statusCode = http.StatusForbidden
+		} else if err.Error() == "join request is no longer pending" ||
+			err.Error() == "community is archived" {
+			statusCode = http.StatusConflict
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.respondWithJoinRequest(ctx, joinRequestID, http.StatusOK)
+}
+
+// requestingUserID reads the authenticated user from the JWT context and writes the error response when it is missing
+func requestingUserID(ctx *gin.Context) (valueobjects.UserID, bool) {
+	value, exists := ctx.Get("userID")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return valueobjects.UserID{}, false
+	}
+	userIDStr, ok := value.(string)
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "invalid user ID in context"})
+		return valueobjects.UserID{}, false
+	}
+
+	userID, err := valueobjects.NewUserID(userIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid requesting user ID"})
+		return valueobjects.UserID{}, false
+	}
+
+	return userID, true
+}
+
+// respondWithJoinRequest reloads the join request and writes it with the given status code
+func (c *JoinRequestController) respondWithJoinRequest(ctx *gin.Context, joinRequestID valueobjects.JoinRequestID, statusCode int) {
+	query, _ := queries.NewGetJoinRequestByIDQuery(joinRequestID)
+	joinRequest, err := c.queryService.HandleGetByID(ctx.Request.Context(), query)
+	if err != nil || joinRequest == nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to retrieve join request"})
+		return
+	}
+
+	ctx.JSON(statusCode, toJoinRequestResource(joinRequest))
+}
+
+func toJoinRequestResource(joinRequest *entities.JoinRequest) resources.JoinRequestResource {
+	resource := resources.JoinRequestResource{
+		JoinRequestID: joinRequest.JoinRequestID().Value(),
+		UserID:        joinRequest.UserID().Value(),
+		CommunityID:   joinRequest.CommunityID().Value(),
+		Status:        joinRequest.Status().Value(),
+		Message:       joinRequest.Message().Value(),
+		ReviewedAt:    joinRequest.ReviewedAt(),
+		CreatedAt:     joinRequest.CreatedAt(),
+		UpdatedAt:     joinRequest.UpdatedAt(),
+	}
+	if reviewedBy := joinRequest.ReviewedBy(); reviewedBy != nil {
+		value := reviewedBy.Value()
+		resource.ReviewedBy = &value
+	}
+	return resource
+}
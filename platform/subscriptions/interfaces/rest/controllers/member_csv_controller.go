@@ -0,0 +1,267 @@
+package controllers
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"Gommunity/platform/subscriptions/domain/model/commands"
+	"Gommunity/platform/subscriptions/domain/model/queries"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	"Gommunity/platform/subscriptions/domain/services"
+	"Gommunity/platform/subscriptions/interfaces/rest/resources"
+)
+
+// maxMemberImportFileSize bounds the upload; a thousand rows of usernames and roles fit well within it
+const maxMemberImportFileSize = 1 << 20
+
+type MemberCSVController struct {
+	commandService services.SubscriptionCommandService
+	queryService   services.SubscriptionQueryService
+}
+
+func NewMemberCSVController(
+	commandService services.SubscriptionCommandService,
+	queryService services.SubscriptionQueryService,
+) *MemberCSVController {
+	return &MemberCSVController{
+		commandService: commandService,
+		queryService:   queryService,
+	}
+}
+
+// @Summary Import community members from a CSV file
+// @Description Subscribe a whole list of users at once. The CSV needs a header row with a username or user_id column and an optional role column; rows without a role join as member. Each row is reported as created, already_subscribed, user_not_found, invalid_role or rejected. Only the community owner or admins can import members, and only into private communities: users join public communities themselves.
+// @Tags subscriptions
+// @Accept multipart/form-data
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Param file formData file true "CSV file, at most 1 MiB and 1000 rows"
+// @Success 200 {object} resources.MemberImportReportResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 413 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/members/import [post]
+func (c *MemberCSVController) ImportMembers(ctx *gin.Context) {
+	requestedBy, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid community ID"})
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxMemberImportFileSize)
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "the CSV file must not exceed 1 MiB"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "a CSV file is required in the file field"})
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "unable to read the uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	rows, err := parseMemberImportCSV(file)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewImportMembersCommand(communityID, rows, requestedBy)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	results, err := c.commandService.HandleImport(ctx.Request.Context(), cmd)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err.Error() == "community not found" {
+			statusCode = http.StatusNotFound
+		} else if err.Error() == "only community owner or admins can import members" ||
+			err.Error() == "members can only be imported into private communities" {
+			statusCode = http.StatusForbidden
+		} else if err.Error() == "community is archived" {
+			statusCode = http.StatusConflict
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toMemberImportReportResource(communityID, results))
+}
+
+// @Summary Export community members as CSV
+// @Description Download the members of a community as a CSV file with user_id, username, role and joined_at columns, newest members first. Only the community owner or admins can export members.
+// @Tags subscriptions
+// @Produce text/csv
+// @Param community_id path string true "Community ID"
+// @Success 200 {file} file
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/members/export [get]
+func (c *MemberCSVController) ExportMembers(ctx *gin.Context) {
+	requestedBy, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid community ID"})
+		return
+	}
+
+	query, err := queries.NewExportMembersQuery(communityID, requestedBy)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	members, err := c.queryService.HandleExport(ctx.Request.Context(), query)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err.Error() == "community not found" {
+			statusCode = http.StatusNotFound
+		} else if err.Error() == "only community owner or admins can export members" {
+			statusCode = http.StatusForbidden
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Header("Content-Type", "text/csv; charset=utf-8")
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"members-%s.csv\"", communityID.Value()))
+	ctx.Status(http.StatusOK)
+
+	writer := csv.NewWriter(ctx.Writer)
+	_ = writer.Write([]string{"user_id", "username", "role", "joined_at"})
+	for _, member := range members {
+		_ = writer.Write([]string{
+			member.UserID,
+			member.Username,
+			member.Role,
+			member.JoinedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	writer.Flush()
+}
+
+// parseMemberImportCSV reads the rows of a member import. The header row names the columns;
+// username, user_id and role are recognised in any order and other columns are ignored.
+// Reading stops as soon as the file holds more rows than an import accepts.
+func parseMemberImportCSV(r io.Reader) ([]commands.MemberImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.New("the CSV file is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+
+	columns := map[string]int{"username": -1, "user_id": -1, "role": -1}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if _, known := columns[name]; known {
+			columns[name] = i
+		}
+	}
+	if columns["username"] < 0 && columns["user_id"] < 0 {
+		return nil, errors.New("the CSV header needs a username or user_id column")
+	}
+
+	field := func(record []string, column string) string {
+		i := columns[column]
+		if i < 0 || i >= len(record) {
+			return ""
+		}
+		return record[i]
+	}
+
+	var rows []commands.MemberImportRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+
+		if len(rows) == commands.MaxMemberImportRows {
+			return nil, fmt.Errorf("import cannot contain more than %d rows", commands.MaxMemberImportRows)
+		}
+
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, commands.NewMemberImportRow(
+			line,
+			field(record, "user_id"),
+			field(record, "username"),
+			field(record, "role"),
+		))
+	}
+
+	return rows, nil
+}
+
+func toMemberImportReportResource(communityID valueobjects.CommunityID, results []services.MemberImportResult) resources.MemberImportReportResource {
+	report := resources.MemberImportReportResource{
+		CommunityID: communityID.Value(),
+		Total:       len(results),
+		Rows:        make([]resources.MemberImportRowResource, 0, len(results)),
+	}
+
+	for _, result := range results {
+		switch result.Status {
+		case services.MemberImportCreated:
+			report.Created++
+		case services.MemberImportAlreadySubscribed:
+			report.AlreadySubscribed++
+		case services.MemberImportUserNotFound:
+			report.UserNotFound++
+		case services.MemberImportInvalidRole:
+			report.InvalidRole++
+		case services.MemberImportRejected:
+			report.Rejected++
+		}
+
+		report.Rows = append(report.Rows, resources.MemberImportRowResource{
+			Line:     result.Line,
+			UserID:   result.UserID,
+			Username: result.Username,
+			Role:     result.Role,
+			Status:   string(result.Status),
+			Reason:   result.Reason,
+		})
+	}
+
+	return report
+}
@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"strings"
+	"testing"
+
+	"Gommunity/platform/subscriptions/domain/model/commands"
+)
+
+func TestParseMemberImportCSVReadsColumnsInAnyOrder(t *testing.T) {
+	input := "\ufeffRole, Username ,email\nadmin,alice,alice@example.com\n,bob,bob@example.com\n"
+
+	rows, err := parseMemberImportCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+	if rows[0].Username() != "alice" || rows[0].Role() != "admin" || rows[0].Line() != 2 {
+		t.Errorf("unexpected first row: line %d, username %q, role %q", rows[0].Line(), rows[0].Username(), rows[0].Role())
+	}
+	if rows[1].Username() != "bob" || rows[1].Role() != "" || rows[1].Line() != 3 {
+		t.Errorf("unexpected second row: line %d, username %q, role %q", rows[1].Line(), rows[1].Username(), rows[1].Role())
+	}
+}
+
+func TestParseMemberImportCSVAcceptsUserIDColumn(t *testing.T) {
+	rows, err := parseMemberImportCSV(strings.NewReader("user_id\nu-1\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rows) != 1 || rows[0].UserID() != "u-1" || rows[0].Username() != "" {
+		t.Fatalf("unexpected rows: %+v", rows)
+	}
+}
+
+func TestParseMemberImportCSVRejectsInvalidFiles(t *testing.T) {
+	cases := map[string]struct {
+		input string
+		err   string
+	}{
+		"empty file":         {input: "", err: "the CSV file is empty"},
+		"no identity column": {input: "email,role\na@example.com,member\n", err: "the CSV header needs a username or user_id column"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseMemberImportCSV(strings.NewReader(tc.input))
+			if err == nil || err.Error() != tc.err {
+				t.Fatalf("expected %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestParseMemberImportCSVStopsAtRowLimit(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("username\n")
+	for i := 0; i < commands.MaxMemberImportRows; i++ {
+		b.WriteString("user\n")
+	}
+
+	rows, err := parseMemberImportCSV(strings.NewReader(b.String()))
+	if err != nil {
+		t.Fatalf("a file at the limit should be accepted: %v", err)
+	}
+	if len(rows) != commands.MaxMemberImportRows {
+		t.Fatalf("expected %d rows, got %d", commands.MaxMemberImportRows, len(rows))
+	}
+
+	b.WriteString("one-too-many\n")
+	if _, err := parseMemberImportCSV(strings.NewReader(b.String())); err == nil {
+		t.Fatal("expected an error past the row limit")
+	}
+}
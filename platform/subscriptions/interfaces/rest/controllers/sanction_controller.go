@@ -0,0 +1,291 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"Gommunity/platform/subscriptions/domain/model/commands"
+	"Gommunity/platform/subscriptions/domain/model/entities"
+	"Gommunity/platform/subscriptions/domain/model/queries"
+	"Gommunity/platform/subscriptions/domain/model/valueobjects"
+	"Gommunity/platform/subscriptions/domain/services"
+	"Gommunity/platform/subscriptions/interfaces/rest/resources"
+)
+
+type SanctionController struct {
+	commandService services.SanctionCommandService
+	queryService   services.SanctionQueryService
+}
+
+func NewSanctionController(
+	commandService services.SanctionCommandService,
+	queryService services.SanctionQueryService,
+) *SanctionController {
+	return &SanctionController{
+		commandService: commandService,
+		queryService:   queryService,
+	}
+}
+
+// @Summary Ban a user from a community
+// @Description Ban a user so they cannot subscribe again until the ban expires or is lifted. A current member is removed from the community. Only the community owner and admins can ban; admins can ban other admins only when the owner allows it.
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Param request body resources.BanMemberResource true "Ban request"
+// @Success 201 {object} resources.SanctionResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/bans [post]
+func (c *SanctionController) BanMember(ctx *gin.Context) {
+	var req resources.BanMemberResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.sanction(ctx, valueobjects.BanSanction, req.UserID, req.Reason, req.ExpiresAt)
+}
+
+// @Summary Mute a community member
+// @Description Mute a member until the given time. Muted members stay subscribed but cannot post, comment or react. Only the community owner and admins can mute; admins can mute other admins only when the owner allows it.
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Param request body resources.MuteMemberResource true "Mute request"
+// @Success 201 {object} resources.SanctionResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/mutes [post]
+func (c *SanctionController) MuteMember(ctx *gin.Context) {
+	var req resources.MuteMemberResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.sanction(ctx, valueobjects.MuteSanction, req.UserID, req.Reason, &req.Until)
+}
+
+// @Summary List the bans of a community
+// @Description List the bans in force in a community, newest first. Only the community owner and admins can see them.
+// @Tags subscriptions
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Success 200 {object} resources.SanctionListResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/bans [get]
+func (c *SanctionController) GetBans(ctx *gin.Context) {
+	c.list(ctx, valueobjects.BanSanction)
+}
+
+// @Summary List the mutes of a community
+// @Description List the mutes in force in a community, newest first. Only the community owner and admins can see them.
+// @Tags subscriptions
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Success 200 {object} resources.SanctionListResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/mutes [get]
+func (c *SanctionController) GetMutes(ctx *gin.Context) {
+	c.list(ctx, valueobjects.MuteSanction)
+}
+
+// @Summary Lift a ban
+// @Description Lift a user's ban so they can subscribe again
+// @Tags subscriptions
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Param user_id path string true "User ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/bans/{user_id} [delete]
+func (c *SanctionController) LiftBan(ctx *gin.Context) {
+	c.lift(ctx, valueobjects.BanSanction)
+}
+
+// @Summary Lift a mute
+// @Description Lift a member's mute so they can post, comment and react again
+// @Tags subscriptions
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Param user_id path string true "User ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/mutes/{user_id} [delete]
+func (c *SanctionController) LiftMute(ctx *gin.Context) {
+	c.lift(ctx, valueobjects.MuteSanction)
+}
+
+// sanction issues a ban or a mute in the community in the path on behalf of the authenticated user
+func (c *SanctionController) sanction(ctx *gin.Context, sanctionType valueobjects.SanctionType, targetUserID string, reasonValue string, expiresAt *time.Time) {
+	issuedBy, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid community ID"})
+		return
+	}
+
+	userID, err := valueobjects.NewUserID(targetUserID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	reason, err := valueobjects.NewSanctionReason(reasonValue)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewSanctionMemberCommand(communityID, userID, issuedBy, sanctionType, reason, expiresAt)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	sanction, err := c.commandService.HandleSanction(ctx.Request.Context(), cmd)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch err.Error() {
+		case "community not found", "user not found":
+			statusCode = http.StatusNotFound
+		case "users cannot ban or mute themselves", "only community members can be muted",
+			"sanction end time must be in the future":
+			statusCode = http.StatusBadRequest
+		case "the community owner cannot be banned or muted",
+			"only community owner or admins can ban or mute members",
+			"only the community owner, or admins when the owner allows it, can manage admins":
+			statusCode = http.StatusForbidden
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, toSanctionResource(sanction))
+}
+
+// list writes the bans or mutes in force in the community in the path
+func (c *SanctionController) list(ctx *gin.Context, sanctionType valueobjects.SanctionType) {
+	requestedBy, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid community ID"})
+		return
+	}
+
+	query, err := queries.NewGetActiveSanctionsQuery(communityID, requestedBy, sanctionType)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	sanctions, err := c.queryService.HandleGetActive(ctx.Request.Context(), query)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err.Error() == "community not found" {
+			statusCode = http.StatusNotFound
+		} else if err.Error() == "only community owner or admins can ban or mute members" {
+			statusCode = http.StatusForbidden
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	sanctionResources := make([]resources.SanctionResource, 0, len(sanctions))
+	for _, sanction := range sanctions {
+		sanctionResources = append(sanctionResources, toSanctionResource(sanction))
+	}
+
+	ctx.JSON(http.StatusOK, resources.SanctionListResource{
+		Sanctions: sanctionResources,
+		Total:     len(sanctionResources),
+	})
+}
+
+// lift ends the ban or mute of the user in the path
+func (c *SanctionController) lift(ctx *gin.Context, sanctionType valueobjects.SanctionType) {
+	requestedBy, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid community ID"})
+		return
+	}
+
+	userID, err := valueobjects.NewUserID(ctx.Param("user_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	cmd, err := commands.NewLiftSanctionCommand(communityID, userID, requestedBy, sanctionType)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleLift(ctx.Request.Context(), cmd); err != nil {
+		statusCode := http.StatusInternalServerError
+		switch err.Error() {
+		case "community not found", "user is not banned from this community", "user is not muted in this community":
+			statusCode = http.StatusNotFound
+		case "only community owner or admins can ban or mute members":
+			statusCode = http.StatusForbidden
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func toSanctionResource(sanction *entities.Sanction) resources.SanctionResource {
+	return resources.SanctionResource{
+		SanctionID:  sanction.SanctionID().Value(),
+		UserID:      sanction.UserID().Value(),
+		CommunityID: sanction.CommunityID().Value(),
+		Type:        sanction.Type().Value(),
+		Reason:      sanction.Reason().Value(),
+		IssuedBy:    sanction.IssuedBy().Value(),
+		ExpiresAt:   sanction.ExpiresAt(),
+		CreatedAt:   sanction.CreatedAt(),
+	}
+}
@@ -2,11 +2,13 @@ package controllers
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 
 	"Gommunity/platform/subscriptions/application/outboundservices/acl"
 	"Gommunity/platform/subscriptions/domain/model/commands"
+	"Gommunity/platform/subscriptions/domain/model/entities"
 	"Gommunity/platform/subscriptions/domain/model/queries"
 	"Gommunity/platform/subscriptions/domain/model/valueobjects"
 	"Gommunity/platform/subscriptions/domain/services"
@@ -32,7 +34,7 @@ func NewSubscriptionController(
 }
 
 // @Summary Subscribe a user to a community
-// @Description Subscribe a user to a community with a specific role. IMPORTANT: Self-subscriptions (following a community) always receive 'member' role regardless of requested role. In public communities, users can only subscribe themselves. In private communities, owner/admin can add users by username and assign any role.
+// @Description Subscribe a user to a community with a specific role. IMPORTANT: Self-subscriptions (following a community) always receive 'member' role regardless of requested role. In public communities, users can only subscribe themselves. In private communities, owner/admin can add users by username and assign any role; other users have to open a join request. Users added by someone else can be given an expires_at date: the membership is then removed on that date, or moved to alumni_role when set.
 // @Tags subscriptions
 // @Accept json
 // @Produce json
@@ -84,6 +86,10 @@ func (c *SubscriptionController) SubscribeUser(ctx *gin.Context) {
 		// Username provided - fetch user ID from Users BC
 		userIDPtr, err := c.externalUsersService.FetchUserIDByUsername(ctx.Request.Context(), *req.Username)
 		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to find user by username"})
+			return
+		}
+		if userIDPtr == nil {
 			ctx.JSON(http.StatusNotFound, gin.H{"error": "user not found with the provided username"})
 			return
 		}
@@ -113,16 +119,43 @@ func (c *SubscriptionController) SubscribeUser(ctx *gin.Context) {
 		return
 	}
 
+	if req.ExpiresAt != nil {
+		var alumniRole *valueobjects.CommunityRole
+		if req.AlumniRole != nil {
+			parsed, err := valueobjects.NewCommunityRole(*req.AlumniRole)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			alumniRole = &parsed
+		}
+		cmd = cmd.WithExpiry(*req.ExpiresAt, alumniRole)
+	} else if req.AlumniRole != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "alumni_role requires expires_at"})
+		return
+	}
+
 	// Execute command
 	_, err = c.commandService.Handle(ctx.Request.Context(), cmd)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
-		if err.Error() == "community not found" || err.Error() == "user not found" {
+		if err.Error() == "community not found" || err.Error() == "user not found" ||
+			err.Error() == "role not found in this community" {
 			statusCode = http.StatusNotFound
-		} else if err.Error() == "user is already subscribed to this community" {
+		} else if err.Error() == "user is already subscribed to this community" ||
+			err.Error() == "community is archived" {
 			statusCode = http.StatusConflict
+		} else if err.Error() == "expiry date must be in the future" ||
+			err.Error() == "alumni role must be member or a custom role" ||
+			err.Error() == "the community owner's membership cannot expire" {
+			statusCode = http.StatusBadRequest
 		} else if err.Error() == "only community owner or admins can add users to private communities" ||
-			err.Error() == "users can only subscribe themselves to public communities" {
+			err.Error() == "users can only subscribe themselves to public communities" ||
+			err.Error() == "private communities require an approved join request" ||
+			err.Error() == "users cannot set an expiry on their own membership" ||
+			err.Error() == "user is banned from this community" ||
+			err.Error() == "the owner role cannot be granted to other users" ||
+			err.Error() == "only the community owner, or admins when the owner allows it, can manage admins" {
 			statusCode = http.StatusForbidden
 		}
 		ctx.JSON(statusCode, gin.H{"error": err.Error()})
@@ -137,14 +170,7 @@ func (c *SubscriptionController) SubscribeUser(ctx *gin.Context) {
 		return
 	}
 
-	response := resources.SubscriptionResource{
-		SubscriptionID: subscription.SubscriptionID().Value(),
-		UserID:         subscription.UserID().Value(),
-		CommunityID:    subscription.CommunityID().Value(),
-		Role:           subscription.Role().Value(),
-		CreatedAt:      subscription.CreatedAt(),
-		UpdatedAt:      subscription.UpdatedAt(),
-	}
+	response := toSubscriptionResource(subscription)
 
 	ctx.JSON(http.StatusCreated, response)
 }
@@ -224,6 +250,167 @@ func (c *SubscriptionController) UnsubscribeUser(ctx *gin.Context) {
 	ctx.Status(http.StatusNoContent)
 }
 
+// @Summary Change the role of a community member
+// @Description Promote a member to admin or demote an admin to member. The owner can change any role except their own; admins can change members' roles, and other admins' roles only when the owner allows it. Ownership cannot be assigned here.
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Param user_id path string true "User ID"
+// @Param request body resources.ChangeMemberRoleResource true "New role"
+// @Success 200 {object} resources.SubscriptionResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/users/{user_id}/role [patch]
+func (c *SubscriptionController) ChangeMemberRole(ctx *gin.Context) {
+	var req resources.ChangeMemberRoleResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	requestedBy, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	userID, err := valueobjects.NewUserID(ctx.Param("user_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid community ID"})
+		return
+	}
+
+	role, err := valueobjects.NewCommunityRole(req.Role)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd, err := commands.NewChangeMemberRoleCommand(userID, communityID, role, requestedBy)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.commandService.HandleChangeRole(ctx.Request.Context(), cmd); err != nil {
+		statusCode := http.StatusInternalServerError
+		if err.Error() == "community not found" || err.Error() == "subscription not found" ||
+			err.Error() == "role not found in this community" {
+			statusCode = http.StatusNotFound
+		} else if err.Error() == "the owner role cannot be assigned through a role change" {
+			statusCode = http.StatusBadRequest
+		} else if err.Error() == "only community owner or admins can change member roles" ||
+			err.Error() == "only the community owner, or admins when the owner allows it, can manage admins" ||
+			err.Error() == "users can only change their own role to member" ||
+			err.Error() == "the community owner's role cannot be changed" {
+			statusCode = http.StatusForbidden
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	query, _ := queries.NewGetSubscriptionByUserAndCommunityQuery(userID, communityID)
+	subscription, err := c.queryService.Handle(ctx.Request.Context(), query)
+	if err != nil || subscription == nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "failed to retrieve updated subscription"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toSubscriptionResource(subscription))
+}
+
+// @Summary Extend expiring memberships in bulk
+// @Description Move the expiry of several memberships to a new date, for example when a course runs longer. Memberships that do not expire are left untouched. Each user is reported as extended, not_subscribed or no_expiry. Only the community owner can extend memberships.
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Param community_id path string true "Community ID"
+// @Param request body resources.ExtendMembershipsResource true "Members and new expiry date"
+// @Success 200 {object} resources.MembershipExtensionReportResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/communities/{community_id}/members/extend [post]
+func (c *SubscriptionController) ExtendMemberships(ctx *gin.Context) {
+	requestedBy, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req resources.ExtendMembershipsResource
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	communityID, err := valueobjects.NewCommunityID(ctx.Param("community_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid community ID"})
+		return
+	}
+
+	userIDs := make([]valueobjects.UserID, 0, len(req.UserIDs))
+	for _, value := range req.UserIDs {
+		userID, err := valueobjects.NewUserID(value)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+			return
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	cmd, err := commands.NewExtendMembershipsCommand(communityID, userIDs, req.ExpiresAt, requestedBy)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	results, err := c.commandService.HandleExtend(ctx.Request.Context(), cmd)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if err.Error() == "community not found" {
+			statusCode = http.StatusNotFound
+		} else if err.Error() == "only the community owner can extend memberships" {
+			statusCode = http.StatusForbidden
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	report := resources.MembershipExtensionReportResource{
+		CommunityID: communityID.Value(),
+		ExpiresAt:   cmd.ExpiresAt(),
+		Users:       make([]resources.MembershipExtensionUserResource, 0, len(results)),
+	}
+	for _, result := range results {
+		switch result.Status {
+		case services.MembershipExtended:
+			report.Extended++
+		case services.MembershipNotSubscribed:
+			report.NotSubscribed++
+		case services.MembershipWithoutExpiry:
+			report.NoExpiry++
+		}
+		report.Users = append(report.Users, resources.MembershipExtensionUserResource{
+			UserID: result.UserID,
+			Status: string(result.Status),
+		})
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
 // @Summary Get subscription count for a community
 // @Description Get the total number of subscriptions for a specific community
 // @Tags subscriptions
@@ -265,13 +452,17 @@ func (c *SubscriptionController) GetSubscriptionCount(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
-// @Summary Get all subscriptions for a community
-// @Description Get all subscriptions for a specific community with optional pagination
+// @Summary Browse the member directory of a community
+// @Description List the members of a community with their username and profile and banner pictures. Members can be searched by username prefix, filtered by role and sorted by join date (newest or oldest) or username. Pages hold 20 members by default and at most 100. Pages sorted by join date continue with the returned cursor; searches and username sorting continue with the returned offset.
 // @Tags subscriptions
 // @Produce json
 // @Param community_id path string true "Community ID"
-// @Param limit query int false "Limit"
-// @Param offset query int false "Offset"
+// @Param q query string false "Username prefix, case insensitive"
+// @Param role query string false "Role: member, admin, owner or a custom role"
+// @Param sort query string false "Sort order: newest (default), oldest or username"
+// @Param limit query int false "Limit" minimum(1) maximum(100)
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param offset query int false "Offset returned by the previous page"
 // @Success 200 {object} resources.SubscriptionListResource
 // @Failure 400 {object} map[string]string
 // @Failure 500 {object} map[string]string
@@ -294,37 +485,145 @@ func (c *SubscriptionController) GetAllSubscriptionsByCommunity(ctx *gin.Context
 	}
 
 	// Handle pagination parameters
-	// Note: For simplicity, pagination is not implemented in this version
-	// You can add strconv.Atoi() to parse limit/offset query params if needed
+	limit := queries.DefaultMemberPageSize
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 || limit > queries.MaxMemberPageSize {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 100"})
+			return
+		}
+	}
+
+	offset := 0
+	if offsetStr := ctx.Query("offset"); offsetStr != "" {
+		offset, err = strconv.Atoi(offsetStr)
+		if err != nil || offset < 0 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "offset must be zero or positive"})
+			return
+		}
+	}
+
+	query = query.WithPagination(limit, offset)
+
+	if cursorStr := ctx.Query("cursor"); cursorStr != "" {
+		cursor, err := valueobjects.ParseCursor(cursorStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		query = query.WithCursor(cursor)
+	}
+
+	// Handle directory filters
+	if prefix := ctx.Query("q"); prefix != "" {
+		query = query.WithUsernamePrefix(prefix)
+	}
+
+	if roleStr := ctx.Query("role"); roleStr != "" {
+		role, err := valueobjects.NewCommunityRole(roleStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		query = query.WithRole(role)
+	}
+
+	if sortStr := ctx.Query("sort"); sortStr != "" {
+		sort, err := valueobjects.NewMemberSort(sortStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		query = query.WithSort(sort)
+	}
 
 	// Execute query
-	subscriptions, err := c.queryService.HandleAll(ctx.Request.Context(), query)
+	page, err := c.queryService.HandleAll(ctx.Request.Context(), query)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
 	// Transform to resources
-	subscriptionResources := make([]resources.SubscriptionResource, 0, len(subscriptions))
-	for _, sub := range subscriptions {
-		subscriptionResources = append(subscriptionResources, resources.SubscriptionResource{
+	memberResources := make([]resources.MemberResource, 0, len(page.Members))
+	for _, member := range page.Members {
+		sub := member.Subscription
+		memberResources = append(memberResources, resources.MemberResource{
 			SubscriptionID: sub.SubscriptionID().Value(),
 			UserID:         sub.UserID().Value(),
+			Username:       member.Username,
+			ProfileURL:     member.ProfileURL,
+			BannerURL:      member.BannerURL,
 			CommunityID:    sub.CommunityID().Value(),
 			Role:           sub.Role().Value(),
+			ExpiresAt:      sub.ExpiresAt(),
+			AlumniRole:     alumniRoleValue(sub),
 			CreatedAt:      sub.CreatedAt(),
 			UpdatedAt:      sub.UpdatedAt(),
 		})
 	}
 
 	response := resources.SubscriptionListResource{
-		Subscriptions: subscriptionResources,
-		Total:         len(subscriptionResources),
+		Subscriptions: memberResources,
+		Total:         len(memberResources),
+		NextOffset:    page.NextOffset,
+	}
+	if page.NextCursor != nil {
+		response.NextCursor = page.NextCursor.Encode()
 	}
 
 	ctx.JSON(http.StatusOK, response)
 }
 
+// @Summary List my subscriptions
+// @Description List the communities the authenticated user belongs to, newest first, with the community name and icon, the user's role, the join date and the member count
+// @Tags subscriptions
+// @Produce json
+// @Success 200 {object} resources.MySubscriptionListResource
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/subscriptions/me [get]
+func (c *SubscriptionController) GetMySubscriptions(ctx *gin.Context) {
+	userID, ok := requestingUserID(ctx)
+	if !ok {
+		return
+	}
+
+	query, err := queries.NewGetSubscriptionsByUserQuery(userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	memberships, err := c.queryService.HandleGetByUser(ctx.Request.Context(), query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	subscriptionResources := make([]resources.MySubscriptionResource, 0, len(memberships))
+	for _, membership := range memberships {
+		subscription := membership.Subscription
+		subscriptionResources = append(subscriptionResources, resources.MySubscriptionResource{
+			SubscriptionID: subscription.SubscriptionID().Value(),
+			CommunityID:    subscription.CommunityID().Value(),
+			CommunityName:  membership.CommunityName,
+			IconURL:        membership.IconURL,
+			IsPrivate:      membership.IsPrivate,
+			Role:           subscription.Role().Value(),
+			MemberCount:    membership.MemberCount,
+			JoinedAt:       subscription.CreatedAt(),
+			ExpiresAt:      subscription.ExpiresAt(),
+		})
+	}
+
+	ctx.JSON(http.StatusOK, resources.MySubscriptionListResource{
+		Subscriptions: subscriptionResources,
+		Total:         len(subscriptionResources),
+	})
+}
+
 // @Summary Get subscription by user and community
 // @Description Get a specific subscription for a user in a community
 // @Tags subscriptions
@@ -372,14 +671,29 @@ func (c *SubscriptionController) GetSubscriptionByUserAndCommunity(ctx *gin.Cont
 		return
 	}
 
-	response := resources.SubscriptionResource{
+	response := toSubscriptionResource(subscription)
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func toSubscriptionResource(subscription *entities.Subscription) resources.SubscriptionResource {
+	return resources.SubscriptionResource{
 		SubscriptionID: subscription.SubscriptionID().Value(),
 		UserID:         subscription.UserID().Value(),
 		CommunityID:    subscription.CommunityID().Value(),
 		Role:           subscription.Role().Value(),
+		ExpiresAt:      subscription.ExpiresAt(),
+		AlumniRole:     alumniRoleValue(subscription),
 		CreatedAt:      subscription.CreatedAt(),
 		UpdatedAt:      subscription.UpdatedAt(),
 	}
+}
 
-	ctx.JSON(http.StatusOK, response)
+// alumniRoleValue returns the alumni role of an expiring membership, or nil when there is none
+func alumniRoleValue(subscription *entities.Subscription) *string {
+	if subscription.AlumniRole() == nil {
+		return nil
+	}
+	value := subscription.AlumniRole().Value()
+	return &value
 }
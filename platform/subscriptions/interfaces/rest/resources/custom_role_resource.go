@@ -0,0 +1,32 @@
+package resources
+
+import "time"
+
+// CustomRoleResource represents a custom community role in the REST API
+type CustomRoleResource struct {
+	CustomRoleID string    `json:"custom_role_id" example:"507f1f77bcf86cd799439017"`
+	CommunityID  string    `json:"community_id" example:"507f1f77bcf86cd799439012"`
+	Name         string    `json:"name" example:"teaching_assistant"`
+	Permissions  []string  `json:"permissions" example:"publish_post,pin_post"`
+	CreatedBy    string    `json:"created_by" example:"507f1f77bcf86cd799439011"`
+	CreatedAt    time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt    time.Time `json:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// CreateCustomRoleResource represents the request to define a custom role.
+// Permissions are any of publish_post, publish_announcement, delete_any_post, manage_members, pin_post and moderate_reactions.
+type CreateCustomRoleResource struct {
+	Name        string   `json:"name" example:"teaching_assistant" validate:"required"`
+	Permissions []string `json:"permissions" example:"publish_post,pin_post"`
+}
+
+// UpdateCustomRoleResource represents the request to replace the permissions of a custom role
+type UpdateCustomRoleResource struct {
+	Permissions []string `json:"permissions" example:"publish_post,pin_post,delete_any_post"`
+}
+
+// CustomRoleListResource represents the custom roles of a community
+type CustomRoleListResource struct {
+	Roles []CustomRoleResource `json:"roles"`
+	Total int                  `json:"total" example:"1"`
+}
@@ -0,0 +1,38 @@
+package resources
+
+import "time"
+
+// InvitationResource represents an invitation link in the REST API
+type InvitationResource struct {
+	InvitationID string     `json:"invitation_id" example:"507f1f77bcf86cd799439015"`
+	CommunityID  string     `json:"community_id" example:"507f1f77bcf86cd799439012"`
+	CreatedBy    string     `json:"created_by" example:"507f1f77bcf86cd799439011"`
+	Role         string     `json:"role" example:"member"`
+	Token        string     `json:"token" example:"507f1f77bcf86cd799439015.q3Vx0YzS8f0mHqQb3cV6nS2J3aQ0oK2i9F1fX8l0T3E"`
+	Status       string     `json:"status" example:"active"`
+	MaxUses      int        `json:"max_uses" example:"25"`
+	Uses         int        `json:"uses" example:"3"`
+	ExpiresAt    time.Time  `json:"expires_at" example:"2023-01-08T00:00:00Z"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty" example:"2023-01-02T00:00:00Z"`
+	CreatedAt    time.Time  `json:"created_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// CreateInvitationResource represents the request to create an invitation link.
+// The expiry defaults to the configured invitation lifetime and max uses defaults to 1.
+// Role is member, admin or the name of a custom role defined in the community.
+type CreateInvitationResource struct {
+	Role      string     `json:"role" example:"member" validate:"required"`
+	MaxUses   *int       `json:"max_uses,omitempty" example:"25" validate:"omitempty,min=1,max=1000"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2023-01-08T00:00:00Z"`
+}
+
+// AcceptInvitationResource represents the request to join a community through an invitation
+type AcceptInvitationResource struct {
+	Token string `json:"token" example:"507f1f77bcf86cd799439015.q3Vx0YzS8f0mHqQb3cV6nS2J3aQ0oK2i9F1fX8l0T3E" validate:"required"`
+}
+
+// InvitationListResource represents a list of invitations
+type InvitationListResource struct {
+	Invitations []InvitationResource `json:"invitations"`
+	Total       int                  `json:"total" example:"2"`
+}
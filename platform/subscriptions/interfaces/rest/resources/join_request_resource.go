@@ -0,0 +1,27 @@
+package resources
+
+import "time"
+
+// JoinRequestResource represents a join request in the REST API
+type JoinRequestResource struct {
+	JoinRequestID string     `json:"join_request_id" example:"507f1f77bcf86cd799439014"`
+	UserID        string     `json:"user_id" example:"507f1f77bcf86cd799439013"`
+	CommunityID   string     `json:"community_id" example:"507f1f77bcf86cd799439012"`
+	Status        string     `json:"status" example:"pending"`
+	Message       string     `json:"message,omitempty" example:"I'm in Prof. Smith's Monday class"`
+	ReviewedBy    *string    `json:"reviewed_by,omitempty" example:"507f1f77bcf86cd799439011"`
+	ReviewedAt    *time.Time `json:"reviewed_at,omitempty" example:"2023-01-02T00:00:00Z"`
+	CreatedAt     time.Time  `json:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt     time.Time  `json:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// CreateJoinRequestResource represents the request to join a private community
+type CreateJoinRequestResource struct {
+	Message string `json:"message,omitempty" example:"I'm in Prof. Smith's Monday class" validate:"max=500"`
+}
+
+// JoinRequestListResource represents a list of join requests
+type JoinRequestListResource struct {
+	JoinRequests []JoinRequestResource `json:"join_requests"`
+	Total        int                   `json:"total" example:"3"`
+}
@@ -0,0 +1,35 @@
+package resources
+
+import "time"
+
+// SanctionResource represents a ban or a mute in the REST API
+type SanctionResource struct {
+	SanctionID  string     `json:"sanction_id" example:"507f1f77bcf86cd799439016"`
+	UserID      string     `json:"user_id" example:"507f1f77bcf86cd799439013"`
+	CommunityID string     `json:"community_id" example:"507f1f77bcf86cd799439012"`
+	Type        string     `json:"type" example:"ban"`
+	Reason      string     `json:"reason,omitempty" example:"Repeated spam after warnings"`
+	IssuedBy    string     `json:"issued_by" example:"507f1f77bcf86cd799439011"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty" example:"2023-01-08T00:00:00Z"`
+	CreatedAt   time.Time  `json:"created_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// BanMemberResource represents the request to ban a user. Without expires_at the ban lasts until it is lifted.
+type BanMemberResource struct {
+	UserID    string     `json:"user_id" example:"507f1f77bcf86cd799439013" validate:"required"`
+	Reason    string     `json:"reason,omitempty" example:"Repeated spam after warnings" validate:"max=500"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2023-01-08T00:00:00Z"`
+}
+
+// MuteMemberResource represents the request to mute a member until the given time
+type MuteMemberResource struct {
+	UserID string    `json:"user_id" example:"507f1f77bcf86cd799439013" validate:"required"`
+	Reason string    `json:"reason,omitempty" example:"Cool down after a heated thread" validate:"max=500"`
+	Until  time.Time `json:"until" example:"2023-01-02T00:00:00Z" validate:"required"`
+}
+
+// SanctionListResource represents a list of bans or mutes
+type SanctionListResource struct {
+	Sanctions []SanctionResource `json:"sanctions"`
+	Total     int                `json:"total" example:"2"`
+}
@@ -2,22 +2,30 @@ package resources
 
 import "time"
 
-// SubscriptionResource represents a subscription in the REST API
+// SubscriptionResource represents a subscription in the REST API.
+// ExpiresAt and AlumniRole are only present on memberships that expire.
 type SubscriptionResource struct {
-	SubscriptionID string    `json:"subscription_id" example:"507f1f77bcf86cd799439011"`
-	UserID         string    `json:"user_id" example:"507f1f77bcf86cd799439013"`
-	CommunityID    string    `json:"community_id" example:"507f1f77bcf86cd799439012"`
-	Role           string    `json:"role" example:"member"`
-	CreatedAt      time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`
-	UpdatedAt      time.Time `json:"updated_at" example:"2023-01-01T00:00:00Z"`
+	SubscriptionID string     `json:"subscription_id" example:"507f1f77bcf86cd799439011"`
+	UserID         string     `json:"user_id" example:"507f1f77bcf86cd799439013"`
+	CommunityID    string     `json:"community_id" example:"507f1f77bcf86cd799439012"`
+	Role           string     `json:"role" example:"member"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty" example:"2024-06-30T00:00:00Z"`
+	AlumniRole     *string    `json:"alumni_role,omitempty" example:"alumni"`
+	CreatedAt      time.Time  `json:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt      time.Time  `json:"updated_at" example:"2023-01-01T00:00:00Z"`
 }
 
-// SubscribeUserResource represents the request to subscribe a user to a community
+// SubscribeUserResource represents the request to subscribe a user to a community.
+// Role is member, admin, owner or the name of a custom role defined in the community.
+// A user added by someone else can be given an expiry; on that date the membership is removed,
+// or moved to AlumniRole when set (member or a custom role).
 type SubscribeUserResource struct {
-	UserID      *string `json:"user_id,omitempty" example:"507f1f77bcf86cd799439013"`
-	Username    *string `json:"username,omitempty" example:"john_doe" validate:"omitempty,min=3,max=50"`
-	CommunityID string  `json:"community_id" example:"507f1f77bcf86cd799439012" validate:"required"`
-	Role        string  `json:"role" example:"member" validate:"required,oneof=member admin owner"`
+	UserID      *string    `json:"user_id,omitempty" example:"507f1f77bcf86cd799439013"`
+	Username    *string    `json:"username,omitempty" example:"john_doe" validate:"omitempty,min=3,max=50"`
+	CommunityID string     `json:"community_id" example:"507f1f77bcf86cd799439012" validate:"required"`
+	Role        string     `json:"role" example:"member" validate:"required"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty" example:"2024-06-30T00:00:00Z"`
+	AlumniRole  *string    `json:"alumni_role,omitempty" example:"alumni"`
 }
 
 // UnsubscribeUserResource represents the request to unsubscribe a user from a community
@@ -26,14 +34,103 @@ type UnsubscribeUserResource struct {
 	CommunityID string `json:"community_id" example:"507f1f77bcf86cd799439012" validate:"required"`
 }
 
+// ChangeMemberRoleResource represents the request to change the role of a subscribed user.
+// Role is member, admin or the name of a custom role defined in the community.
+type ChangeMemberRoleResource struct {
+	Role string `json:"role" example:"admin" validate:"required"`
+}
+
 // SubscriptionCountResource represents the subscription count for a community
 type SubscriptionCountResource struct {
 	CommunityID string `json:"community_id" example:"507f1f77bcf86cd799439012"`
 	Count       int64  `json:"count" example:"150"`
 }
 
-// SubscriptionListResource represents a list of subscriptions
+// MemberResource represents a subscription in the member directory, with the profile of its user
+type MemberResource struct {
+	SubscriptionID string     `json:"subscription_id" example:"507f1f77bcf86cd799439011"`
+	UserID         string     `json:"user_id" example:"507f1f77bcf86cd799439013"`
+	Username       string     `json:"username,omitempty" example:"john_doe"`
+	ProfileURL     *string    `json:"profile_url,omitempty" example:"https://example.com/profile.png"`
+	BannerURL      *string    `json:"banner_url,omitempty" example:"https://example.com/banner.png"`
+	CommunityID    string     `json:"community_id" example:"507f1f77bcf86cd799439012"`
+	Role           string     `json:"role" example:"member"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty" example:"2024-06-30T00:00:00Z"`
+	AlumniRole     *string    `json:"alumni_role,omitempty" example:"alumni"`
+	CreatedAt      time.Time  `json:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt      time.Time  `json:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// SubscriptionListResource represents one page of the member directory.
+// Pages listed by join date continue with next_cursor; searches and username sorting continue with next_offset.
 type SubscriptionListResource struct {
-	Subscriptions []SubscriptionResource `json:"subscriptions"`
-	Total         int                    `json:"total" example:"150"`
+	Subscriptions []MemberResource `json:"subscriptions"`
+	Total         int              `json:"total" example:"20"`
+	NextCursor    string           `json:"next_cursor,omitempty" example:"MTczNjY4MzIwMDo1MDdmMWY3N2JjZjg2Y2Q3OTk0MzkwMTE"`
+	NextOffset    *int             `json:"next_offset,omitempty" example:"20"`
+}
+
+// MySubscriptionResource represents a community the requesting user belongs to
+type MySubscriptionResource struct {
+	SubscriptionID string     `json:"subscription_id" example:"507f1f77bcf86cd799439011"`
+	CommunityID    string     `json:"community_id" example:"507f1f77bcf86cd799439012"`
+	CommunityName  string     `json:"community_name" example:"Go Study Group"`
+	IconURL        *string    `json:"icon_url,omitempty" example:"https://example.com/icon.png"`
+	IsPrivate      bool       `json:"is_private" example:"false"`
+	Role           string     `json:"role" example:"member"`
+	MemberCount    int64      `json:"member_count" example:"150"`
+	JoinedAt       time.Time  `json:"joined_at" example:"2023-01-01T00:00:00Z"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty" example:"2024-06-30T00:00:00Z"`
+}
+
+// MySubscriptionListResource represents the communities the requesting user belongs to
+type MySubscriptionListResource struct {
+	Subscriptions []MySubscriptionResource `json:"subscriptions"`
+	Total         int                      `json:"total" example:"3"`
+}
+
+// MemberImportRowResource reports the outcome of one row of a member import.
+// Status is created, already_subscribed, user_not_found, invalid_role or rejected.
+type MemberImportRowResource struct {
+	Line     int    `json:"line" example:"2"`
+	UserID   string `json:"user_id,omitempty" example:"507f1f77bcf86cd799439013"`
+	Username string `json:"username,omitempty" example:"john_doe"`
+	Role     string `json:"role,omitempty" example:"member"`
+	Status   string `json:"status" example:"created"`
+	Reason   string `json:"reason,omitempty" example:"user is banned from this community"`
+}
+
+// MemberImportReportResource represents the per-row report of a member import
+type MemberImportReportResource struct {
+	CommunityID       string                    `json:"community_id" example:"507f1f77bcf86cd799439012"`
+	Total             int                       `json:"total" example:"30"`
+	Created           int                       `json:"created" example:"27"`
+	AlreadySubscribed int                       `json:"already_subscribed" example:"1"`
+	UserNotFound      int                       `json:"user_not_found" example:"1"`
+	InvalidRole       int                       `json:"invalid_role" example:"1"`
+	Rejected          int                       `json:"rejected" example:"0"`
+	Rows              []MemberImportRowResource `json:"rows"`
+}
+
+// ExtendMembershipsResource represents the request to move the expiry of several memberships to a new date
+type ExtendMembershipsResource struct {
+	UserIDs   []string  `json:"user_ids" validate:"required" example:"507f1f77bcf86cd799439013,507f1f77bcf86cd799439014"`
+	ExpiresAt time.Time `json:"expires_at" validate:"required" example:"2024-12-31T00:00:00Z"`
+}
+
+// MembershipExtensionUserResource reports the outcome of extending the membership of one user.
+// Status is extended, not_subscribed or no_expiry.
+type MembershipExtensionUserResource struct {
+	UserID string `json:"user_id" example:"507f1f77bcf86cd799439013"`
+	Status string `json:"status" example:"extended"`
+}
+
+// MembershipExtensionReportResource represents the per-user report of a bulk membership extension
+type MembershipExtensionReportResource struct {
+	CommunityID   string                            `json:"community_id" example:"507f1f77bcf86cd799439012"`
+	ExpiresAt     time.Time                         `json:"expires_at" example:"2024-12-31T00:00:00Z"`
+	Extended      int                               `json:"extended" example:"28"`
+	NotSubscribed int                               `json:"not_subscribed" example:"1"`
+	NoExpiry      int                               `json:"no_expiry" example:"1"`
+	Users         []MembershipExtensionUserResource `json:"users"`
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"Gommunity/platform/users/domain/model/entities"
 	"Gommunity/platform/users/domain/model/valueobjects"
 	"Gommunity/platform/users/domain/repositories"
 	"Gommunity/platform/users/interfaces/acl"
@@ -24,9 +25,10 @@ func NewUsersFacade(
 
 // FindUserIDByUsername retrieves a user ID by username (returns UUID string)
 func (f *usersFacadeImpl) FindUserIDByUsername(ctx context.Context, username string) (string, error) {
+	// No user can hold an invalid username
 	usernameVO, err := valueobjects.NewUsername(username)
 	if err != nil {
-		return "", err
+		return "", acl.ErrUserNotFound
 	}
 
 	user, err := f.userRepository.FindByUsername(ctx, usernameVO)
@@ -35,7 +37,7 @@ func (f *usersFacadeImpl) FindUserIDByUsername(ctx context.Context, username str
 	}
 
 	if user == nil {
-		return "", errors.New("user not found")
+		return "", acl.ErrUserNotFound
 	}
 
 	return user.UserID().Value(), nil
@@ -90,3 +92,54 @@ func (f *usersFacadeImpl) GetProfileIDByUserID(ctx context.Context, userID strin
 
 	return user.ProfileID().Value(), nil
 }
+
+// GetUsersByIDs retrieves the public profiles of several users in a single lookup
+func (f *usersFacadeImpl) GetUsersByIDs(ctx context.Context, userIDs []string) ([]*acl.UserSummaryData, error) {
+	userIDVOs := make([]valueobjects.UserID, 0, len(userIDs))
+	for _, userID := range userIDs {
+		userIDVO, err := valueobjects.NewUserID(userID)
+		if err != nil {
+			return nil, err
+		}
+		userIDVOs = append(userIDVOs, userIDVO)
+	}
+
+	users, err := f.userRepository.FindByUserIDs(ctx, userIDVOs)
+	if err != nil {
+		return nil, err
+	}
+
+	return toUserSummaries(users), nil
+}
+
+// SearchUsersByIDs retrieves a page of the given users filtered by username prefix and sorted by username
+func (f *usersFacadeImpl) SearchUsersByIDs(ctx context.Context, userIDs []string, usernamePrefix string, offset, limit int) ([]*acl.UserSummaryData, error) {
+	userIDVOs := make([]valueobjects.UserID, 0, len(userIDs))
+	for _, userID := range userIDs {
+		userIDVO, err := valueobjects.NewUserID(userID)
+		if err != nil {
+			return nil, err
+		}
+		userIDVOs = append(userIDVOs, userIDVO)
+	}
+
+	users, err := f.userRepository.SearchByUserIDs(ctx, userIDVOs, usernamePrefix, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return toUserSummaries(users), nil
+}
+
+func toUserSummaries(users []*entities.User) []*acl.UserSummaryData {
+	results := make([]*acl.UserSummaryData, len(users))
+	for i, user := range users {
+		results[i] = &acl.UserSummaryData{
+			UserID:     user.UserID().Value(),
+			Username:   user.Username().Value(),
+			ProfileURL: user.ProfileURL(),
+			BannerURL:  user.BannerURL(),
+		}
+	}
+	return results
+}
@@ -0,0 +1,136 @@
+package eventhandlers
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+
+	"Gommunity/platform/users/application/outboundservices/acl"
+	"Gommunity/platform/users/domain/model/events"
+	"Gommunity/platform/users/domain/model/valueobjects"
+	"Gommunity/platform/users/domain/repositories"
+)
+
+// UserRemovalHandler cleans up after a user whose account was deleted in IAM, or deactivated there
+// under the remove deactivation policy.
+// Owned communities are handed over or archived and memberships are removed whatever the policy,
+// since an anonymous member can take no part in a community. Posts, comments and reactions are
+// kept under an anonymous ID or deleted, depending on the removal policy.
+type UserRemovalHandler struct {
+	userRepository               repositories.UserRepository
+	externalCommunitiesService   *acl.ExternalCommunitiesService
+	externalSubscriptionsService *acl.ExternalSubscriptionsService
+	externalPostsService         *acl.ExternalPostsService
+	externalReactionsService     *acl.ExternalReactionsService
+	externalCommentsService      *acl.ExternalCommentsService
+	policy                       valueobjects.RemovalPolicy
+}
+
+func NewUserRemovalHandler(
+	userRepository repositories.UserRepository,
+	externalCommunitiesService *acl.ExternalCommunitiesService,
+	externalSubscriptionsService *acl.ExternalSubscriptionsService,
+	externalPostsService *acl.ExternalPostsService,
+	externalReactionsService *acl.ExternalReactionsService,
+	externalCommentsService *acl.ExternalCommentsService,
+	policy valueobjects.RemovalPolicy,
+) *UserRemovalHandler {
+	return &UserRemovalHandler{
+		userRepository:               userRepository,
+		externalCommunitiesService:   externalCommunitiesService,
+		externalSubscriptionsService: externalSubscriptionsService,
+		externalPostsService:         externalPostsService,
+		externalReactionsService:     externalReactionsService,
+		externalCommentsService:      externalCommentsService,
+		policy:                       policy,
+	}
+}
+
+// Handle processes the UserRemovedEvent. Every step can be repeated, so a failed event is safe to retry.
+func (h *UserRemovalHandler) Handle(ctx context.Context, event events.UserRemovedEvent) error {
+	log.Printf("Processing user removal event for user: %s (policy: %s)", event.UserID, h.policy.Value())
+
+	userID, err := valueobjects.NewUserID(event.UserID)
+	if err != nil {
+		log.Printf("Error creating UserID: %v", err)
+		return err
+	}
+
+	// Communities go first so that none is left without an owner once the memberships are gone
+	if err := h.externalCommunitiesService.ReleaseOwnerships(ctx, userID); err != nil {
+		log.Printf("Error releasing owned communities: %v", err)
+		return err
+	}
+
+	if err := h.externalSubscriptionsService.DeleteMemberships(ctx, userID); err != nil {
+		log.Printf("Error deleting memberships: %v", err)
+		return err
+	}
+
+	if h.policy.IsDelete() {
+		err = h.deleteContent(ctx, userID)
+	} else {
+		err = h.anonymizeContent(ctx, userID)
+	}
+	if err != nil {
+		return err
+	}
+
+	exists, err := h.userRepository.ExistsByUserID(ctx, userID)
+	if err != nil {
+		log.Printf("Error checking user existence: %v", err)
+		return err
+	}
+	if exists {
+		if err := h.userRepository.Delete(ctx, userID); err != nil {
+			log.Printf("Error deleting user: %v", err)
+			return err
+		}
+	}
+
+	// TODO: Publish event to message broker
+	log.Printf("Event: UserRemoved - UserID: %s, Policy: %s", userID.Value(), h.policy.Value())
+	return nil
+}
+
+// anonymizeContent moves the posts, comments and reactions of the user to a fresh random ID,
+// so the content stays but can no longer be linked back to the user
+func (h *UserRemovalHandler) anonymizeContent(ctx context.Context, userID valueobjects.UserID) error {
+	anonymousID, err := valueobjects.NewUserID(uuid.New().String())
+	if err != nil {
+		return err
+	}
+
+	if err := h.externalPostsService.AnonymizePosts(ctx, userID, anonymousID); err != nil {
+		log.Printf("Error anonymizing posts: %v", err)
+		return err
+	}
+	if err := h.externalReactionsService.AnonymizeReactions(ctx, userID, anonymousID); err != nil {
+		log.Printf("Error anonymizing reactions: %v", err)
+		return err
+	}
+	if err := h.externalCommentsService.AnonymizeComments(ctx, userID, anonymousID); err != nil {
+		log.Printf("Error anonymizing comments: %v", err)
+		return err
+	}
+	return nil
+}
+
+// deleteContent removes the posts, comments and reactions of the user. Each post is deleted
+// with the comments and reactions left by others on it, so a retry leaves nothing behind.
+func (h *UserRemovalHandler) deleteContent(ctx context.Context, userID valueobjects.UserID) error {
+	if err := h.externalPostsService.DeletePosts(ctx, userID); err != nil {
+		log.Printf("Error deleting posts: %v", err)
+		return err
+	}
+	if err := h.externalReactionsService.DeleteReactions(ctx, userID); err != nil {
+		log.Printf("Error deleting reactions: %v", err)
+		return err
+	}
+	if err := h.externalCommentsService.DeleteComments(ctx, userID); err != nil {
+		log.Printf("Error deleting comments: %v", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package acl
+
+import (
+	"context"
+
+	comments_acl "Gommunity/platform/comments/interfaces/acl"
+	"Gommunity/platform/users/domain/model/valueobjects"
+)
+
+// ExternalCommentsService provides access to the comments bounded context
+type ExternalCommentsService struct {
+	commentsFacade comments_acl.CommentsFacade
+}
+
+func NewExternalCommentsService(commentsFacade comments_acl.CommentsFacade) *ExternalCommentsService {
+	return &ExternalCommentsService{
+		commentsFacade: commentsFacade,
+	}
+}
+
+// AnonymizeComments moves the comments of a removed user to the anonymous ID
+func (s *ExternalCommentsService) AnonymizeComments(ctx context.Context, userID, anonymousID valueobjects.UserID) error {
+	return s.commentsFacade.AnonymizeAuthor(ctx, userID.Value(), anonymousID.Value())
+}
+
+// DeleteComments removes the comments of a removed user together with the replies to them
+func (s *ExternalCommentsService) DeleteComments(ctx context.Context, userID valueobjects.UserID) error {
+	return s.commentsFacade.DeleteCommentsByAuthor(ctx, userID.Value())
+}
@@ -0,0 +1,38 @@
+package acl
+
+import (
+	"context"
+	"fmt"
+
+	community_commands "Gommunity/platform/community/domain/model/commands"
+	community_vo "Gommunity/platform/community/domain/model/valueobjects"
+	community_services "Gommunity/platform/community/domain/services"
+	"Gommunity/platform/users/domain/model/valueobjects"
+)
+
+// ExternalCommunitiesService provides access to Community BC operations
+type ExternalCommunitiesService struct {
+	ownershipTransferCommandService community_services.OwnershipTransferCommandService
+}
+
+func NewExternalCommunitiesService(ownershipTransferCommandService community_services.OwnershipTransferCommandService) *ExternalCommunitiesService {
+	return &ExternalCommunitiesService{
+		ownershipTransferCommandService: ownershipTransferCommandService,
+	}
+}
+
+// ReleaseOwnerships hands each community owned by a removed user over to its longest-standing admin,
+// archiving the communities that have none
+func (s *ExternalCommunitiesService) ReleaseOwnerships(ctx context.Context, userID valueobjects.UserID) error {
+	ownerID, err := community_vo.NewOwnerID(userID.Value())
+	if err != nil {
+		return fmt.Errorf("failed to create owner ID: %w", err)
+	}
+
+	cmd, err := community_commands.NewReleaseOwnershipsCommand(ownerID)
+	if err != nil {
+		return fmt.Errorf("failed to create release ownerships command: %w", err)
+	}
+
+	return s.ownershipTransferCommandService.HandleReleaseOwnerships(ctx, cmd)
+}
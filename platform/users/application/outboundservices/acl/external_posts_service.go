@@ -0,0 +1,29 @@
+package acl
+
+import (
+	"context"
+
+	posts_acl "Gommunity/platform/posts/interfaces/acl"
+	"Gommunity/platform/users/domain/model/valueobjects"
+)
+
+// ExternalPostsService provides access to the posts bounded context
+type ExternalPostsService struct {
+	postsFacade posts_acl.PostsFacade
+}
+
+func NewExternalPostsService(postsFacade posts_acl.PostsFacade) *ExternalPostsService {
+	return &ExternalPostsService{
+		postsFacade: postsFacade,
+	}
+}
+
+// AnonymizePosts moves the posts, revisions and poll votes of a removed user to the anonymous ID
+func (s *ExternalPostsService) AnonymizePosts(ctx context.Context, userID, anonymousID valueobjects.UserID) error {
+	return s.postsFacade.AnonymizeAuthor(ctx, userID.Value(), anonymousID.Value())
+}
+
+// DeletePosts deletes the posts of a removed user, with the comments and reactions left on them
+func (s *ExternalPostsService) DeletePosts(ctx context.Context, userID valueobjects.UserID) error {
+	return s.postsFacade.DeletePostsByAuthor(ctx, userID.Value())
+}
@@ -0,0 +1,29 @@
+package acl
+
+import (
+	"context"
+
+	reactions_acl "Gommunity/platform/reactions/interfaces/acl"
+	"Gommunity/platform/users/domain/model/valueobjects"
+)
+
+// ExternalReactionsService provides access to the reactions bounded context
+type ExternalReactionsService struct {
+	reactionsFacade reactions_acl.ReactionsFacade
+}
+
+func NewExternalReactionsService(reactionsFacade reactions_acl.ReactionsFacade) *ExternalReactionsService {
+	return &ExternalReactionsService{
+		reactionsFacade: reactionsFacade,
+	}
+}
+
+// AnonymizeReactions moves the reactions of a removed user to the anonymous ID
+func (s *ExternalReactionsService) AnonymizeReactions(ctx context.Context, userID, anonymousID valueobjects.UserID) error {
+	return s.reactionsFacade.AnonymizeUserReactions(ctx, userID.Value(), anonymousID.Value())
+}
+
+// DeleteReactions removes the reactions of a removed user
+func (s *ExternalReactionsService) DeleteReactions(ctx context.Context, userID valueobjects.UserID) error {
+	return s.reactionsFacade.DeleteReactionsByUser(ctx, userID.Value())
+}
@@ -0,0 +1,31 @@
+package acl
+
+import (
+	"context"
+	"fmt"
+
+	subscription_vo "Gommunity/platform/subscriptions/domain/model/valueobjects"
+	subscription_services "Gommunity/platform/subscriptions/domain/services"
+	"Gommunity/platform/users/domain/model/valueobjects"
+)
+
+// ExternalSubscriptionsService provides access to Subscriptions BC operations
+type ExternalSubscriptionsService struct {
+	subscriptionCommandService subscription_services.SubscriptionCommandService
+}
+
+func NewExternalSubscriptionsService(subscriptionCommandService subscription_services.SubscriptionCommandService) *ExternalSubscriptionsService {
+	return &ExternalSubscriptionsService{
+		subscriptionCommandService: subscriptionCommandService,
+	}
+}
+
+// DeleteMemberships removes the subscriptions and join requests of a removed user
+func (s *ExternalSubscriptionsService) DeleteMemberships(ctx context.Context, userID valueobjects.UserID) error {
+	subUserID, err := subscription_vo.NewUserID(userID.Value())
+	if err != nil {
+		return fmt.Errorf("failed to create user ID: %w", err)
+	}
+
+	return s.subscriptionCommandService.HandleDeleteByUser(ctx, subUserID)
+}
@@ -0,0 +1,53 @@
+package entities
+
+import (
+	"time"
+
+	"Gommunity/platform/users/domain/model/valueobjects"
+)
+
+// ProcessedEvent is a ledger entry for a consumed event, so redelivered copies are recognised
+// and events older than the state already applied for a user are not applied again
+type ProcessedEvent struct {
+	eventKey    string
+	eventType   string
+	userID      valueobjects.UserID
+	occurredOn  *time.Time
+	processedAt time.Time
+}
+
+// NewProcessedEvent records that an event was processed. occurredOn is nil when the event did not carry one.
+func NewProcessedEvent(eventKey, eventType string, userID valueobjects.UserID, occurredOn *time.Time) *ProcessedEvent {
+	return &ProcessedEvent{
+		eventKey:    eventKey,
+		eventType:   eventType,
+		userID:      userID,
+		occurredOn:  occurredOn,
+		processedAt: time.Now(),
+	}
+}
+
+// EventKey returns the key identifying the event across redeliveries
+func (e *ProcessedEvent) EventKey() string {
+	return e.eventKey
+}
+
+// EventType returns the type of the event
+func (e *ProcessedEvent) EventType() string {
+	return e.eventType
+}
+
+// UserID returns the user the event is about
+func (e *ProcessedEvent) UserID() valueobjects.UserID {
+	return e.userID
+}
+
+// OccurredOn returns when the event happened, or nil when unknown
+func (e *ProcessedEvent) OccurredOn() *time.Time {
+	return e.occurredOn
+}
+
+// ProcessedAt returns when the event was processed
+func (e *ProcessedEvent) ProcessedAt() time.Time {
+	return e.processedAt
+}
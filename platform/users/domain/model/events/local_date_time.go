@@ -0,0 +1,25 @@
+package events
+
+import "time"
+
+// minLocalDateTimeParts is the shortest array a Java LocalDateTime is serialized to:
+// Jackson leaves out the seconds and nanoseconds when they are zero.
+const minLocalDateTimeParts = 5
+
+// localDateTime converts the array format of a Java LocalDateTime, [year, month, day, hour, minute, second, nanosecond],
+// to a UTC time. Missing trailing parts count as zero.
+func localDateTime(parts []int) time.Time {
+	var full [7]int
+	copy(full[:], parts)
+
+	return time.Date(
+		full[0],             // year
+		time.Month(full[1]), // month
+		full[2],             // day
+		full[3],             // hour
+		full[4],             // minute
+		full[5],             // second
+		full[6],             // nanosecond
+		time.UTC,
+	)
+}
@@ -0,0 +1,37 @@
+package events
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetOccurredOnAcceptsTruncatedArrays(t *testing.T) {
+	cases := map[string]struct {
+		parts []int
+		want  time.Time
+	}{
+		"full":           {parts: []int{2026, 3, 14, 9, 26, 53, 589000000}, want: time.Date(2026, 3, 14, 9, 26, 53, 589000000, time.UTC)},
+		"no nanoseconds": {parts: []int{2026, 3, 14, 9, 26, 53}, want: time.Date(2026, 3, 14, 9, 26, 53, 0, time.UTC)},
+		"on the minute":  {parts: []int{2026, 3, 14, 9, 26}, want: time.Date(2026, 3, 14, 9, 26, 0, 0, time.UTC)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			event := UserRemovedEvent{OccurredOn: tc.parts}
+			if !event.HasOccurredOn() {
+				t.Fatal("expected the event to carry its time")
+			}
+			if got := event.GetOccurredOn(); !got.Equal(tc.want) {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHasOccurredOnRejectsIncompleteArrays(t *testing.T) {
+	for _, parts := range [][]int{nil, {2026, 3, 14, 9}} {
+		if (ProfileUpdatedEvent{OccurredOn: parts}).HasOccurredOn() {
+			t.Errorf("expected %v to be rejected", parts)
+		}
+	}
+}
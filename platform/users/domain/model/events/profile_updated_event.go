@@ -4,6 +4,7 @@ import "time"
 
 // ProfileUpdatedEvent represents the event when a user profile is updated
 type ProfileUpdatedEvent struct {
+	EventID    string  `json:"eventId"`
 	UserID     string  `json:"userId"`
 	ProfileID  string  `json:"profileId"`
 	Username   string  `json:"username"`
@@ -11,19 +12,15 @@ type ProfileUpdatedEvent struct {
 	OccurredOn []int   `json:"occurredOn"`
 }
 
+// HasOccurredOn reports whether the event carries the time it happened
+func (e ProfileUpdatedEvent) HasOccurredOn() bool {
+	return len(e.OccurredOn) >= minLocalDateTimeParts
+}
+
 // GetOccurredOn converts the array format to time.Time
 func (e ProfileUpdatedEvent) GetOccurredOn() time.Time {
-	if len(e.OccurredOn) >= 7 {
-		return time.Date(
-			e.OccurredOn[0],             // year
-			time.Month(e.OccurredOn[1]), // month
-			e.OccurredOn[2],             // day
-			e.OccurredOn[3],             // hour
-			e.OccurredOn[4],             // minute
-			e.OccurredOn[5],             // second
-			e.OccurredOn[6],             // nanosecond
-			time.UTC,
-		)
+	if e.HasOccurredOn() {
+		return localDateTime(e.OccurredOn)
 	}
 	return time.Now()
 }
@@ -0,0 +1,24 @@
+package events
+
+import "time"
+
+// UserRemovedEvent represents the event when a user account is deleted or deactivated in IAM.
+// Both events carry the same payload.
+type UserRemovedEvent struct {
+	EventID    string `json:"eventId"`
+	UserID     string `json:"userId"`
+	OccurredOn []int  `json:"occurredOn"`
+}
+
+// HasOccurredOn reports whether the event carries the time it happened
+func (e UserRemovedEvent) HasOccurredOn() bool {
+	return len(e.OccurredOn) >= minLocalDateTimeParts
+}
+
+// GetOccurredOn converts the array format to time.Time
+func (e UserRemovedEvent) GetOccurredOn() time.Time {
+	if e.HasOccurredOn() {
+		return localDateTime(e.OccurredOn)
+	}
+	return time.Now()
+}
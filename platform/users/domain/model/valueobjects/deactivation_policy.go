@@ -0,0 +1,43 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+// Deactivation policies for a user deactivated in IAM
+const (
+	KeepDeactivationPolicy   = "keep"
+	RemoveDeactivationPolicy = "remove"
+)
+
+// DeactivationPolicy decides what happens to a user deactivated in IAM. A deactivation can be undone
+// there, so keep leaves the user and everything they own in place, while remove handles it like a
+// deletion, under the removal policy.
+type DeactivationPolicy struct {
+	value string
+}
+
+func NewDeactivationPolicy(value string) (DeactivationPolicy, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	switch normalized {
+	case KeepDeactivationPolicy, RemoveDeactivationPolicy:
+		return DeactivationPolicy{value: normalized}, nil
+	case "":
+		return DeactivationPolicy{}, errors.New("deactivation policy cannot be empty")
+	}
+	return DeactivationPolicy{}, errors.New("deactivation policy must be either keep or remove")
+}
+
+func (p DeactivationPolicy) Value() string {
+	return p.value
+}
+
+func (p DeactivationPolicy) String() string {
+	return p.value
+}
+
+// IsRemove reports whether a deactivated user is removed like a deleted one
+func (p DeactivationPolicy) IsRemove() bool {
+	return p.value == RemoveDeactivationPolicy
+}
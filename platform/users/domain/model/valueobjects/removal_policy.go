@@ -0,0 +1,42 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+// Removal policies for the content of a deleted or deactivated user
+const (
+	AnonymizeRemovalPolicy = "anonymize"
+	DeleteRemovalPolicy    = "delete"
+)
+
+// RemovalPolicy decides what happens to the posts, comments and reactions of a removed user:
+// anonymize keeps them under an anonymous ID, delete removes them.
+type RemovalPolicy struct {
+	value string
+}
+
+func NewRemovalPolicy(value string) (RemovalPolicy, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	switch normalized {
+	case AnonymizeRemovalPolicy, DeleteRemovalPolicy:
+		return RemovalPolicy{value: normalized}, nil
+	case "":
+		return RemovalPolicy{}, errors.New("removal policy cannot be empty")
+	}
+	return RemovalPolicy{}, errors.New("removal policy must be either anonymize or delete")
+}
+
+func (p RemovalPolicy) Value() string {
+	return p.value
+}
+
+func (p RemovalPolicy) String() string {
+	return p.value
+}
+
+// IsDelete reports whether the content of a removed user is deleted rather than anonymized
+func (p RemovalPolicy) IsDelete() bool {
+	return p.value == DeleteRemovalPolicy
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"Gommunity/platform/users/domain/model/entities"
+	"Gommunity/platform/users/domain/model/valueobjects"
+)
+
+// ProcessedEventRepository is the ledger of consumed events
+type ProcessedEventRepository interface {
+	// Save records a processed event; recording the same event key twice is not an error
+	Save(ctx context.Context, event *entities.ProcessedEvent) error
+	ExistsByEventKey(ctx context.Context, eventKey string) (bool, error)
+	// FindLatestOccurredOn returns when the newest processed event about the user happened, or nil when none is known.
+	// When event types are given, only events of those types are considered.
+	FindLatestOccurredOn(ctx context.Context, userID valueobjects.UserID, eventTypes ...string) (*time.Time, error)
+}
@@ -13,6 +13,11 @@ type UserRepository interface {
 	FindByUserID(ctx context.Context, userID valueobjects.UserID) (*entities.User, error)
 	FindByProfileID(ctx context.Context, profileID valueobjects.ProfileID) (*entities.User, error)
 	FindByUsername(ctx context.Context, username valueobjects.Username) (*entities.User, error)
+	// FindByUserIDs returns the users with the given identifiers, in no particular order
+	FindByUserIDs(ctx context.Context, userIDs []valueobjects.UserID) ([]*entities.User, error)
+	// SearchByUserIDs returns the users among the given identifiers whose username starts with the prefix,
+	// ignoring case, ordered by username then user ID. An empty prefix matches every user and a zero limit returns every match.
+	SearchByUserIDs(ctx context.Context, userIDs []valueobjects.UserID, usernamePrefix string, offset, limit int) ([]*entities.User, error)
 	ExistsByUserID(ctx context.Context, userID valueobjects.UserID) (bool, error)
 	Delete(ctx context.Context, userID valueobjects.UserID) error
 }
@@ -2,47 +2,70 @@ package messaging
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"log"
+	"time"
 
 	"Gommunity/platform/users/application/eventhandlers"
+	"Gommunity/platform/users/domain/model/entities"
 	"Gommunity/platform/users/domain/model/events"
+	"Gommunity/platform/users/domain/model/valueobjects"
+	"Gommunity/platform/users/domain/repositories"
+	"Gommunity/shared/infrastructure/messaging/kafka"
 )
 
+// Event types handled by the users bounded context. Unless configured otherwise,
+// each is consumed from the topic of the same name.
 const (
-	TopicCommunityRegistration = "community.registration"
-	TopicProfileUpdated        = "community.profile.updated"
+	EventTypeCommunityRegistration = "community.registration"
+	EventTypeProfileUpdated        = "community.profile.updated"
+	EventTypeUserDeleted           = "user.deleted"
+	EventTypeUserDeactivated       = "user.deactivated"
 )
 
+// KafkaEventConsumer routes consumed events to their handlers. Every handled event is recorded in the
+// processed event ledger: redelivered copies are skipped, and so are profile updates older than what
+// was already applied for the user. A registration and a removal that arrive out of order are told
+// apart by when they happened, so the older one cannot undo the newer. A deactivation only removes
+// the user when the deactivation policy says so.
 type KafkaEventConsumer struct {
-	registrationHandler  *eventhandlers.UserRegistrationHandler
-	profileUpdateHandler *eventhandlers.ProfileUpdatedHandler
+	registrationHandler      *eventhandlers.UserRegistrationHandler
+	profileUpdateHandler     *eventhandlers.ProfileUpdatedHandler
+	userRemovalHandler       *eventhandlers.UserRemovalHandler
+	processedEventRepository repositories.ProcessedEventRepository
+	deactivationPolicy       valueobjects.DeactivationPolicy
 }
 
 func NewKafkaEventConsumer(
 	registrationHandler *eventhandlers.UserRegistrationHandler,
 	profileUpdateHandler *eventhandlers.ProfileUpdatedHandler,
+	userRemovalHandler *eventhandlers.UserRemovalHandler,
+	processedEventRepository repositories.ProcessedEventRepository,
+	deactivationPolicy valueobjects.DeactivationPolicy,
 ) *KafkaEventConsumer {
 	return &KafkaEventConsumer{
-		registrationHandler:  registrationHandler,
-		profileUpdateHandler: profileUpdateHandler,
+		registrationHandler:      registrationHandler,
+		profileUpdateHandler:     profileUpdateHandler,
+		userRemovalHandler:       userRemovalHandler,
+		processedEventRepository: processedEventRepository,
+		deactivationPolicy:       deactivationPolicy,
 	}
 }
 
-// HandleMessage routes messages to appropriate handlers based on topic
-func (kec *KafkaEventConsumer) HandleMessage(topic string, message []byte) error {
-	ctx := context.Background()
-	log.Printf("Handling message from topic: %s", topic)
-
-	switch topic {
-	case TopicCommunityRegistration:
-		return kec.handleRegistrationEvent(ctx, message)
-	case TopicProfileUpdated:
-		return kec.handleProfileUpdatedEvent(ctx, message)
-	default:
-		log.Printf("Unknown topic: %s", topic)
-		return nil
+// RegisterHandlers registers the handlers of the users bounded context events
+func (kec *KafkaEventConsumer) RegisterHandlers(registry *kafka.HandlerRegistry) error {
+	if err := registry.Register(EventTypeCommunityRegistration, kec.handleRegistrationEvent); err != nil {
+		return err
+	}
+	if err := registry.Register(EventTypeProfileUpdated, kec.handleProfileUpdatedEvent); err != nil {
+		return err
+	}
+	if err := registry.Register(EventTypeUserDeleted, kec.handleUserDeletedEvent); err != nil {
+		return err
 	}
+	return registry.Register(EventTypeUserDeactivated, kec.handleUserDeactivatedEvent)
 }
 
 func (kec *KafkaEventConsumer) handleRegistrationEvent(ctx context.Context, message []byte) error {
@@ -52,8 +75,43 @@ func (kec *KafkaEventConsumer) handleRegistrationEvent(ctx context.Context, mess
 		return err
 	}
 
+	userID, err := valueobjects.NewUserID(event.UserID)
+	if err != nil {
+		log.Printf("Error creating UserID: %v", err)
+		return err
+	}
+
+	eventKey := eventKey(EventTypeCommunityRegistration, event.EventID, message)
+	processed, err := kec.processedEventRepository.ExistsByEventKey(ctx, eventKey)
+	if err != nil {
+		return err
+	}
+	if processed {
+		log.Printf("Skipping duplicate registration event %s for user: %s", eventKey, event.UserID)
+		return nil
+	}
+
+	processedEvent := entities.NewProcessedEvent(eventKey, EventTypeCommunityRegistration, userID, occurredOn(event.HasOccurredOn(), event.GetOccurredOn()))
+
+	// A registration that happened before the user was removed would bring the user back
+	if processedEvent.OccurredOn() != nil {
+		removedOn, err := kec.processedEventRepository.FindLatestOccurredOn(ctx, userID, kec.removalEventTypes()...)
+		if err != nil {
+			return err
+		}
+		if removedOn != nil && processedEvent.OccurredOn().Before(*removedOn) {
+			log.Printf("Skipping stale registration event %s for user: %s (occurred %s, removed %s)",
+				eventKey, event.UserID, processedEvent.OccurredOn().Format(time.RFC3339Nano), removedOn.Format(time.RFC3339Nano))
+			return kec.processedEventRepository.Save(ctx, processedEvent)
+		}
+	}
+
 	log.Printf("Processing registration event: UserID=%s, Username=%s", event.UserID, event.Username)
-	return kec.registrationHandler.Handle(ctx, event)
+	if err := kec.registrationHandler.Handle(ctx, event); err != nil {
+		return err
+	}
+
+	return kec.processedEventRepository.Save(ctx, processedEvent)
 }
 
 func (kec *KafkaEventConsumer) handleProfileUpdatedEvent(ctx context.Context, message []byte) error {
@@ -63,6 +121,156 @@ func (kec *KafkaEventConsumer) handleProfileUpdatedEvent(ctx context.Context, me
 		return err
 	}
 
+	userID, err := valueobjects.NewUserID(event.UserID)
+	if err != nil {
+		log.Printf("Error creating UserID: %v", err)
+		return err
+	}
+
+	eventKey := eventKey(EventTypeProfileUpdated, event.EventID, message)
+	processed, err := kec.processedEventRepository.ExistsByEventKey(ctx, eventKey)
+	if err != nil {
+		return err
+	}
+	if processed {
+		log.Printf("Skipping duplicate profile updated event %s for user: %s", eventKey, event.UserID)
+		return nil
+	}
+
+	processedEvent := entities.NewProcessedEvent(eventKey, EventTypeProfileUpdated, userID, occurredOn(event.HasOccurredOn(), event.GetOccurredOn()))
+
+	// An update that happened before the newest applied event would roll the profile back
+	if processedEvent.OccurredOn() != nil {
+		latest, err := kec.processedEventRepository.FindLatestOccurredOn(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if latest != nil && processedEvent.OccurredOn().Before(*latest) {
+			log.Printf("Skipping stale profile updated event %s for user: %s (occurred %s, already applied %s)",
+				eventKey, event.UserID, processedEvent.OccurredOn().Format(time.RFC3339Nano), latest.Format(time.RFC3339Nano))
+			return kec.processedEventRepository.Save(ctx, processedEvent)
+		}
+	}
+
 	log.Printf("Processing profile updated event: UserID=%s, Username=%s", event.UserID, event.Username)
-	return kec.profileUpdateHandler.Handle(ctx, event)
+	if err := kec.profileUpdateHandler.Handle(ctx, event); err != nil {
+		return err
+	}
+
+	return kec.processedEventRepository.Save(ctx, processedEvent)
+}
+
+func (kec *KafkaEventConsumer) handleUserDeletedEvent(ctx context.Context, message []byte) error {
+	return kec.handleUserRemovedEvent(ctx, EventTypeUserDeleted, message)
+}
+
+func (kec *KafkaEventConsumer) handleUserDeactivatedEvent(ctx context.Context, message []byte) error {
+	if kec.deactivationPolicy.IsRemove() {
+		return kec.handleUserRemovedEvent(ctx, EventTypeUserDeactivated, message)
+	}
+	return kec.keepDeactivatedUser(ctx, message)
+}
+
+// keepDeactivatedUser records a deactivation without touching the user, since IAM can reactivate the account
+func (kec *KafkaEventConsumer) keepDeactivatedUser(ctx context.Context, message []byte) error {
+	var event events.UserRemovedEvent
+	if err := json.Unmarshal(message, &event); err != nil {
+		log.Printf("Error unmarshalling %s event: %v", EventTypeUserDeactivated, err)
+		return err
+	}
+
+	userID, err := valueobjects.NewUserID(event.UserID)
+	if err != nil {
+		log.Printf("Error creating UserID: %v", err)
+		return err
+	}
+
+	eventKey := eventKey(EventTypeUserDeactivated, event.EventID, message)
+	processed, err := kec.processedEventRepository.ExistsByEventKey(ctx, eventKey)
+	if err != nil {
+		return err
+	}
+	if processed {
+		log.Printf("Skipping duplicate %s event %s for user: %s", EventTypeUserDeactivated, eventKey, event.UserID)
+		return nil
+	}
+
+	log.Printf("Keeping deactivated user: %s (deactivation policy: %s)", event.UserID, kec.deactivationPolicy.Value())
+	processedEvent := entities.NewProcessedEvent(eventKey, EventTypeUserDeactivated, userID, occurredOn(event.HasOccurredOn(), event.GetOccurredOn()))
+	return kec.processedEventRepository.Save(ctx, processedEvent)
+}
+
+// removalEventTypes lists the events that remove a user, which a later-delivered older registration must not undo
+func (kec *KafkaEventConsumer) removalEventTypes() []string {
+	if kec.deactivationPolicy.IsRemove() {
+		return []string{EventTypeUserDeleted, EventTypeUserDeactivated}
+	}
+	return []string{EventTypeUserDeleted}
+}
+
+// handleUserRemovedEvent removes the user on user.deleted, and on user.deactivated under the remove deactivation policy
+func (kec *KafkaEventConsumer) handleUserRemovedEvent(ctx context.Context, eventType string, message []byte) error {
+	var event events.UserRemovedEvent
+	if err := json.Unmarshal(message, &event); err != nil {
+		log.Printf("Error unmarshalling %s event: %v", eventType, err)
+		return err
+	}
+
+	userID, err := valueobjects.NewUserID(event.UserID)
+	if err != nil {
+		log.Printf("Error creating UserID: %v", err)
+		return err
+	}
+
+	eventKey := eventKey(eventType, event.EventID, message)
+	processed, err := kec.processedEventRepository.ExistsByEventKey(ctx, eventKey)
+	if err != nil {
+		return err
+	}
+	if processed {
+		log.Printf("Skipping duplicate %s event %s for user: %s", eventType, eventKey, event.UserID)
+		return nil
+	}
+
+	processedEvent := entities.NewProcessedEvent(eventKey, eventType, userID, occurredOn(event.HasOccurredOn(), event.GetOccurredOn()))
+
+	// A removal that happened before the user registered again must not remove the new account
+	if processedEvent.OccurredOn() != nil {
+		registeredOn, err := kec.processedEventRepository.FindLatestOccurredOn(ctx, userID, EventTypeCommunityRegistration)
+		if err != nil {
+			return err
+		}
+		if registeredOn != nil && processedEvent.OccurredOn().Before(*registeredOn) {
+			log.Printf("Skipping stale %s event %s for user: %s (occurred %s, registered %s)",
+				eventType, eventKey, event.UserID, processedEvent.OccurredOn().Format(time.RFC3339Nano), registeredOn.Format(time.RFC3339Nano))
+			return kec.processedEventRepository.Save(ctx, processedEvent)
+		}
+	}
+
+	log.Printf("Processing %s event: UserID=%s", eventType, event.UserID)
+	if err := kec.userRemovalHandler.Handle(ctx, event); err != nil {
+		return err
+	}
+
+	return kec.processedEventRepository.Save(ctx, processedEvent)
+}
+
+// eventKey identifies an event across redeliveries: by its event ID when the producer sends one,
+// otherwise by a hash of the message, since a redelivered copy carries the exact same bytes.
+// Keys are scoped by event type rather than topic, so renaming a topic keeps the ledger valid.
+func eventKey(eventType, eventID string, message []byte) string {
+	if eventID != "" {
+		return eventType + ":" + eventID
+	}
+
+	sum := sha256.Sum256(message)
+	return eventType + ":sha256:" + hex.EncodeToString(sum[:])
+}
+
+// occurredOn returns the time an event happened, or nil when the event does not carry it
+func occurredOn(known bool, at time.Time) *time.Time {
+	if !known {
+		return nil
+	}
+	return &at
 }
@@ -0,0 +1,95 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"Gommunity/platform/users/domain/model/entities"
+	"Gommunity/platform/users/domain/model/valueobjects"
+	domain_repos "Gommunity/platform/users/domain/repositories"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type processedEventRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+// NewProcessedEventRepository creates a new ProcessedEventRepository implementation
+func NewProcessedEventRepository(collection *mongo.Collection) domain_repos.ProcessedEventRepository {
+	return &processedEventRepositoryImpl{
+		collection: collection,
+	}
+}
+
+// processedEventDocument represents the MongoDB document structure.
+// occurred_on is kept in nanoseconds so that events of the same second stay ordered.
+type processedEventDocument struct {
+	EventKey    string `bson:"_id"`
+	EventType   string `bson:"event_type"`
+	UserID      string `bson:"user_id"`
+	OccurredOn  *int64 `bson:"occurred_on,omitempty"`
+	ProcessedAt int64  `bson:"processed_at"`
+}
+
+// Save records a processed event
+func (r *processedEventRepositoryImpl) Save(ctx context.Context, event *entities.ProcessedEvent) error {
+	doc := processedEventDocument{
+		EventKey:    event.EventKey(),
+		EventType:   event.EventType(),
+		UserID:      event.UserID().Value(),
+		ProcessedAt: event.ProcessedAt().Unix(),
+	}
+	if event.OccurredOn() != nil {
+		occurredOn := event.OccurredOn().UnixNano()
+		doc.OccurredOn = &occurredOn
+	}
+
+	_, err := r.collection.InsertOne(ctx, doc)
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+
+	return nil
+}
+
+// ExistsByEventKey checks if an event was already processed
+func (r *processedEventRepositoryImpl) ExistsByEventKey(ctx context.Context, eventKey string) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"_id": eventKey}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// FindLatestOccurredOn returns when the newest processed event about the user happened,
+// optionally limited to the given event types
+func (r *processedEventRepositoryImpl) FindLatestOccurredOn(ctx context.Context, userID valueobjects.UserID, eventTypes ...string) (*time.Time, error) {
+	filter := bson.M{
+		"user_id":     userID.Value(),
+		"occurred_on": bson.M{"$exists": true},
+	}
+	if len(eventTypes) > 0 {
+		filter["event_type"] = bson.M{"$in": eventTypes}
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "occurred_on", Value: -1}})
+
+	var doc processedEventDocument
+	err := r.collection.FindOne(ctx, filter, opts).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if doc.OccurredOn == nil {
+		return nil, nil
+	}
+
+	occurredOn := time.Unix(0, *doc.OccurredOn).UTC()
+	return &occurredOn, nil
+}
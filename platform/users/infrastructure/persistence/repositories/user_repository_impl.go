@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log"
+	"regexp"
 
 	"Gommunity/platform/users/domain/model/entities"
 	"Gommunity/platform/users/domain/model/valueobjects"
@@ -11,6 +12,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type userRepositoryImpl struct {
@@ -144,6 +146,89 @@ func (r *userRepositoryImpl) FindByUsername(ctx context.Context, username valueo
 	return r.documentToEntity(&doc)
 }
 
+// FindByUserIDs finds the users with the given user IDs
+func (r *userRepositoryImpl) FindByUserIDs(ctx context.Context, userIDs []valueobjects.UserID) ([]*entities.User, error) {
+	if len(userIDs) == 0 {
+		return []*entities.User{}, nil
+	}
+
+	ids := make([]string, len(userIDs))
+	for i, userID := range userIDs {
+		ids[i] = userID.Value()
+	}
+
+	users, err := r.findUsers(ctx, bson.M{"user_id": bson.M{"$in": ids}})
+	if err != nil {
+		log.Printf("Error finding users by UserIDs in MongoDB: %v", err)
+		return nil, err
+	}
+	return users, nil
+}
+
+// SearchByUserIDs finds the users with the given user IDs whose username starts with the prefix, sorted by username
+func (r *userRepositoryImpl) SearchByUserIDs(ctx context.Context, userIDs []valueobjects.UserID, usernamePrefix string, offset, limit int) ([]*entities.User, error) {
+	if len(userIDs) == 0 {
+		return []*entities.User{}, nil
+	}
+
+	ids := make([]string, len(userIDs))
+	for i, userID := range userIDs {
+		ids[i] = userID.Value()
+	}
+
+	filter := bson.M{"user_id": bson.M{"$in": ids}}
+	if usernamePrefix != "" {
+		filter["username"] = bson.M{"$regex": "^" + regexp.QuoteMeta(usernamePrefix), "$options": "i"}
+	}
+
+	// A secondary-strength collation orders usernames without regard to case
+	opts := options.Find().
+		SetSort(bson.D{{Key: "username", Value: 1}, {Key: "user_id", Value: 1}}).
+		SetCollation(&options.Collation{Locale: "en", Strength: 2})
+	if offset > 0 {
+		opts.SetSkip(int64(offset))
+	}
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	users, err := r.findUsers(ctx, filter, opts)
+	if err != nil {
+		log.Printf("Error searching users by UserIDs in MongoDB: %v", err)
+		return nil, err
+	}
+	return users, nil
+}
+
+// findUsers decodes every user matching the filter
+func (r *userRepositoryImpl) findUsers(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]*entities.User, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	users := make([]*entities.User, 0)
+	for cursor.Next(ctx) {
+		var doc userDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		user, err := r.documentToEntity(&doc)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
 // Delete deletes a user by user ID
 func (r *userRepositoryImpl) Delete(ctx context.Context, userID valueobjects.UserID) error {
 	filter := bson.M{"user_id": userID.Value()}
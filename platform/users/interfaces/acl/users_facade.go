@@ -1,11 +1,26 @@
 package acl
 
-import "context"
+import (
+	"context"
+	"errors"
+)
+
+// ErrUserNotFound is returned by FindUserIDByUsername when no user has the username
+var ErrUserNotFound = errors.New("user not found")
+
+// UserSummaryData represents the public profile of a user exposed to other bounded contexts
+type UserSummaryData struct {
+	UserID     string
+	Username   string
+	ProfileURL *string
+	BannerURL  *string
+}
 
 // UsersFacade provides an anti-corruption layer for accessing User bounded context functionality
 // This facade exposes only the necessary operations needed by other bounded contexts
 type UsersFacade interface {
-	// FindUserIDByUsername retrieves a user ID by username (returns UUID string)
+	// FindUserIDByUsername retrieves a user ID by username (returns UUID string).
+	// It fails with ErrUserNotFound when no user has the username.
 	FindUserIDByUsername(ctx context.Context, username string) (string, error)
 
 	// ValidateUserExists checks if a user exists by ID (UUID string)
@@ -20,4 +35,12 @@ type UsersFacade interface {
 
 	// GetProfileIDByUserID retrieves a user's profile ID (UUID) by user ID (UUID string)
 	GetProfileIDByUserID(ctx context.Context, userID string) (string, error)
+
+	// GetUsersByIDs retrieves the public profiles of several users in a single lookup.
+	// Unknown users are absent from the result.
+	GetUsersByIDs(ctx context.Context, userIDs []string) ([]*UserSummaryData, error)
+
+	// SearchUsersByIDs retrieves one page of the users among the given IDs whose username starts with the prefix,
+	// ignoring case, ordered by username. A zero limit returns every match.
+	SearchUsersByIDs(ctx context.Context, userIDs []string, usernamePrefix string, offset, limit int) ([]*UserSummaryData, error)
 }
@@ -1,15 +1,18 @@
 package config
 
 import (
+	"errors"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
 )
 
-// KafkaConfig holds Kafka-related configuration
+// KafkaConfig holds Kafka-related configuration.
+// Topics lists the topics to consume, each as "event_type=topic" or a name that is both.
 type KafkaConfig struct {
 	BootstrapServers string
 	GroupID          string
@@ -18,24 +21,40 @@ type KafkaConfig struct {
 	SASLMechanism    string
 	SASLUsername     string
 	SASLPassword     string
+
+	// Retry policy of the consumer before a failing message is dead-lettered
+	ConsumerMaxAttempts     int
+	ConsumerRetryBackoff    time.Duration
+	ConsumerMaxRetryBackoff time.Duration
 }
 
 type Config struct {
-	Port                 string
-	MongoURI             string
-	MongoDatabase        string
-	MongoTimeout         time.Duration
-	Kafka                KafkaConfig
-	JWTSecret            string
-	ServiceDiscoveryURL  string
-	ServerIP             string
-	ServiceName          string
-	APIPrefix            string
-	CORSAllowedOrigins   []string
-	CORSAllowedMethods   []string
-	CORSAllowedHeaders   []string
-	CORSAllowCredentials bool
-	CORSMaxAge           time.Duration
+	Port                       string
+	MongoURI                   string
+	MongoDatabase              string
+	MongoTimeout               time.Duration
+	Kafka                      KafkaConfig
+	JWTSecret                  string
+	ServiceDiscoveryURL        string
+	ServerIP                   string
+	ServiceName                string
+	APIPrefix                  string
+	CORSAllowedOrigins         []string
+	CORSAllowedMethods         []string
+	CORSAllowedHeaders         []string
+	CORSAllowCredentials       bool
+	CORSMaxAge                 time.Duration
+	MaxPinnedPosts             int
+	PostSchedulerInterval      time.Duration
+	InvitationSecret           string
+	InvitationLifetime         time.Duration
+	MembershipSweepInterval    time.Duration
+	MemberCountRefreshInterval time.Duration
+	OutboxRelayInterval        time.Duration
+	OutboxRelayEnabled         bool
+	OutboxMaxAttempts          int
+	UserRemovalPolicy          string
+	UserDeactivationPolicy     string
 }
 
 func Load() (*Config, error) {
@@ -51,29 +70,69 @@ func Load() (*Config, error) {
 		MongoDatabase: getEnv("MONGO_DATABASE", "gommunity"),
 		MongoTimeout:  getEnvDuration("MONGO_TIMEOUT", 10*time.Second),
 		Kafka: KafkaConfig{
-			BootstrapServers: getEnv("KAFKA_BOOTSTRAP_SERVERS", "localhost:9092"),
-			GroupID:          getEnv("KAFKA_GROUP_ID", "gommunity-group"),
+			BootstrapServers: getEnv("KAFKA_BOOTSTRAP_SERVERS", ""),
+			GroupID:          getEnv("KAFKA_GROUP_ID", "gommunity-consumer-group"),
 			Topics:           getEnvSlice("KAFKA_TOPICS", []string{}),
 			SecurityProtocol: getEnv("KAFKA_SECURITY_PROTOCOL", "PLAINTEXT"),
 			SASLMechanism:    getEnv("KAFKA_SASL_MECHANISM", "PLAIN"),
 			SASLUsername:     getEnv("KAFKA_SASL_USERNAME", "$ConnectionString"),
 			SASLPassword:     getEnv("KAFKA_SASL_PASSWORD", ""),
+
+			ConsumerMaxAttempts:     getEnvInt("KAFKA_CONSUMER_MAX_ATTEMPTS", 5),
+			ConsumerRetryBackoff:    getEnvDuration("KAFKA_CONSUMER_RETRY_BACKOFF", time.Second),
+			ConsumerMaxRetryBackoff: getEnvDuration("KAFKA_CONSUMER_MAX_RETRY_BACKOFF", 30*time.Second),
 		},
-		JWTSecret:            getEnv("JWT_SECRET", ""),
-		ServiceDiscoveryURL:  strings.TrimSuffix(getEnv("SERVICE_DISCOVERY_URL", "http://127.0.0.1:8761/eureka"), "/"),
-		ServerIP:             getEnv("SERVER_IP", "127.0.0.1"),
-		ServiceName:          getEnv("SERVICE_NAME", "gommunity-service"),
-		APIPrefix:            "/api/v1",
-		CORSAllowedOrigins:   []string{"http://localhost:3000"},
-		CORSAllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "PATCH", "OPTIONS"},
-		CORSAllowedHeaders:   []string{"*"},
-		CORSAllowCredentials: true,
-		CORSMaxAge:           12 * time.Hour,
+		JWTSecret:                  getEnv("JWT_SECRET", ""),
+		ServiceDiscoveryURL:        strings.TrimSuffix(getEnv("SERVICE_DISCOVERY_URL", "http://127.0.0.1:8761/eureka"), "/"),
+		ServerIP:                   getEnv("SERVER_IP", "127.0.0.1"),
+		ServiceName:                getEnv("SERVICE_NAME", "gommunity-service"),
+		APIPrefix:                  "/api/v1",
+		CORSAllowedOrigins:         []string{"http://localhost:3000"},
+		CORSAllowedMethods:         []string{"GET", "POST", "PUT", "DELETE", "PATCH", "OPTIONS"},
+		CORSAllowedHeaders:         []string{"*"},
+		CORSAllowCredentials:       true,
+		CORSMaxAge:                 12 * time.Hour,
+		MaxPinnedPosts:             getEnvInt("POSTS_MAX_PINNED", 3),
+		PostSchedulerInterval:      getEnvDuration("POSTS_SCHEDULER_INTERVAL", 30*time.Second),
+		InvitationSecret:           getEnv("INVITATIONS_SECRET", ""),
+		InvitationLifetime:         getEnvDuration("INVITATIONS_DEFAULT_LIFETIME", 7*24*time.Hour),
+		MembershipSweepInterval:    getEnvDuration("SUBSCRIPTIONS_EXPIRY_SWEEP_INTERVAL", time.Minute),
+		MemberCountRefreshInterval: getEnvDuration("COMMUNITIES_MEMBER_COUNT_REFRESH_INTERVAL", 5*time.Minute),
+		OutboxRelayInterval:        getEnvDuration("KAFKA_OUTBOX_RELAY_INTERVAL", 2*time.Second),
+		OutboxRelayEnabled:         getEnvBool("KAFKA_OUTBOX_RELAY_ENABLED", true),
+		OutboxMaxAttempts:          getEnvInt("KAFKA_OUTBOX_MAX_ATTEMPTS", 20),
+		UserRemovalPolicy:          getEnv("USERS_REMOVAL_POLICY", "anonymize"),
+		UserDeactivationPolicy:     getEnv("USERS_DEACTIVATION_POLICY", "keep"),
+	}
+
+	// Invitation tokens are only as safe as their signing key: an empty key would let anyone
+	// forge a token from an invitation ID, so startup fails rather than run without one.
+	if config.InvitationSecret == "" {
+		if config.JWTSecret == "" {
+			return nil, errors.New("INVITATIONS_SECRET must be set to sign invitation tokens")
+		}
+		log.Println("Warning: INVITATIONS_SECRET is not set, signing invitation tokens with JWT_SECRET; set a dedicated secret")
+		config.InvitationSecret = config.JWTSecret
+	}
+
+	// Zero attempts or less would park every event on its first failure
+	if config.OutboxMaxAttempts <= 0 {
+		return nil, errors.New("KAFKA_OUTBOX_MAX_ATTEMPTS must be greater than zero")
+	}
+
+	// A limit of zero or less would refuse every pin
+	if config.MaxPinnedPosts <= 0 {
+		return nil, errors.New("POSTS_MAX_PINNED must be greater than zero")
 	}
 
 	return config, nil
 }
 
+// Enabled reports whether Kafka is configured. Without bootstrap servers no events are consumed or published.
+func (k KafkaConfig) Enabled() bool {
+	return k.BootstrapServers != ""
+}
+
 // Helper functions for environment variables
 
 func getEnv(key, defaultValue string) string {
@@ -100,6 +159,19 @@ func getEnvBool(key string, defaultValue bool) bool {
 	return value == "true"
 }
 
+func getEnvInt(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Invalid integer for %s: %v, using default", key, err)
+		return defaultValue
+	}
+	return parsed
+}
+
 func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 	value := os.Getenv(key)
 	if value == "" {
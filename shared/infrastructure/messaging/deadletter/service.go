@@ -0,0 +1,63 @@
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Handler processes a consumed message, the same way the Kafka consumer does
+type Handler func(ctx context.Context, topic string, message []byte) error
+
+// Service lists, replays and discards dead-lettered messages
+type Service struct {
+	store   *Store
+	handler Handler
+}
+
+// NewService creates a Service replaying messages through handler
+func NewService(store *Store, handler Handler) *Service {
+	return &Service{
+		store:   store,
+		handler: handler,
+	}
+}
+
+// List returns dead-lettered messages, newest first
+func (s *Service) List(ctx context.Context, topic string, limit, offset int) ([]Message, error) {
+	return s.store.FindAll(ctx, topic, limit, offset)
+}
+
+// Replay hands a dead-lettered message to the handler again. It is removed when handled,
+// and kept with the new error otherwise.
+func (s *Service) Replay(ctx context.Context, id string) error {
+	message, err := s.store.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve dead letter: %w", err)
+	}
+	if message == nil {
+		return errors.New("dead letter not found")
+	}
+
+	if handlerErr := s.handler(ctx, message.Topic, message.Payload); handlerErr != nil {
+		if err := s.store.RecordReplayFailure(ctx, id, handlerErr, time.Now()); err != nil {
+			log.Printf("failed to record replay failure of dead letter %s: %v", id, err)
+		}
+		return fmt.Errorf("replay failed: %w", handlerErr)
+	}
+
+	log.Printf("Replayed dead letter %s from topic %s", id, message.Topic)
+	return s.store.Delete(ctx, id)
+}
+
+// Discard removes a dead-lettered message without handling it
+func (s *Service) Discard(ctx context.Context, id string) error {
+	if err := s.store.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	log.Printf("Discarded dead letter %s", id)
+	return nil
+}
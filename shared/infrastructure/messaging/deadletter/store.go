@@ -0,0 +1,194 @@
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"Gommunity/shared/infrastructure/messaging/kafka"
+)
+
+// Message is a consumed Kafka message that kept failing and was set aside
+type Message struct {
+	ID              string
+	Topic           string
+	Partition       int
+	Offset          int64
+	Key             string
+	Payload         []byte
+	Headers         map[string]string
+	Attempts        int
+	Error           string
+	FailedAt        time.Time
+	ReplayCount     int
+	LastReplayError string
+	LastReplayedAt  *time.Time
+}
+
+// Store keeps dead-lettered messages in MongoDB until they are replayed or discarded.
+// It is the dead-letter sink of the Kafka consumer.
+type Store struct {
+	collection *mongo.Collection
+}
+
+// NewStore creates a Store on the dead letters collection
+func NewStore(collection *mongo.Collection) *Store {
+	return &Store{collection: collection}
+}
+
+// messageDocument represents the MongoDB document structure
+type messageDocument struct {
+	ID              string            `bson:"_id"`
+	Topic           string            `bson:"topic"`
+	Partition       int               `bson:"partition"`
+	Offset          int64             `bson:"offset"`
+	Key             string            `bson:"key,omitempty"`
+	Payload         []byte            `bson:"payload"`
+	Headers         map[string]string `bson:"headers,omitempty"`
+	Attempts        int               `bson:"attempts"`
+	Error           string            `bson:"error"`
+	FailedAt        int64             `bson:"failed_at"`
+	ReplayCount     int               `bson:"replay_count"`
+	LastReplayError string            `bson:"last_replay_error,omitempty"`
+	LastReplayedAt  *int64            `bson:"last_replayed_at,omitempty"`
+}
+
+// Save stores a message whose retries ran out. A message is identified by its topic, partition and offset,
+// so one read again after a restart updates its dead letter instead of adding a second one.
+func (s *Store) Save(ctx context.Context, letter kafka.DeadLetter) error {
+	filter := bson.M{
+		"topic":     letter.Topic,
+		"partition": letter.Partition,
+		"offset":    letter.Offset,
+	}
+	update := bson.M{
+		"$setOnInsert": bson.M{
+			"_id":          primitive.NewObjectID().Hex(),
+			"replay_count": 0,
+		},
+		"$set": bson.M{
+			"key":       string(letter.Key),
+			"payload":   letter.Payload,
+			"headers":   letter.Headers,
+			"attempts":  letter.Attempts,
+			"error":     letter.Error,
+			"failed_at": letter.FailedAt.Unix(),
+		},
+	}
+
+	_, err := s.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// FindAll retrieves dead-lettered messages, newest first. An empty topic matches every topic.
+func (s *Store) FindAll(ctx context.Context, topic string, limit, offset int) ([]Message, error) {
+	filter := bson.M{}
+	if topic != "" {
+		filter["topic"] = topic
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "failed_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+
+	cursor, err := s.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var messages []Message
+	for cursor.Next(ctx) {
+		var doc messageDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		messages = append(messages, toMessage(&doc))
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// FindByID retrieves a dead-lettered message, or nil when it does not exist
+func (s *Store) FindByID(ctx context.Context, id string) (*Message, error) {
+	var doc messageDocument
+	err := s.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	message := toMessage(&doc)
+	return &message, nil
+}
+
+// RecordReplayFailure notes that replaying a message failed again
+func (s *Store) RecordReplayFailure(ctx context.Context, id string, replayErr error, replayedAt time.Time) error {
+	filter := bson.M{"_id": id}
+	update := bson.M{
+		"$inc": bson.M{"replay_count": 1},
+		"$set": bson.M{
+			"last_replay_error": replayErr.Error(),
+			"last_replayed_at":  replayedAt.Unix(),
+		},
+	}
+
+	result, err := s.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("dead letter not found")
+	}
+
+	return nil
+}
+
+// Delete removes a dead-lettered message
+func (s *Store) Delete(ctx context.Context, id string) error {
+	result, err := s.collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.New("dead letter not found")
+	}
+
+	return nil
+}
+
+func toMessage(doc *messageDocument) Message {
+	message := Message{
+		ID:              doc.ID,
+		Topic:           doc.Topic,
+		Partition:       doc.Partition,
+		Offset:          doc.Offset,
+		Key:             doc.Key,
+		Payload:         doc.Payload,
+		Headers:         doc.Headers,
+		Attempts:        doc.Attempts,
+		Error:           doc.Error,
+		FailedAt:        time.Unix(doc.FailedAt, 0),
+		ReplayCount:     doc.ReplayCount,
+		LastReplayError: doc.LastReplayError,
+	}
+	if doc.LastReplayedAt != nil {
+		replayedAt := time.Unix(*doc.LastReplayedAt, 0)
+		message.LastReplayedAt = &replayedAt
+	}
+	return message
+}
@@ -19,10 +19,46 @@ type KafkaConfig struct {
 	SASLMechanism    string
 	SASLUsername     string
 	SASLPassword     string
+	Retry            RetryPolicy
+}
+
+// RetryPolicy controls how often a failing message is handled again before it is dead-lettered.
+// The wait between attempts starts at Backoff and doubles up to MaxBackoff.
+type RetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+// DefaultRetryPolicy is used for the fields of a RetryPolicy left at zero
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	Backoff:     time.Second,
+	MaxBackoff:  30 * time.Second,
+}
+
+// DeadLetter is a message whose handler still failed after every attempt of the retry policy
+type DeadLetter struct {
+	Topic     string
+	Partition int
+	Offset    int64
+	Key       []byte
+	Payload   []byte
+	Headers   map[string]string
+	Attempts  int
+	Error     string
+	FailedAt  time.Time
+}
+
+// DeadLetterSink keeps dead letters so they can be inspected and replayed
+type DeadLetterSink interface {
+	Save(ctx context.Context, letter DeadLetter) error
 }
 
 type KafkaConsumer struct {
-	readers []*kafka.Reader
+	readers     []*kafka.Reader
+	retry       RetryPolicy
+	deadLetters DeadLetterSink
 }
 
 // NewKafkaConsumer creates a new Kafka consumer with Azure Event Hub support
@@ -131,13 +167,32 @@ func NewKafkaConsumer(config KafkaConfig) *KafkaConsumer {
 	log.Printf("Kafka consumer created for topics: %v with group ID: %s (Security: %s)",
 		config.Topics, config.GroupID, config.SecurityProtocol)
 
+	retry := config.Retry
+	if retry.MaxAttempts <= 0 {
+		retry.MaxAttempts = DefaultRetryPolicy.MaxAttempts
+	}
+	if retry.Backoff <= 0 {
+		retry.Backoff = DefaultRetryPolicy.Backoff
+	}
+	if retry.MaxBackoff < retry.Backoff {
+		retry.MaxBackoff = max(DefaultRetryPolicy.MaxBackoff, retry.Backoff)
+	}
+
 	return &KafkaConsumer{
 		readers: readers,
+		retry:   retry,
 	}
 }
 
+// WithDeadLetterSink sets where messages go once their retries run out.
+// Without a sink such messages are logged and skipped.
+func (kc *KafkaConsumer) WithDeadLetterSink(sink DeadLetterSink) *KafkaConsumer {
+	kc.deadLetters = sink
+	return kc
+}
+
 // ConsumeMessages starts consuming messages from Kafka with retry logic
-func (kc *KafkaConsumer) ConsumeMessages(ctx context.Context, handler func(topic string, message []byte) error) error {
+func (kc *KafkaConsumer) ConsumeMessages(ctx context.Context, handler func(ctx context.Context, topic string, message []byte) error) error {
 	log.Println("Starting Kafka message consumption...")
 
 	// Start a goroutine for each reader with retry logic
@@ -200,13 +255,18 @@ func (kc *KafkaConsumer) ConsumeMessages(ctx context.Context, handler func(topic
 					log.Printf("📨 Received message from topic %s: partition=%d offset=%d",
 						msg.Topic, msg.Partition, msg.Offset)
 
-					if err := handler(msg.Topic, msg.Value); err != nil {
-						log.Printf("❌ Error handling message: %v", err)
-						// Continue processing other messages even if one fails
-						continue
+					attempts, err := kc.handleWithRetry(ctx, msg, handler)
+					if err != nil {
+						if ctx.Err() != nil {
+							// Shutting down: leave the message uncommitted so it is read again
+							return
+						}
+						if !kc.deadLetter(ctx, msg, attempts, err) {
+							return
+						}
 					}
 
-					// Commit the message after successful processing
+					// Commit the message once it is handled or dead-lettered, so it is neither lost nor re-read
 					if err := r.CommitMessages(ctx, msg); err != nil {
 						log.Printf("⚠️  Error committing message: %v", err)
 					}
@@ -221,6 +281,89 @@ func (kc *KafkaConsumer) ConsumeMessages(ctx context.Context, handler func(topic
 	return kc.Close()
 }
 
+// handleWithRetry runs the handler until it succeeds or the retry policy runs out,
+// and returns the number of attempts made with the last error
+func (kc *KafkaConsumer) handleWithRetry(ctx context.Context, msg kafka.Message, handler func(ctx context.Context, topic string, message []byte) error) (int, error) {
+	delay := kc.retry.Backoff
+	var err error
+
+	for attempt := 1; attempt <= kc.retry.MaxAttempts; attempt++ {
+		if err = handler(ctx, msg.Topic, msg.Value); err == nil {
+			return attempt, nil
+		}
+
+		log.Printf("❌ Error handling message from topic %s (partition=%d offset=%d), attempt %d/%d: %v",
+			msg.Topic, msg.Partition, msg.Offset, attempt, kc.retry.MaxAttempts, err)
+
+		if attempt == kc.retry.MaxAttempts {
+			break
+		}
+		if !sleep(ctx, delay) {
+			return attempt, err
+		}
+		delay = min(delay*2, kc.retry.MaxBackoff)
+	}
+
+	return kc.retry.MaxAttempts, err
+}
+
+// deadLetter hands a message whose retries ran out to the dead-letter sink. Storing is retried until it works,
+// since committing the message without it would lose it. Returns false when the consumer is shutting down.
+func (kc *KafkaConsumer) deadLetter(ctx context.Context, msg kafka.Message, attempts int, handlerErr error) bool {
+	if kc.deadLetters == nil {
+		log.Printf("⚠️  Skipping message from topic %s (partition=%d offset=%d) after %d attempts: %v",
+			msg.Topic, msg.Partition, msg.Offset, attempts, handlerErr)
+		return true
+	}
+
+	headers := make(map[string]string, len(msg.Headers))
+	for _, header := range msg.Headers {
+		headers[header.Key] = string(header.Value)
+	}
+
+	letter := DeadLetter{
+		Topic:     msg.Topic,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Key:       msg.Key,
+		Payload:   msg.Value,
+		Headers:   headers,
+		Attempts:  attempts,
+		Error:     handlerErr.Error(),
+		FailedAt:  time.Now(),
+	}
+
+	delay := kc.retry.Backoff
+	for {
+		err := kc.deadLetters.Save(ctx, letter)
+		if err == nil {
+			log.Printf("☠️  Dead-lettered message from topic %s (partition=%d offset=%d) after %d attempts",
+				msg.Topic, msg.Partition, msg.Offset, attempts)
+			return true
+		}
+
+		log.Printf("❌ Error dead-lettering message from topic %s (partition=%d offset=%d): %v",
+			msg.Topic, msg.Partition, msg.Offset, err)
+		if !sleep(ctx, delay) {
+			return false
+		}
+		delay = min(delay*2, kc.retry.MaxBackoff)
+	}
+}
+
+// sleep waits for d and returns false when ctx is cancelled first
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
 // Close closes the Kafka consumer
 func (kc *KafkaConsumer) Close() error {
 	for _, reader := range kc.readers {
@@ -0,0 +1,112 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+)
+
+// MessageHandler handles the payload of a consumed event
+type MessageHandler func(ctx context.Context, message []byte) error
+
+// HandlerRegistry routes consumed messages to the handlers bounded contexts register by event type.
+// Topics are bound to event types from configuration, so topic names can change per environment.
+type HandlerRegistry struct {
+	handlers map[string]MessageHandler
+	topics   map[string]string
+}
+
+// NewHandlerRegistry creates an empty HandlerRegistry
+func NewHandlerRegistry() *HandlerRegistry {
+	return &HandlerRegistry{
+		handlers: make(map[string]MessageHandler),
+		topics:   make(map[string]string),
+	}
+}
+
+// Register adds the handler of an event type. Each event type has exactly one handler.
+func (r *HandlerRegistry) Register(eventType string, handler MessageHandler) error {
+	if eventType == "" {
+		return errors.New("event type cannot be empty")
+	}
+	if handler == nil {
+		return fmt.Errorf("handler of event type %s cannot be nil", eventType)
+	}
+	if _, exists := r.handlers[eventType]; exists {
+		return fmt.Errorf("a handler is already registered for event type %s", eventType)
+	}
+
+	r.handlers[eventType] = handler
+	return nil
+}
+
+// EventTypes returns the registered event types, sorted
+func (r *HandlerRegistry) EventTypes() []string {
+	eventTypes := make([]string, 0, len(r.handlers))
+	for eventType := range r.handlers {
+		eventTypes = append(eventTypes, eventType)
+	}
+	sort.Strings(eventTypes)
+	return eventTypes
+}
+
+// Bind binds the configured topics to event types and returns the topic names to consume.
+// Each entry is either "event_type=topic" or a name used as both event type and topic.
+// Without entries every registered event type is consumed from the topic of the same name.
+// A topic whose event type has no handler is an error, so a misconfiguration stops startup.
+func (r *HandlerRegistry) Bind(entries []string) ([]string, error) {
+	if len(entries) == 0 {
+		entries = r.EventTypes()
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("no topics configured and no handlers registered")
+	}
+
+	topics := make(map[string]string, len(entries))
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		eventType, topic := entry, entry
+		if i := strings.Index(entry, "="); i >= 0 {
+			eventType = strings.TrimSpace(entry[:i])
+			topic = strings.TrimSpace(entry[i+1:])
+		}
+		if eventType == "" || topic == "" {
+			return nil, fmt.Errorf("invalid topic binding %q, expected event_type=topic", entry)
+		}
+		if _, registered := r.handlers[eventType]; !registered {
+			return nil, fmt.Errorf("topic %s is bound to event type %s, which has no handler", topic, eventType)
+		}
+		if bound, exists := topics[topic]; exists {
+			return nil, fmt.Errorf("topic %s is bound to both %s and %s", topic, bound, eventType)
+		}
+
+		topics[topic] = eventType
+		names = append(names, topic)
+	}
+
+	r.topics = topics
+	for _, topic := range names {
+		log.Printf("Kafka topic %s is handled as event type %s", topic, topics[topic])
+	}
+	return names, nil
+}
+
+// HandleMessage passes a consumed message to the handler of the event type its topic is bound to.
+// A topic that is not bound is an error, so a replayed dead letter is kept rather than dropped.
+func (r *HandlerRegistry) HandleMessage(ctx context.Context, topic string, message []byte) error {
+	eventType, bound := r.topics[topic]
+	if !bound {
+		return fmt.Errorf("topic %s is not bound to an event type", topic)
+	}
+
+	log.Printf("Handling message from topic %s as event type %s", topic, eventType)
+	return r.handlers[eventType](ctx, message)
+}
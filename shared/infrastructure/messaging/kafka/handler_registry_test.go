@@ -0,0 +1,80 @@
+package kafka
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func newTestRegistry(t *testing.T, handled map[string]string, eventTypes ...string) *HandlerRegistry {
+	t.Helper()
+
+	registry := NewHandlerRegistry()
+	for _, eventType := range eventTypes {
+		err := registry.Register(eventType, func(ctx context.Context, message []byte) error {
+			handled[eventType] = string(message)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error registering %s: %v", eventType, err)
+		}
+	}
+	return registry
+}
+
+func TestBindRoutesTopicsToEventTypes(t *testing.T) {
+	handled := make(map[string]string)
+	registry := newTestRegistry(t, handled, "community.registration", "user.deleted")
+
+	topics, err := registry.Bind([]string{" community.registration = user-registration ", "user.deleted"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"user-registration", "user.deleted"}; !reflect.DeepEqual(topics, want) {
+		t.Fatalf("got topics %v, want %v", topics, want)
+	}
+
+	if err := registry.HandleMessage(context.Background(), "user-registration", []byte("payload")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if handled["community.registration"] != "payload" {
+		t.Errorf("message was not routed to community.registration: %v", handled)
+	}
+}
+
+func TestBindDefaultsToRegisteredEventTypes(t *testing.T) {
+	registry := newTestRegistry(t, map[string]string{}, "user.deleted", "community.registration")
+
+	topics, err := registry.Bind(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"community.registration", "user.deleted"}; !reflect.DeepEqual(topics, want) {
+		t.Fatalf("got topics %v, want %v", topics, want)
+	}
+}
+
+func TestBindRejectsInvalidConfiguration(t *testing.T) {
+	cases := map[string][]string{
+		"unhandled event type": {"user-registration"},
+		"empty topic":          {"community.registration="},
+		"topic bound twice":    {"community.registration=events", "user.deleted=events"},
+	}
+
+	for name, entries := range cases {
+		t.Run(name, func(t *testing.T) {
+			registry := newTestRegistry(t, map[string]string{}, "community.registration", "user.deleted")
+			if _, err := registry.Bind(entries); err == nil {
+				t.Fatalf("expected an error for %v", entries)
+			}
+		})
+	}
+}
+
+func TestHandleMessageRejectsUnboundTopics(t *testing.T) {
+	registry := newTestRegistry(t, map[string]string{}, "user.deleted")
+
+	if err := registry.HandleMessage(context.Background(), "user.deleted", nil); err == nil {
+		t.Fatal("expected an error before the topics are bound")
+	}
+}
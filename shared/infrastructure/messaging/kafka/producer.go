@@ -0,0 +1,77 @@
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl/plain"
+)
+
+type KafkaProducer struct {
+	writer *kafka.Writer
+}
+
+// NewKafkaProducer creates a Kafka producer with Azure Event Hub support.
+// Messages are spread over partitions by key, so messages sharing a key keep their order.
+func NewKafkaProducer(config KafkaConfig) *KafkaProducer {
+	transport := &kafka.Transport{
+		DialTimeout: 10 * time.Second,
+	}
+
+	if config.SecurityProtocol == "SASL_SSL" {
+		log.Printf("Configuring Kafka producer for Azure Event Hub (%s)", config.BootstrapServers)
+
+		if config.SASLMechanism == "PLAIN" {
+			transport.SASL = plain.Mechanism{
+				Username: config.SASLUsername,
+				Password: config.SASLPassword,
+			}
+		}
+		transport.TLS = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+		transport.DialTimeout = 30 * time.Second // Increased timeout for Azure
+	}
+
+	writer := &kafka.Writer{
+		Addr:         kafka.TCP(strings.Split(config.BootstrapServers, ",")...),
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+		MaxAttempts:  3,
+		Transport:    transport,
+	}
+
+	log.Printf("Kafka producer created (Security: %s)", config.SecurityProtocol)
+
+	return &KafkaProducer{
+		writer: writer,
+	}
+}
+
+// Publish writes a single message to the topic and waits for the brokers to acknowledge it
+func (kp *KafkaProducer) Publish(ctx context.Context, topic, key string, value []byte, headers map[string]string) error {
+	message := kafka.Message{
+		Topic: topic,
+		Key:   []byte(key),
+		Value: value,
+	}
+	for name, headerValue := range headers {
+		message.Headers = append(message.Headers, kafka.Header{Key: name, Value: []byte(headerValue)})
+	}
+
+	return kp.writer.WriteMessages(ctx, message)
+}
+
+// Close flushes pending writes and closes the Kafka producer
+func (kp *KafkaProducer) Close() error {
+	if err := kp.writer.Close(); err != nil {
+		log.Printf("Error closing Kafka writer: %v", err)
+		return err
+	}
+	log.Println("Kafka producer closed")
+	return nil
+}
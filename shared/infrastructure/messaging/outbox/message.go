@@ -0,0 +1,54 @@
+package outbox
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Message is a domain event waiting in the outbox to be published.
+// Messages sharing an aggregate key are published in the order they were recorded.
+type Message struct {
+	ID            string
+	Topic         string
+	EventType     string
+	AggregateType string
+	AggregateKey  string
+	Payload       []byte
+	OccurredOn    time.Time
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+}
+
+// NewMessage creates an outbox message with payload encoded as JSON
+func NewMessage(topic, eventType, aggregateType, aggregateKey string, payload interface{}, occurredOn time.Time) (Message, error) {
+	if topic == "" {
+		return Message{}, errors.New("topic cannot be empty")
+	}
+	if eventType == "" {
+		return Message{}, errors.New("event type cannot be empty")
+	}
+	if aggregateKey == "" {
+		return Message{}, errors.New("aggregate key cannot be empty")
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return Message{}, err
+	}
+
+	return Message{
+		// ObjectIDs grow with time, which gives the relay the order in which messages were recorded
+		ID:            primitive.NewObjectID().Hex(),
+		Topic:         topic,
+		EventType:     eventType,
+		AggregateType: aggregateType,
+		AggregateKey:  aggregateKey,
+		Payload:       body,
+		OccurredOn:    occurredOn,
+		NextAttemptAt: occurredOn,
+	}, nil
+}
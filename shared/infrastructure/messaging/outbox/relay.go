@@ -0,0 +1,172 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	// relayBatchSize bounds how many pending messages are loaded at once
+	relayBatchSize = 100
+	// baseRetryDelay is the wait after the first failed attempt; it doubles on every further failure
+	baseRetryDelay = 5 * time.Second
+	maxRetryDelay  = 10 * time.Minute
+)
+
+// Publisher sends a message to a topic. Messages with the same key must land on the same partition.
+type Publisher interface {
+	Publish(ctx context.Context, topic, key string, value []byte, headers map[string]string) error
+}
+
+// Relay periodically publishes the pending outbox messages.
+// Messages of an aggregate are published one after the other: when one fails, the later ones
+// wait until it goes through or is parked after maxAttempts. Delivery is at least once, so consumers
+// have to tolerate duplicates. Only one relay may run against an outbox: two relays would publish
+// the messages of an aggregate concurrently and so out of order.
+type Relay struct {
+	store       *Store
+	publisher   Publisher
+	interval    time.Duration
+	maxAttempts int
+	stop        chan struct{}
+	done        sync.WaitGroup
+}
+
+// NewRelay creates a relay that checks the outbox every interval and parks a message after maxAttempts failures.
+func NewRelay(store *Store, publisher Publisher, interval time.Duration, maxAttempts int) *Relay {
+	return &Relay{
+		store:       store,
+		publisher:   publisher,
+		interval:    interval,
+		maxAttempts: maxAttempts,
+		stop:        make(chan struct{}),
+	}
+}
+
+// Start runs the relay in a background goroutine until Stop is called or ctx is cancelled.
+func (r *Relay) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	r.done.Add(1)
+	go func() {
+		defer r.done.Done()
+		defer ticker.Stop()
+
+		log.Printf("Outbox relay started (interval %s)", r.interval)
+		for {
+			select {
+			case <-ticker.C:
+				r.relayPending(ctx)
+			case <-r.stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop signals the relay to exit and waits for the current run to finish.
+func (r *Relay) Stop() {
+	close(r.stop)
+	r.done.Wait()
+	log.Println("Outbox relay stopped")
+}
+
+// relayPending publishes every due message, paging through the outbox in the order messages were recorded.
+// Aggregates with a message waiting for its retry are left out, so their later messages keep their place.
+func (r *Relay) relayPending(ctx context.Context) {
+	now := time.Now()
+	waiting, err := r.store.FindWaitingAggregateKeys(ctx, now)
+	if err != nil {
+		log.Printf("Outbox relay error: %v", err)
+		return
+	}
+
+	// Aggregates whose message fails during this run are held back for the rest of it
+	blocked := make(map[string]bool)
+	afterID := ""
+	for {
+		pending, err := r.store.FindPending(ctx, now, waiting, afterID, relayBatchSize)
+		if err != nil {
+			log.Printf("Outbox relay error: %v", err)
+			return
+		}
+
+		published := r.publishBatch(ctx, pending, blocked, now)
+		if published > 0 {
+			log.Printf("Published %d outbox message(s)", published)
+		}
+
+		if len(pending) < relayBatchSize {
+			return
+		}
+		afterID = pending[len(pending)-1].ID
+	}
+}
+
+// publishBatch publishes the messages in order and returns how many went through
+func (r *Relay) publishBatch(ctx context.Context, messages []Message, blocked map[string]bool, now time.Time) int {
+	published := 0
+
+	for _, message := range messages {
+		if blocked[message.AggregateKey] {
+			continue
+		}
+
+		headers := map[string]string{
+			"message_id":     message.ID,
+			"event_type":     message.EventType,
+			"aggregate_type": message.AggregateType,
+			"occurred_on":    message.OccurredOn.UTC().Format(time.RFC3339),
+		}
+
+		if err := r.publisher.Publish(ctx, message.Topic, message.AggregateKey, message.Payload, headers); err != nil {
+			blocked[message.AggregateKey] = true
+			r.recordFailure(ctx, message, err, now)
+			continue
+		}
+
+		if err := r.store.Remove(ctx, message.ID); err != nil {
+			// The message will be published again on the next run
+			blocked[message.AggregateKey] = true
+			log.Printf("failed to remove published outbox message %s: %v", message.ID, err)
+			continue
+		}
+		published++
+	}
+
+	return published
+}
+
+// recordFailure schedules the next attempt of a message, or parks it once it has used up its attempts
+func (r *Relay) recordFailure(ctx context.Context, message Message, publishErr error, now time.Time) {
+	attempt := message.Attempts + 1
+	if attempt >= r.maxAttempts {
+		log.Printf("giving up on outbox message %s (%s) after %d attempts, parking it: %v",
+			message.ID, message.EventType, attempt, publishErr)
+		if err := r.store.Park(ctx, message.ID, publishErr, now); err != nil {
+			log.Printf("failed to park outbox message %s: %v", message.ID, err)
+		}
+		return
+	}
+
+	log.Printf("failed to publish outbox message %s (%s), attempt %d: %v",
+		message.ID, message.EventType, attempt, publishErr)
+	if err := r.store.MarkFailed(ctx, message.ID, publishErr, now.Add(retryDelay(attempt))); err != nil {
+		log.Printf("failed to record outbox failure for message %s: %v", message.ID, err)
+	}
+}
+
+// retryDelay returns how long to wait before the given attempt, doubling from baseRetryDelay up to maxRetryDelay
+func retryDelay(attempt int) time.Duration {
+	delay := baseRetryDelay
+	for i := 1; i < attempt && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
@@ -0,0 +1,31 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryDelayDoublesUpToTheMaximum(t *testing.T) {
+	cases := map[int]time.Duration{
+		1:  5 * time.Second,
+		2:  10 * time.Second,
+		3:  20 * time.Second,
+		7:  320 * time.Second,
+		8:  maxRetryDelay,
+		50: maxRetryDelay,
+	}
+
+	for attempt, want := range cases {
+		if got := retryDelay(attempt); got != want {
+			t.Errorf("retryDelay(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+}
+
+func TestRetryDelayStartsAtTheBaseDelay(t *testing.T) {
+	for _, attempt := range []int{-1, 0, 1} {
+		if got := retryDelay(attempt); got != baseRetryDelay {
+			t.Errorf("retryDelay(%d) = %s, want %s", attempt, got, baseRetryDelay)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Store keeps outbox messages in MongoDB until the relay has published them.
+// Messages are removed once published; messages the relay gave up on are parked and kept for inspection.
+type Store struct {
+	collection *mongo.Collection
+}
+
+// NewStore creates a Store on the outbox collection
+func NewStore(collection *mongo.Collection) *Store {
+	return &Store{collection: collection}
+}
+
+// messageDocument represents the MongoDB document structure
+type messageDocument struct {
+	ID            string `bson:"_id"`
+	Topic         string `bson:"topic"`
+	EventType     string `bson:"event_type"`
+	AggregateType string `bson:"aggregate_type"`
+	AggregateKey  string `bson:"aggregate_key"`
+	Payload       []byte `bson:"payload"`
+	OccurredOn    int64  `bson:"occurred_on"`
+	Attempts      int    `bson:"attempts"`
+	NextAttemptAt int64  `bson:"next_attempt_at"`
+	LastError     string `bson:"last_error,omitempty"`
+	ParkedAt      *int64 `bson:"parked_at,omitempty"`
+}
+
+// Append records a message. Called with the context of a unit of work, the message is only
+// stored when the rest of the transaction commits.
+func (s *Store) Append(ctx context.Context, message Message) error {
+	doc := messageDocument{
+		ID:            message.ID,
+		Topic:         message.Topic,
+		EventType:     message.EventType,
+		AggregateType: message.AggregateType,
+		AggregateKey:  message.AggregateKey,
+		Payload:       message.Payload,
+		OccurredOn:    message.OccurredOn.Unix(),
+		Attempts:      message.Attempts,
+		NextAttemptAt: message.NextAttemptAt.Unix(),
+		LastError:     message.LastError,
+	}
+
+	_, err := s.collection.InsertOne(ctx, doc)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errors.New("outbox message already exists")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// FindWaitingAggregateKeys retrieves the aggregates that have a message waiting for its next attempt after now.
+// The later messages of these aggregates must not be published before it.
+func (s *Store) FindWaitingAggregateKeys(ctx context.Context, now time.Time) ([]string, error) {
+	filter := bson.M{
+		"parked_at":       bson.M{"$exists": false},
+		"next_attempt_at": bson.M{"$gt": now.Unix()},
+	}
+
+	values, err := s.collection.Distinct(ctx, "aggregate_key", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(values))
+	for _, value := range values {
+		if key, ok := value.(string); ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// FindPending retrieves up to limit messages due by now, in the order they were recorded and recorded after afterID
+// when it is set. Parked messages and the messages of the excluded aggregates are left out.
+func (s *Store) FindPending(ctx context.Context, now time.Time, excludedAggregateKeys []string, afterID string, limit int) ([]Message, error) {
+	filter := bson.M{
+		"parked_at":       bson.M{"$exists": false},
+		"next_attempt_at": bson.M{"$lte": now.Unix()},
+	}
+	if len(excludedAggregateKeys) > 0 {
+		filter["aggregate_key"] = bson.M{"$nin": excludedAggregateKeys}
+	}
+	if afterID != "" {
+		filter["_id"] = bson.M{"$gt": afterID}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := s.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var messages []Message
+	for cursor.Next(ctx) {
+		var doc messageDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, Message{
+			ID:            doc.ID,
+			Topic:         doc.Topic,
+			EventType:     doc.EventType,
+			AggregateType: doc.AggregateType,
+			AggregateKey:  doc.AggregateKey,
+			Payload:       doc.Payload,
+			OccurredOn:    time.Unix(doc.OccurredOn, 0),
+			Attempts:      doc.Attempts,
+			NextAttemptAt: time.Unix(doc.NextAttemptAt, 0),
+			LastError:     doc.LastError,
+		})
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// Remove deletes a message once it has been published
+func (s *Store) Remove(ctx context.Context, id string) error {
+	result, err := s.collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.New("outbox message not found")
+	}
+
+	return nil
+}
+
+// MarkFailed records a failed publication attempt and when the message should be tried again
+func (s *Store) MarkFailed(ctx context.Context, id string, publishErr error, nextAttemptAt time.Time) error {
+	filter := bson.M{"_id": id}
+	update := bson.M{
+		"$inc": bson.M{"attempts": 1},
+		"$set": bson.M{
+			"next_attempt_at": nextAttemptAt.Unix(),
+			"last_error":      publishErr.Error(),
+		},
+	}
+
+	result, err := s.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("outbox message not found")
+	}
+
+	return nil
+}
+
+// Park records the last failed publication attempt and stops the relay from trying the message again.
+// The later messages of its aggregate are published without it.
+func (s *Store) Park(ctx context.Context, id string, publishErr error, parkedAt time.Time) error {
+	filter := bson.M{"_id": id}
+	update := bson.M{
+		"$inc": bson.M{"attempts": 1},
+		"$set": bson.M{
+			"parked_at":  parkedAt.Unix(),
+			"last_error": publishErr.Error(),
+		},
+	}
+
+	result, err := s.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("outbox message not found")
+	}
+
+	return nil
+}
@@ -39,3 +39,385 @@ func CreateUserIndexes(ctx context.Context, collection *mongo.Collection) error
 	log.Println("MongoDB indexes created successfully for users collection")
 	return nil
 }
+
+// CreatePollVoteIndexes creates indexes for the poll_votes collection
+func CreatePollVoteIndexes(ctx context.Context, collection *mongo.Collection) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "post_id", Value: 1}, {Key: "voter_id", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("idx_unique_post_voter_poll_vote"),
+		},
+		{
+			Keys:    bson.D{{Key: "community_id", Value: 1}},
+			Options: options.Index().SetName("idx_poll_votes_community"),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		log.Printf("Error creating indexes: %v", err)
+		return err
+	}
+
+	log.Println("MongoDB indexes created successfully for poll_votes collection")
+	return nil
+}
+
+// CreateCommentIndexes creates indexes for the comments collection.
+// Threads are paged by post and parent, and removing a comment removes its replies through ancestor_ids.
+func CreateCommentIndexes(ctx context.Context, collection *mongo.Collection) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "comment_id", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("idx_comment_id"),
+		},
+		{
+			Keys: bson.D{
+				{Key: "post_id", Value: 1},
+				{Key: "parent_id", Value: 1},
+				{Key: "created_at", Value: 1},
+				{Key: "_id", Value: 1},
+			},
+			Options: options.Index().SetName("idx_post_parent_created_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "ancestor_ids", Value: 1}},
+			Options: options.Index().SetName("idx_ancestor_ids"),
+		},
+		{
+			Keys:    bson.D{{Key: "author_id", Value: 1}},
+			Options: options.Index().SetName("idx_comments_author"),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		log.Printf("Error creating indexes: %v", err)
+		return err
+	}
+
+	log.Println("MongoDB indexes created successfully for comments collection")
+	return nil
+}
+
+// CreateCommunityIndexes creates indexes for the community directory filters and sort orders
+func CreateCommunityIndexes(ctx context.Context, collection *mongo.Collection) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "is_private", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("idx_privacy_created_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "owner_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("idx_owner_created_at"),
+		},
+		{
+			Keys: bson.D{{Key: "name", Value: 1}},
+			Options: options.Index().
+				SetName("idx_name_ci").
+				SetCollation(&options.Collation{Locale: "en", Strength: 2}),
+		},
+		{
+			Keys:    bson.D{{Key: "member_count", Value: -1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("idx_member_count"),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		log.Printf("Error creating indexes: %v", err)
+		return err
+	}
+
+	log.Println("MongoDB indexes created successfully for communities collection")
+	return nil
+}
+
+// CreateSubscriptionExpiryIndexes creates the index the membership expiry sweeper relies on.
+// Only expiring subscriptions are indexed.
+func CreateSubscriptionExpiryIndexes(ctx context.Context, collection *mongo.Collection) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetSparse(true).SetName("idx_expires_at"),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		log.Printf("Error creating indexes: %v", err)
+		return err
+	}
+
+	log.Println("MongoDB indexes created successfully for subscriptions collection")
+	return nil
+}
+
+// CreateJoinRequestIndexes creates indexes for the join_requests collection.
+// A user can only have one pending request per community.
+func CreateJoinRequestIndexes(ctx context.Context, collection *mongo.Collection) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "community_id", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetName("idx_unique_pending_user_community").
+				SetPartialFilterExpression(bson.M{"status": "pending"}),
+		},
+		{
+			Keys:    bson.D{{Key: "community_id", Value: 1}, {Key: "status", Value: 1}, {Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("idx_community_status_created_at"),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		log.Printf("Error creating indexes: %v", err)
+		return err
+	}
+
+	log.Println("MongoDB indexes created successfully for join_requests collection")
+	return nil
+}
+
+// CreateInvitationIndexes creates indexes for the invitations collection
+func CreateInvitationIndexes(ctx context.Context, collection *mongo.Collection) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "invitation_id", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("idx_invitation_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "community_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("idx_community_created_at"),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		log.Printf("Error creating indexes: %v", err)
+		return err
+	}
+
+	log.Println("MongoDB indexes created successfully for invitations collection")
+	return nil
+}
+
+// CreateSanctionIndexes creates indexes for the community_sanctions collection.
+// A user holds at most one ban and one mute per community.
+func CreateSanctionIndexes(ctx context.Context, collection *mongo.Collection) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "community_id", Value: 1}, {Key: "type", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("idx_unique_user_community_type"),
+		},
+		{
+			Keys:    bson.D{{Key: "community_id", Value: 1}, {Key: "type", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("idx_community_type_created_at"),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		log.Printf("Error creating indexes: %v", err)
+		return err
+	}
+
+	log.Println("MongoDB indexes created successfully for community_sanctions collection")
+	return nil
+}
+
+// CreateCustomRoleIndexes creates indexes for the community_roles collection.
+// Role names are unique within a community.
+func CreateCustomRoleIndexes(ctx context.Context, collection *mongo.Collection) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "community_id", Value: 1}, {Key: "name", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("idx_unique_community_name"),
+		},
+		{
+			Keys:    bson.D{{Key: "custom_role_id", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("idx_custom_role_id"),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		log.Printf("Error creating indexes: %v", err)
+		return err
+	}
+
+	log.Println("MongoDB indexes created successfully for community_roles collection")
+	return nil
+}
+
+// CreateOwnershipTransferIndexes creates indexes for the ownership_transfers collection.
+// A community can only have one pending transfer at a time.
+func CreateOwnershipTransferIndexes(ctx context.Context, collection *mongo.Collection) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "community_id", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetName("idx_unique_pending_community").
+				SetPartialFilterExpression(bson.M{"status": "pending"}),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		log.Printf("Error creating indexes: %v", err)
+		return err
+	}
+
+	log.Println("MongoDB indexes created successfully for ownership_transfers collection")
+	return nil
+}
+
+// CreateCommunityTextIndex creates the full-text search index for the communities collection.
+// Name matches weigh more than description matches.
+func CreateCommunityTextIndex(ctx context.Context, collection *mongo.Collection) error {
+	index := mongo.IndexModel{
+		Keys: bson.D{{Key: "name", Value: "text"}, {Key: "description", Value: "text"}},
+		Options: options.Index().
+			SetName("idx_community_text").
+			SetWeights(bson.D{{Key: "name", Value: 10}, {Key: "description", Value: 1}}),
+	}
+
+	_, err := collection.Indexes().CreateOne(ctx, index)
+	if err != nil {
+		log.Printf("Error creating indexes: %v", err)
+		return err
+	}
+
+	log.Println("MongoDB text index created successfully for communities collection")
+	return nil
+}
+
+// CreatePostPinIndexes creates the index that keeps each pin position of a community held by one post at most
+func CreatePostPinIndexes(ctx context.Context, collection *mongo.Collection) error {
+	index := mongo.IndexModel{
+		Keys: bson.D{{Key: "community_id", Value: 1}, {Key: "pin_order", Value: 1}},
+		Options: options.Index().
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"is_pinned": true}).
+			SetName("idx_unique_community_pin_order"),
+	}
+
+	_, err := collection.Indexes().CreateOne(ctx, index)
+	if err != nil {
+		log.Printf("Error creating indexes: %v", err)
+		return err
+	}
+
+	log.Println("MongoDB pin order index created successfully for posts collection")
+	return nil
+}
+
+// CreatePostFeedIndexes creates the index behind the paginated post listings, which filter by community
+// and status and page newest first on (created_at, post_id)
+func CreatePostFeedIndexes(ctx context.Context, collection *mongo.Collection) error {
+	index := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "community_id", Value: 1},
+			{Key: "status", Value: 1},
+			{Key: "created_at", Value: -1},
+			{Key: "post_id", Value: -1},
+		},
+		Options: options.Index().SetName("idx_community_status_created_at_post_id"),
+	}
+
+	_, err := collection.Indexes().CreateOne(ctx, index)
+	if err != nil {
+		log.Printf("Error creating indexes: %v", err)
+		return err
+	}
+
+	log.Println("MongoDB feed index created successfully for posts collection")
+	return nil
+}
+
+// CreatePostTextIndex creates the full-text search index for the posts collection
+func CreatePostTextIndex(ctx context.Context, collection *mongo.Collection) error {
+	index := mongo.IndexModel{
+		Keys:    bson.D{{Key: "content", Value: "text"}},
+		Options: options.Index().SetName("idx_post_text"),
+	}
+
+	_, err := collection.Indexes().CreateOne(ctx, index)
+	if err != nil {
+		log.Printf("Error creating indexes: %v", err)
+		return err
+	}
+
+	log.Println("MongoDB text index created successfully for posts collection")
+	return nil
+}
+
+// CreateOutboxIndexes creates the index the outbox relay polls with on every tick:
+// unparked messages by next attempt, paged in _id order
+func CreateOutboxIndexes(ctx context.Context, collection *mongo.Collection) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "parked_at", Value: 1}, {Key: "next_attempt_at", Value: 1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("idx_outbox_parked_next_attempt"),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		log.Printf("Error creating indexes: %v", err)
+		return err
+	}
+
+	log.Println("MongoDB indexes created successfully for outbox collection")
+	return nil
+}
+
+// CreateDeadLetterIndexes creates indexes for the dead_letters collection.
+// A consumed message is dead-lettered at most once, so concurrent upserts of it cannot insert twice.
+func CreateDeadLetterIndexes(ctx context.Context, collection *mongo.Collection) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "failed_at", Value: -1}},
+			Options: options.Index().SetName("idx_dead_letters_failed_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "topic", Value: 1}, {Key: "failed_at", Value: -1}},
+			Options: options.Index().SetName("idx_dead_letters_topic_failed_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "topic", Value: 1}, {Key: "partition", Value: 1}, {Key: "offset", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("idx_dead_letters_topic_partition_offset"),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		log.Printf("Error creating indexes: %v", err)
+		return err
+	}
+
+	log.Println("MongoDB indexes created successfully for dead_letters collection")
+	return nil
+}
+
+// CreateProcessedEventIndexes creates indexes for the processed_events collection.
+// Events are keyed by _id; the user index finds the newest event applied for a user.
+func CreateProcessedEventIndexes(ctx context.Context, collection *mongo.Collection) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "occurred_on", Value: -1}},
+			Options: options.Index().SetName("idx_processed_events_user_occurred_on"),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		log.Printf("Error creating indexes: %v", err)
+		return err
+	}
+
+	log.Println("MongoDB indexes created successfully for processed_events collection")
+	return nil
+}
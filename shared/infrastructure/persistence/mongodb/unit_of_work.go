@@ -0,0 +1,34 @@
+package mongodb
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// UnitOfWork runs a group of writes in a single MongoDB transaction.
+// Transactions need a replica set or a sharded cluster.
+type UnitOfWork struct {
+	client *mongo.Client
+}
+
+// NewUnitOfWork creates a UnitOfWork on the given client
+func NewUnitOfWork(client *mongo.Client) *UnitOfWork {
+	return &UnitOfWork{client: client}
+}
+
+// Execute runs fn inside a transaction and commits it when fn succeeds.
+// Repositories take part in the transaction by using the context passed to fn.
+// fn may be retried on transient errors, so it should only perform writes.
+func (u *UnitOfWork) Execute(ctx context.Context, fn func(ctx context.Context) error) error {
+	session, err := u.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessionCtx)
+	})
+	return err
+}
@@ -0,0 +1,148 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"Gommunity/shared/infrastructure/messaging/deadletter"
+	"Gommunity/shared/interfaces/rest/resources"
+)
+
+const (
+	defaultDeadLetterLimit = 20
+	maxDeadLetterLimit     = 100
+)
+
+type DeadLetterController struct {
+	service *deadletter.Service
+}
+
+func NewDeadLetterController(service *deadletter.Service) *DeadLetterController {
+	return &DeadLetterController{
+		service: service,
+	}
+}
+
+// @Summary List dead-lettered Kafka messages
+// @Description List the consumed Kafka messages whose handler kept failing after every retry, newest first. Only platform admins can manage dead letters.
+// @Tags admin
+// @Produce json
+// @Param topic query string false "Only messages from this topic"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param offset query int false "Number of messages to skip"
+// @Success 200 {object} resources.DeadLetterListResource
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/admin/dead-letters [get]
+func (c *DeadLetterController) GetDeadLetters(ctx *gin.Context) {
+	limit := defaultDeadLetterLimit
+	if value := ctx.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive number"})
+			return
+		}
+		limit = min(parsed, maxDeadLetterLimit)
+	}
+
+	offset := 0
+	if value := ctx.Query("offset"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "offset cannot be negative"})
+			return
+		}
+		offset = parsed
+	}
+
+	messages, err := c.service.List(ctx.Request.Context(), strings.TrimSpace(ctx.Query("topic")), limit, offset)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	deadLetters := make([]resources.DeadLetterResource, 0, len(messages))
+	for _, message := range messages {
+		deadLetters = append(deadLetters, toDeadLetterResource(message))
+	}
+
+	ctx.JSON(http.StatusOK, resources.DeadLetterListResource{
+		DeadLetters: deadLetters,
+		Limit:       limit,
+		Offset:      offset,
+	})
+}
+
+// @Summary Replay a dead-lettered Kafka message
+// @Description Hand a dead-lettered message to its handler again. It is removed once handled; otherwise it stays with the new error. Only platform admins can manage dead letters.
+// @Tags admin
+// @Produce json
+// @Param dead_letter_id path string true "Dead letter ID"
+// @Success 204
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 422 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/admin/dead-letters/{dead_letter_id}/replay [post]
+func (c *DeadLetterController) ReplayDeadLetter(ctx *gin.Context) {
+	if err := c.service.Replay(ctx.Request.Context(), ctx.Param("dead_letter_id")); err != nil {
+		statusCode := http.StatusInternalServerError
+		if err.Error() == "dead letter not found" {
+			statusCode = http.StatusNotFound
+		} else if strings.HasPrefix(err.Error(), "replay failed") {
+			statusCode = http.StatusUnprocessableEntity
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// @Summary Discard a dead-lettered Kafka message
+// @Description Remove a dead-lettered message without handling it. Only platform admins can manage dead letters.
+// @Tags admin
+// @Produce json
+// @Param dead_letter_id path string true "Dead letter ID"
+// @Success 204
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security BearerAuth
+// @Router /api/v1/admin/dead-letters/{dead_letter_id} [delete]
+func (c *DeadLetterController) DiscardDeadLetter(ctx *gin.Context) {
+	if err := c.service.Discard(ctx.Request.Context(), ctx.Param("dead_letter_id")); err != nil {
+		statusCode := http.StatusInternalServerError
+		if err.Error() == "dead letter not found" {
+			statusCode = http.StatusNotFound
+		}
+		ctx.JSON(statusCode, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func toDeadLetterResource(message deadletter.Message) resources.DeadLetterResource {
+	return resources.DeadLetterResource{
+		DeadLetterID:    message.ID,
+		Topic:           message.Topic,
+		Partition:       message.Partition,
+		Offset:          message.Offset,
+		Key:             message.Key,
+		Payload:         string(message.Payload),
+		Headers:         message.Headers,
+		Attempts:        message.Attempts,
+		Error:           message.Error,
+		FailedAt:        message.FailedAt,
+		ReplayCount:     message.ReplayCount,
+		LastReplayError: message.LastReplayError,
+		LastReplayedAt:  message.LastReplayedAt,
+	}
+}
@@ -0,0 +1,27 @@
+package resources
+
+import "time"
+
+// DeadLetterResource is a consumed Kafka message that kept failing
+type DeadLetterResource struct {
+	DeadLetterID    string            `json:"dead_letter_id"`
+	Topic           string            `json:"topic"`
+	Partition       int               `json:"partition"`
+	Offset          int64             `json:"offset"`
+	Key             string            `json:"key,omitempty"`
+	Payload         string            `json:"payload"`
+	Headers         map[string]string `json:"headers,omitempty"`
+	Attempts        int               `json:"attempts"`
+	Error           string            `json:"error"`
+	FailedAt        time.Time         `json:"failed_at"`
+	ReplayCount     int               `json:"replay_count"`
+	LastReplayError string            `json:"last_replay_error,omitempty"`
+	LastReplayedAt  *time.Time        `json:"last_replayed_at,omitempty"`
+}
+
+// DeadLetterListResource is a page of dead-lettered messages
+type DeadLetterListResource struct {
+	DeadLetters []DeadLetterResource `json:"dead_letters"`
+	Limit       int                  `json:"limit"`
+	Offset      int                  `json:"offset"`
+}